@@ -4,9 +4,15 @@
 package acceptance
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -148,3 +154,78 @@ func (td TestData) RequiresImportErrorStep(configBuilder func(data TestData) str
 		ExpectError: RequiresImportError(td.ResourceType),
 	}
 }
+
+// TagsOnlyUpdateStep returns a Test Step which applies a Configuration which only changes the
+// `tags` of the resource, and then checks that the resource was updated using a PATCH rather
+// than a PUT of the entire resource.
+//
+// The requests are captured from the Provider's request logging, which requires the Provider
+// to be running in-process (as it is for all acceptance tests).
+func (td TestData) TagsOnlyUpdateStep(config func(data TestData) string, testResource types.TestResource) resource.TestStep {
+	requests := &requestLogWriter{}
+	return resource.TestStep{
+		PreConfig: requests.start,
+		Config:    config(td),
+		Check: ComposeTestCheckFunc(
+			check.That(td.ResourceName).ExistsInAzure(testResource),
+			func(state *terraform.State) error {
+				requests.stop()
+
+				rs, ok := state.RootModule().Resources[td.ResourceName]
+				if !ok {
+					return fmt.Errorf("Resource not found: %s", td.ResourceName)
+				}
+
+				methods := requests.methodsFor(rs.Primary.ID)
+				if _, ok := methods[http.MethodPut]; ok {
+					return fmt.Errorf("expected the tags for %q to be updated without a PUT of the resource", rs.Primary.ID)
+				}
+				if _, ok := methods[http.MethodPatch]; !ok {
+					return fmt.Errorf("expected the tags for %q to be updated using a PATCH", rs.Primary.ID)
+				}
+
+				return nil
+			},
+		),
+	}
+}
+
+// requestLogWriter captures the requests which are logged by the Provider
+type requestLogWriter struct {
+	lock     sync.Mutex
+	previous io.Writer
+	buffer   bytes.Buffer
+}
+
+func (w *requestLogWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.buffer.Write(p)
+}
+
+func (w *requestLogWriter) start() {
+	w.previous = log.Writer()
+	log.SetOutput(io.MultiWriter(w.previous, w))
+}
+
+func (w *requestLogWriter) stop() {
+	if w.previous != nil {
+		log.SetOutput(w.previous)
+		w.previous = nil
+	}
+}
+
+// methodsFor returns the HTTP Methods of the requests sent to the Resource ID `id` (excluding any nested resources)
+func (w *requestLogWriter) methodsFor(id string) map[string]struct{} {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	// requests are logged in wire format, e.g. `PUT /subscriptions/.../resourceGroups/...?api-version=... HTTP/1.1`
+	pattern := regexp.MustCompile(`(?mi)^([A-Z]+) ` + regexp.QuoteMeta(id) + `(\?[^ ]*)? HTTP/`)
+
+	methods := make(map[string]struct{})
+	for _, match := range pattern.FindAllStringSubmatch(w.buffer.String(), -1) {
+		methods[strings.ToUpper(match[1])] = struct{}{}
+	}
+	return methods
+}
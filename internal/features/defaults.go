@@ -20,6 +20,9 @@ func Default() UserFeatures {
 		CognitiveAccount: CognitiveAccountFeatures{
 			PurgeSoftDeleteOnDestroy: true,
 		},
+		DataFactory: DataFactoryFeatures{
+			LiveModeOnly: false,
+		},
 		KeyVault: KeyVaultFeatures{
 			PurgeSoftDeleteOnDestroy:         true,
 			PurgeSoftDeletedKeysOnDestroy:    true,
@@ -42,7 +45,8 @@ func Default() UserFeatures {
 			ExpandWithoutDowntime: true,
 		},
 		ResourceGroup: ResourceGroupFeatures{
-			PreventDeletionIfContainsResources: true,
+			PreventDeletionIfContainsResources:       true,
+			RemoveOwnedManagementLocksDuringDeletion: false,
 		},
 		RecoveryServicesVault: RecoveryServicesVault{
 			RecoverSoftDeletedBackupProtectedVM: true,
@@ -75,5 +79,22 @@ func Default() UserFeatures {
 			VMBackupStopProtectionAndRetainDataOnDestroy: false,
 			PurgeProtectedItemsFromVaultOnDestroy:        false,
 		},
+		ResourceGraphRefresh: ResourceGraphRefreshFeatures{
+			Enabled:       false,
+			LookbackHours: 24,
+		},
+		QuotaValidation: QuotaValidationFeatures{
+			Enabled: false,
+		},
+		NameAvailabilityCheck: NameAvailabilityCheckFeatures{
+			Enabled: false,
+		},
+		UpgradeGuard: UpgradeGuardFeatures{
+			Enabled: false,
+		},
+		ResponseCache: ResponseCacheFeatures{
+			Enabled:    false,
+			TtlSeconds: 30,
+		},
 	}
 }
@@ -8,6 +8,7 @@ type UserFeatures struct {
 	AppConfiguration         AppConfigurationFeatures
 	ApplicationInsights      ApplicationInsightFeatures
 	CognitiveAccount         CognitiveAccountFeatures
+	DataFactory              DataFactoryFeatures
 	VirtualMachine           VirtualMachineFeatures
 	VirtualMachineScaleSet   VirtualMachineScaleSetFeatures
 	KeyVault                 KeyVaultFeatures
@@ -20,12 +21,21 @@ type UserFeatures struct {
 	PostgresqlFlexibleServer PostgresqlFlexibleServerFeatures
 	MachineLearning          MachineLearningFeatures
 	RecoveryService          RecoveryServiceFeatures
+	ResourceGraphRefresh     ResourceGraphRefreshFeatures
+	QuotaValidation          QuotaValidationFeatures
+	NameAvailabilityCheck    NameAvailabilityCheckFeatures
+	UpgradeGuard             UpgradeGuardFeatures
+	ResponseCache            ResponseCacheFeatures
 }
 
 type CognitiveAccountFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 }
 
+type DataFactoryFeatures struct {
+	LiveModeOnly bool
+}
+
 type VirtualMachineFeatures struct {
 	DetachImplicitDataDiskOnDeletion bool
 	DeleteOSDiskOnDeletion           bool
@@ -63,7 +73,8 @@ type LogAnalyticsWorkspaceFeatures struct {
 }
 
 type ResourceGroupFeatures struct {
-	PreventDeletionIfContainsResources bool
+	PreventDeletionIfContainsResources       bool
+	RemoveOwnedManagementLocksDuringDeletion bool
 }
 
 type ApiManagementFeatures struct {
@@ -104,3 +115,25 @@ type RecoveryServiceFeatures struct {
 	VMBackupStopProtectionAndRetainDataOnDestroy bool
 	PurgeProtectedItemsFromVaultOnDestroy        bool
 }
+
+type ResourceGraphRefreshFeatures struct {
+	Enabled       bool
+	LookbackHours int
+}
+
+type QuotaValidationFeatures struct {
+	Enabled bool
+}
+
+type NameAvailabilityCheckFeatures struct {
+	Enabled bool
+}
+
+type UpgradeGuardFeatures struct {
+	Enabled bool
+}
+
+type ResponseCacheFeatures struct {
+	Enabled    bool
+	TtlSeconds int
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nameavailability
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Result is the outcome of checking whether a globally-unique name is available
+type Result struct {
+	Available bool
+
+	// Message is the reason the name isn't available, as returned from the API
+	Message string
+}
+
+// CheckFunc checks whether the globally-unique `name` is available, using the `checkNameAvailability` API for this
+// type of resource - the ResourceDiff is provided since some other fields (for example the Location) can affect this
+type CheckFunc func(ctx context.Context, client *clients.Client, d *pluginsdk.ResourceDiff, name string) (*Result, error)
+
+// CustomizeDiff returns a CustomizeDiffFunc which checks that the (globally-unique) `name` field is available during
+// the plan when the resource is being created, rather than part-way through the apply.
+//
+// This is only performed when opted into via the `name_availability_check` feature, and failures to check the name
+// are logged rather than returned - since the Create will surface any problems in that case.
+func CustomizeDiff(displayName string, check CheckFunc) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		client := meta.(*clients.Client)
+		if !client.Features.NameAvailabilityCheck.Enabled {
+			return nil
+		}
+
+		// the name is only checked when it's going to be used for a new resource - since an existing resource is
+		// already using its own name
+		if d.Id() != "" && !d.HasChange("name") {
+			return nil
+		}
+		if !d.NewValueKnown("name") {
+			return nil
+		}
+		name := d.Get("name").(string)
+		if name == "" {
+			return nil
+		}
+
+		result, err := check(ctx, client, d, name)
+		if err != nil {
+			log.Printf("[WARN] skipping the plan-time name availability check for the %s %q: %+v", displayName, name, err)
+			return nil
+		}
+
+		if !result.Available {
+			return fmt.Errorf("the name %q for the %s needs to be globally unique and isn't available: %s", name, displayName, result.Message)
+		}
+
+		return nil
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
@@ -88,6 +89,21 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"data_factory": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"live_mode_only": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
 		"key_vault": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -275,6 +291,12 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional: true,
 						Default:  os.Getenv("TF_ACC") == "",
 					},
+
+					"remove_owned_management_locks_during_deletion": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
 				},
 			},
 		},
@@ -372,6 +394,90 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"resource_graph_refresh": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"lookback_hours": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      24,
+						ValidateFunc: validation.IntBetween(1, 336),
+					},
+				},
+			},
+		},
+
+		"quota_validation": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		"name_availability_check": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		"upgrade_guard": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		"response_cache": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"ttl_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      30,
+						ValidateFunc: validation.IntBetween(1, 300),
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -453,6 +559,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["data_factory"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			dataFactoryRaw := items[0].(map[string]interface{})
+			if v, ok := dataFactoryRaw["live_mode_only"]; ok {
+				featuresMap.DataFactory.LiveModeOnly = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["key_vault"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 && items[0] != nil {
@@ -558,6 +674,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := resourceGroupRaw["prevent_deletion_if_contains_resources"]; ok {
 				featuresMap.ResourceGroup.PreventDeletionIfContainsResources = v.(bool)
 			}
+			if v, ok := resourceGroupRaw["remove_owned_management_locks_during_deletion"]; ok {
+				featuresMap.ResourceGroup.RemoveOwnedManagementLocksDuringDeletion = v.(bool)
+			}
 		}
 	}
 
@@ -624,5 +743,61 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["resource_graph_refresh"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			resourceGraphRefreshRaw := items[0].(map[string]interface{})
+			if v, ok := resourceGraphRefreshRaw["enabled"]; ok {
+				featuresMap.ResourceGraphRefresh.Enabled = v.(bool)
+			}
+			if v, ok := resourceGraphRefreshRaw["lookback_hours"]; ok {
+				featuresMap.ResourceGraphRefresh.LookbackHours = v.(int)
+			}
+		}
+	}
+
+	if raw, ok := val["quota_validation"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			quotaValidationRaw := items[0].(map[string]interface{})
+			if v, ok := quotaValidationRaw["enabled"]; ok {
+				featuresMap.QuotaValidation.Enabled = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["name_availability_check"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			nameAvailabilityCheckRaw := items[0].(map[string]interface{})
+			if v, ok := nameAvailabilityCheckRaw["enabled"]; ok {
+				featuresMap.NameAvailabilityCheck.Enabled = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["upgrade_guard"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			upgradeGuardRaw := items[0].(map[string]interface{})
+			if v, ok := upgradeGuardRaw["enabled"]; ok {
+				featuresMap.UpgradeGuard.Enabled = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["response_cache"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			responseCacheRaw := items[0].(map[string]interface{})
+			if v, ok := responseCacheRaw["enabled"]; ok {
+				featuresMap.ResponseCache.Enabled = v.(bool)
+			}
+			if v, ok := responseCacheRaw["ttl_seconds"]; ok {
+				featuresMap.ResponseCache.TtlSeconds = v.(int)
+			}
+		}
+	}
+
 	return featuresMap
 }
@@ -35,6 +35,9 @@ func TestExpandFeatures(t *testing.T) {
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				DataFactory: features.DataFactoryFeatures{
+					LiveModeOnly: false,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
 					PurgeSoftDeletedKeysOnDestroy:    true,
@@ -88,6 +91,23 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				ResourceGraphRefresh: features.ResourceGraphRefreshFeatures{
+					Enabled:       false,
+					LookbackHours: 24,
+				},
+				QuotaValidation: features.QuotaValidationFeatures{
+					Enabled: false,
+				},
+				NameAvailabilityCheck: features.NameAvailabilityCheckFeatures{
+					Enabled: false,
+				},
+				UpgradeGuard: features.UpgradeGuardFeatures{
+					Enabled: false,
+				},
+				ResponseCache: features.ResponseCacheFeatures{
+					Enabled:    false,
+					TtlSeconds: 30,
+				},
 			},
 		},
 		{
@@ -116,6 +136,11 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": true,
 						},
 					},
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"live_mode_only": true,
+						},
+					},
 					"key_vault": []interface{}{
 						map[string]interface{}{
 							"purge_soft_deleted_certificates_on_destroy":                  true,
@@ -148,7 +173,8 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
-							"prevent_deletion_if_contains_resources": true,
+							"prevent_deletion_if_contains_resources":        true,
+							"remove_owned_management_locks_during_deletion": true,
 						},
 					},
 					"recovery_services_vaults": []interface{}{
@@ -193,6 +219,33 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          true,
 						},
 					},
+					"resource_graph_refresh": []interface{}{
+						map[string]interface{}{
+							"enabled":        true,
+							"lookback_hours": 48,
+						},
+					},
+					"quota_validation": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+						},
+					},
+					"name_availability_check": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+						},
+					},
+					"upgrade_guard": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+						},
+					},
+					"response_cache": []interface{}{
+						map[string]interface{}{
+							"enabled":     true,
+							"ttl_seconds": 60,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -210,6 +263,9 @@ func TestExpandFeatures(t *testing.T) {
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				DataFactory: features.DataFactoryFeatures{
+					LiveModeOnly: true,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
 					PurgeSoftDeletedKeysOnDestroy:    true,
@@ -230,7 +286,8 @@ func TestExpandFeatures(t *testing.T) {
 					ExpandWithoutDowntime: true,
 				},
 				ResourceGroup: features.ResourceGroupFeatures{
-					PreventDeletionIfContainsResources: true,
+					PreventDeletionIfContainsResources:       true,
+					RemoveOwnedManagementLocksDuringDeletion: true,
 				},
 				RecoveryServicesVault: features.RecoveryServicesVault{
 					RecoverSoftDeletedBackupProtectedVM: true,
@@ -263,6 +320,23 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: true,
 					PurgeProtectedItemsFromVaultOnDestroy:        true,
 				},
+				ResourceGraphRefresh: features.ResourceGraphRefreshFeatures{
+					Enabled:       true,
+					LookbackHours: 48,
+				},
+				QuotaValidation: features.QuotaValidationFeatures{
+					Enabled: true,
+				},
+				NameAvailabilityCheck: features.NameAvailabilityCheckFeatures{
+					Enabled: true,
+				},
+				UpgradeGuard: features.UpgradeGuardFeatures{
+					Enabled: true,
+				},
+				ResponseCache: features.ResponseCacheFeatures{
+					Enabled:    true,
+					TtlSeconds: 60,
+				},
 			},
 		},
 		{
@@ -291,6 +365,11 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": false,
 						},
 					},
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"live_mode_only": false,
+						},
+					},
 					"key_vault": []interface{}{
 						map[string]interface{}{
 							"purge_soft_deleted_certificates_on_destroy":                  false,
@@ -323,7 +402,8 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
-							"prevent_deletion_if_contains_resources": false,
+							"prevent_deletion_if_contains_resources":        false,
+							"remove_owned_management_locks_during_deletion": false,
 						},
 					},
 					"recovery_services_vaults": []interface{}{
@@ -368,6 +448,33 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          false,
 						},
 					},
+					"resource_graph_refresh": []interface{}{
+						map[string]interface{}{
+							"enabled":        false,
+							"lookback_hours": 24,
+						},
+					},
+					"quota_validation": []interface{}{
+						map[string]interface{}{
+							"enabled": false,
+						},
+					},
+					"name_availability_check": []interface{}{
+						map[string]interface{}{
+							"enabled": false,
+						},
+					},
+					"upgrade_guard": []interface{}{
+						map[string]interface{}{
+							"enabled": false,
+						},
+					},
+					"response_cache": []interface{}{
+						map[string]interface{}{
+							"enabled":     false,
+							"ttl_seconds": 30,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -385,6 +492,9 @@ func TestExpandFeatures(t *testing.T) {
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
+				DataFactory: features.DataFactoryFeatures{
+					LiveModeOnly: false,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   false,
 					PurgeSoftDeletedKeysOnDestroy:    false,
@@ -405,7 +515,8 @@ func TestExpandFeatures(t *testing.T) {
 					ExpandWithoutDowntime: false,
 				},
 				ResourceGroup: features.ResourceGroupFeatures{
-					PreventDeletionIfContainsResources: false,
+					PreventDeletionIfContainsResources:       false,
+					RemoveOwnedManagementLocksDuringDeletion: false,
 				},
 				RecoveryServicesVault: features.RecoveryServicesVault{
 					RecoverSoftDeletedBackupProtectedVM: false,
@@ -438,6 +549,23 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				ResourceGraphRefresh: features.ResourceGraphRefreshFeatures{
+					Enabled:       false,
+					LookbackHours: 24,
+				},
+				QuotaValidation: features.QuotaValidationFeatures{
+					Enabled: false,
+				},
+				NameAvailabilityCheck: features.NameAvailabilityCheckFeatures{
+					Enabled: false,
+				},
+				UpgradeGuard: features.UpgradeGuardFeatures{
+					Enabled: false,
+				},
+				ResponseCache: features.ResponseCacheFeatures{
+					Enabled:    false,
+					TtlSeconds: 30,
+				},
 			},
 		},
 	}
@@ -721,6 +849,71 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesDataFactory(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"data_factory": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				DataFactory: features.DataFactoryFeatures{
+					LiveModeOnly: false,
+				},
+			},
+		},
+		{
+			Name: "Live Mode Only Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"live_mode_only": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				DataFactory: features.DataFactoryFeatures{
+					LiveModeOnly: true,
+				},
+			},
+		},
+		{
+			Name: "Live Mode Only Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"live_mode_only": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				DataFactory: features.DataFactoryFeatures{
+					LiveModeOnly: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.DataFactory, testCase.Expected.DataFactory) {
+			t.Fatalf("Expected %+v but got %+v", result.DataFactory, testCase.Expected.DataFactory)
+		}
+	}
+}
+
 func TestExpandFeaturesKeyVault(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -1290,6 +1483,25 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Remove Owned Management Locks During Deletion Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources":        true,
+							"remove_owned_management_locks_during_deletion": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGroup: features.ResourceGroupFeatures{
+					PreventDeletionIfContainsResources:       true,
+					RemoveOwnedManagementLocksDuringDeletion: true,
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testData {
@@ -1678,3 +1890,338 @@ func TestExpandFeaturesRecoveryService(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesResourceGraphRefresh(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_graph_refresh": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGraphRefresh: features.ResourceGraphRefreshFeatures{
+					Enabled:       false,
+					LookbackHours: 24,
+				},
+			},
+		},
+		{
+			Name: "Resource Graph Refresh Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_graph_refresh": []interface{}{
+						map[string]interface{}{
+							"enabled":        true,
+							"lookback_hours": 72,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGraphRefresh: features.ResourceGraphRefreshFeatures{
+					Enabled:       true,
+					LookbackHours: 72,
+				},
+			},
+		},
+		{
+			Name: "Resource Graph Refresh Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_graph_refresh": []interface{}{
+						map[string]interface{}{
+							"enabled":        false,
+							"lookback_hours": 24,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGraphRefresh: features.ResourceGraphRefreshFeatures{
+					Enabled:       false,
+					LookbackHours: 24,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ResourceGraphRefresh, testCase.Expected.ResourceGraphRefresh) {
+			t.Fatalf("Expected %+v but got %+v", result.ResourceGraphRefresh, testCase.Expected.ResourceGraphRefresh)
+		}
+	}
+}
+
+func TestExpandFeaturesQuotaValidation(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"quota_validation": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				QuotaValidation: features.QuotaValidationFeatures{
+					Enabled: false,
+				},
+			},
+		},
+		{
+			Name: "Quota Validation Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"quota_validation": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				QuotaValidation: features.QuotaValidationFeatures{
+					Enabled: true,
+				},
+			},
+		},
+		{
+			Name: "Quota Validation Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"quota_validation": []interface{}{
+						map[string]interface{}{
+							"enabled": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				QuotaValidation: features.QuotaValidationFeatures{
+					Enabled: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.QuotaValidation, testCase.Expected.QuotaValidation) {
+			t.Fatalf("Expected %+v but got %+v", result.QuotaValidation, testCase.Expected.QuotaValidation)
+		}
+	}
+}
+
+func TestExpandFeaturesNameAvailabilityCheck(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name_availability_check": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				NameAvailabilityCheck: features.NameAvailabilityCheckFeatures{
+					Enabled: false,
+				},
+			},
+		},
+		{
+			Name: "Name Availability Check Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name_availability_check": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NameAvailabilityCheck: features.NameAvailabilityCheckFeatures{
+					Enabled: true,
+				},
+			},
+		},
+		{
+			Name: "Name Availability Check Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name_availability_check": []interface{}{
+						map[string]interface{}{
+							"enabled": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NameAvailabilityCheck: features.NameAvailabilityCheckFeatures{
+					Enabled: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.NameAvailabilityCheck, testCase.Expected.NameAvailabilityCheck) {
+			t.Fatalf("Expected %+v but got %+v", result.NameAvailabilityCheck, testCase.Expected.NameAvailabilityCheck)
+		}
+	}
+}
+
+func TestExpandFeaturesUpgradeGuard(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"upgrade_guard": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				UpgradeGuard: features.UpgradeGuardFeatures{
+					Enabled: false,
+				},
+			},
+		},
+		{
+			Name: "Upgrade Guard Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"upgrade_guard": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				UpgradeGuard: features.UpgradeGuardFeatures{
+					Enabled: true,
+				},
+			},
+		},
+		{
+			Name: "Upgrade Guard Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"upgrade_guard": []interface{}{
+						map[string]interface{}{
+							"enabled": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				UpgradeGuard: features.UpgradeGuardFeatures{
+					Enabled: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.UpgradeGuard, testCase.Expected.UpgradeGuard) {
+			t.Fatalf("Expected %+v but got %+v", result.UpgradeGuard, testCase.Expected.UpgradeGuard)
+		}
+	}
+}
+
+func TestExpandFeaturesResponseCache(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"response_cache": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResponseCache: features.ResponseCacheFeatures{
+					Enabled:    false,
+					TtlSeconds: 30,
+				},
+			},
+		},
+		{
+			Name: "Response Cache Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"response_cache": []interface{}{
+						map[string]interface{}{
+							"enabled":     true,
+							"ttl_seconds": 10,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResponseCache: features.ResponseCacheFeatures{
+					Enabled:    true,
+					TtlSeconds: 10,
+				},
+			},
+		},
+		{
+			Name: "Response Cache Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"response_cache": []interface{}{
+						map[string]interface{}{
+							"enabled":     false,
+							"ttl_seconds": 30,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResponseCache: features.ResponseCacheFeatures{
+					Enabled:    false,
+					TtlSeconds: 30,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ResponseCache, testCase.Expected.ResponseCache) {
+			t.Fatalf("Expected %+v but got %+v", result.ResponseCache, testCase.Expected.ResponseCache)
+		}
+	}
+}
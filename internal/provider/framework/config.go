@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	providerfeatures "github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type ProviderConfig struct {
@@ -29,7 +31,13 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	var err error
 
 	if metadataHost := getEnvStringOrDefault(data.MetaDataHost, "ARM_METADATA_HOSTNAME", ""); metadataHost != "" {
-		env, err = environments.FromEndpoint(ctx, metadataHost)
+		metadataEndpoint, endpointErr := provider.MetadataEndpoint(metadataHost)
+		if endpointErr != nil {
+			diags.Append(diag.NewErrorDiagnostic("Configuring metadata host", endpointErr.Error()))
+			return
+		}
+
+		env, err = environments.FromEndpoint(ctx, metadataEndpoint)
 		if err != nil {
 			diags.Append(diag.NewErrorDiagnostic("Configuring metadata host", err.Error()))
 			return
@@ -207,6 +215,22 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			f.CognitiveAccount.PurgeSoftDeleteOnDestroy = true
 		}
 
+		if !features.DataFactory.IsNull() && !features.DataFactory.IsUnknown() {
+			var feature []DataFactory
+			d := features.DataFactory.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.DataFactory.LiveModeOnly = false
+			if !feature[0].LiveModeOnly.IsNull() && !feature[0].LiveModeOnly.IsUnknown() {
+				f.DataFactory.LiveModeOnly = feature[0].LiveModeOnly.ValueBool()
+			}
+		} else {
+			f.DataFactory.LiveModeOnly = false
+		}
+
 		if !features.KeyVault.IsNull() && !features.KeyVault.IsUnknown() {
 			var feature []KeyVault
 			d := features.KeyVault.ElementsAs(ctx, &feature, true)
@@ -389,8 +413,14 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			if !feature[0].PreventDeletionIfContainsResources.IsNull() && !feature[0].PreventDeletionIfContainsResources.IsUnknown() {
 				f.ResourceGroup.PreventDeletionIfContainsResources = feature[0].PreventDeletionIfContainsResources.ValueBool()
 			}
+
+			f.ResourceGroup.RemoveOwnedManagementLocksDuringDeletion = false
+			if !feature[0].RemoveOwnedManagementLocksDuringDeletion.IsNull() && !feature[0].RemoveOwnedManagementLocksDuringDeletion.IsUnknown() {
+				f.ResourceGroup.RemoveOwnedManagementLocksDuringDeletion = feature[0].RemoveOwnedManagementLocksDuringDeletion.ValueBool()
+			}
 		} else {
 			f.ResourceGroup.PreventDeletionIfContainsResources = os.Getenv("TF_ACC") == ""
+			f.ResourceGroup.RemoveOwnedManagementLocksDuringDeletion = false
 		}
 
 		if !features.ManagedDisk.IsNull() && !features.ManagedDisk.IsUnknown() {
@@ -462,9 +492,129 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			f.RecoveryService.VMBackupStopProtectionAndRetainDataOnDestroy = false
 			f.RecoveryService.PurgeProtectedItemsFromVaultOnDestroy = false
 		}
+
+		f.ResourceGraphRefresh.Enabled = false
+		f.ResourceGraphRefresh.LookbackHours = 24
+		if !features.ResourceGraphRefresh.IsNull() && !features.ResourceGraphRefresh.IsUnknown() {
+			var feature []ResourceGraphRefresh
+			d := features.ResourceGraphRefresh.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			if len(feature) > 0 {
+				if !feature[0].Enabled.IsNull() && !feature[0].Enabled.IsUnknown() {
+					f.ResourceGraphRefresh.Enabled = feature[0].Enabled.ValueBool()
+				}
+
+				if !feature[0].LookbackHours.IsNull() && !feature[0].LookbackHours.IsUnknown() {
+					lookbackHours := feature[0].LookbackHours.ValueInt64()
+					if lookbackHours < 1 || lookbackHours > 336 {
+						diags.AddError("invalid `resource_graph_refresh` configuration", fmt.Sprintf("`lookback_hours` must be between 1 and 336, got %d", lookbackHours))
+						return
+					}
+					f.ResourceGraphRefresh.LookbackHours = int(lookbackHours)
+				}
+			}
+		}
+
+		f.QuotaValidation.Enabled = false
+		if !features.QuotaValidation.IsNull() && !features.QuotaValidation.IsUnknown() {
+			var feature []QuotaValidation
+			d := features.QuotaValidation.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			if len(feature) > 0 && !feature[0].Enabled.IsNull() && !feature[0].Enabled.IsUnknown() {
+				f.QuotaValidation.Enabled = feature[0].Enabled.ValueBool()
+			}
+		}
+
+		f.NameAvailabilityCheck.Enabled = false
+		if !features.NameAvailabilityCheck.IsNull() && !features.NameAvailabilityCheck.IsUnknown() {
+			var feature []NameAvailabilityCheck
+			d := features.NameAvailabilityCheck.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			if len(feature) > 0 && !feature[0].Enabled.IsNull() && !feature[0].Enabled.IsUnknown() {
+				f.NameAvailabilityCheck.Enabled = feature[0].Enabled.ValueBool()
+			}
+		}
+
+		f.UpgradeGuard.Enabled = false
+		if !features.UpgradeGuard.IsNull() && !features.UpgradeGuard.IsUnknown() {
+			var feature []UpgradeGuard
+			d := features.UpgradeGuard.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			if len(feature) > 0 && !feature[0].Enabled.IsNull() && !feature[0].Enabled.IsUnknown() {
+				f.UpgradeGuard.Enabled = feature[0].Enabled.ValueBool()
+			}
+		}
+
+		f.ResponseCache.Enabled = false
+		f.ResponseCache.TtlSeconds = 30
+		if !features.ResponseCache.IsNull() && !features.ResponseCache.IsUnknown() {
+			var feature []ResponseCache
+			d := features.ResponseCache.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			if len(feature) > 0 {
+				if !feature[0].Enabled.IsNull() && !feature[0].Enabled.IsUnknown() {
+					f.ResponseCache.Enabled = feature[0].Enabled.ValueBool()
+				}
+
+				if !feature[0].TtlSeconds.IsNull() && !feature[0].TtlSeconds.IsUnknown() {
+					ttlSeconds := feature[0].TtlSeconds.ValueInt64()
+					if ttlSeconds < 1 || ttlSeconds > 300 {
+						diags.AddError("invalid `response_cache` configuration", fmt.Sprintf("`ttl_seconds` must be between 1 and 300, got %d", ttlSeconds))
+						return
+					}
+					f.ResponseCache.TtlSeconds = int(ttlSeconds)
+				}
+			}
+		}
 	}
 
 	p.clientBuilder.Features = f
+
+	p.clientBuilder.IgnoreTags = tags.IgnoreConfig{
+		Keys:        make([]string, 0),
+		KeyPrefixes: make([]string, 0),
+	}
+	if !data.IgnoreTags.IsNull() && !data.IgnoreTags.IsUnknown() {
+		var ignoreTags []IgnoreTags
+		d := data.IgnoreTags.ElementsAs(ctx, &ignoreTags, true)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(ignoreTags) > 0 {
+			if !ignoreTags[0].Keys.IsNull() && !ignoreTags[0].Keys.IsUnknown() {
+				diags.Append(ignoreTags[0].Keys.ElementsAs(ctx, &p.clientBuilder.IgnoreTags.Keys, false)...)
+			}
+			if !ignoreTags[0].KeyPrefixes.IsNull() && !ignoreTags[0].KeyPrefixes.IsUnknown() {
+				diags.Append(ignoreTags[0].KeyPrefixes.ElementsAs(ctx, &p.clientBuilder.IgnoreTags.KeyPrefixes, false)...)
+			}
+			if diags.HasError() {
+				return
+			}
+		}
+	}
+
 	p.clientBuilder.AuthConfig = authConfig
 	p.clientBuilder.CustomCorrelationRequestID = os.Getenv("ARM_CORRELATION_REQUEST_ID")
 	p.clientBuilder.TerraformVersion = tfVersion
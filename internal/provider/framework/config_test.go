@@ -93,6 +93,10 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 		t.Errorf("expected cognitive_account.purge_soft_delete_on_destroy to be true")
 	}
 
+	if features.DataFactory.LiveModeOnly {
+		t.Errorf("expected data_factory.live_mode_only to be false")
+	}
+
 	if !features.KeyVault.PurgeSoftDeleteOnDestroy {
 		t.Errorf("expected key_vault.purge_soft_delete_on_destroy to be true")
 	}
@@ -177,6 +181,10 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 		t.Errorf("expected virtual_machine.scale_to_zero_on_delete to be false")
 	}
 
+	if features.ResourceGroup.RemoveOwnedManagementLocksDuringDeletion {
+		t.Errorf("expected resource_group.remove_owned_management_locks_during_deletion to be false")
+	}
+
 	if !features.ManagedDisk.ExpandWithoutDowntime {
 		t.Errorf("expected managed_disk.expand_without_downtime to be true")
 	}
@@ -200,6 +208,34 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 	if features.RecoveryService.PurgeProtectedItemsFromVaultOnDestroy {
 		t.Errorf("expected recovery_service.PurgeProtectedItemsFromVaultOnDestroy to be false")
 	}
+
+	if features.ResourceGraphRefresh.Enabled {
+		t.Errorf("expected resource_graph_refresh.enabled to be false")
+	}
+
+	if features.ResourceGraphRefresh.LookbackHours != 24 {
+		t.Errorf("expected resource_graph_refresh.lookback_hours to be 24, got %d", features.ResourceGraphRefresh.LookbackHours)
+	}
+
+	if features.QuotaValidation.Enabled {
+		t.Errorf("expected quota_validation.enabled to be false")
+	}
+
+	if features.NameAvailabilityCheck.Enabled {
+		t.Errorf("expected name_availability_check.enabled to be false")
+	}
+
+	if features.UpgradeGuard.Enabled {
+		t.Errorf("expected upgrade_guard.enabled to be false")
+	}
+
+	if features.ResponseCache.Enabled {
+		t.Errorf("expected response_cache.enabled to be false")
+	}
+
+	if features.ResponseCache.TtlSeconds != 30 {
+		t.Errorf("expected response_cache.ttl_seconds to be 30, got %d", features.ResponseCache.TtlSeconds)
+	}
 }
 
 // TODO - helper functions to make setting up test date more easily so we can add more configuration coverage
@@ -227,6 +263,11 @@ func defaultFeaturesList() types.List {
 	})
 	cognitiveAccountList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(CognitiveAccountAttributes), []attr.Value{cognitiveAccount})
 
+	dataFactory, _ := basetypes.NewObjectValueFrom(context.Background(), DataFactoryAttributes, map[string]attr.Value{
+		"live_mode_only": basetypes.NewBoolNull(),
+	})
+	dataFactoryList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(DataFactoryAttributes), []attr.Value{dataFactory})
+
 	keyVault, _ := basetypes.NewObjectValueFrom(context.Background(), KeyVaultAttributes, map[string]attr.Value{
 		"purge_soft_delete_on_destroy":                            basetypes.NewBoolNull(),
 		"purge_soft_deleted_certificates_on_destroy":              basetypes.NewBoolNull(),
@@ -266,7 +307,8 @@ func defaultFeaturesList() types.List {
 	virtualMachineScaleSetList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(VirtualMachineScaleSetAttributes), []attr.Value{virtualMachineScaleSet})
 
 	resourceGroup, _ := basetypes.NewObjectValueFrom(context.Background(), ResourceGroupAttributes, map[string]attr.Value{
-		"prevent_deletion_if_contains_resources": basetypes.NewBoolNull(),
+		"prevent_deletion_if_contains_resources":        basetypes.NewBoolNull(),
+		"remove_owned_management_locks_during_deletion": basetypes.NewBoolNull(),
 	})
 	resourceGroupList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(ResourceGroupAttributes), []attr.Value{resourceGroup})
 
@@ -302,11 +344,39 @@ func defaultFeaturesList() types.List {
 	})
 	recoveryServicesVaultsList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes), []attr.Value{recoveryServicesVaults})
 
+	resourceGraphRefresh, _ := basetypes.NewObjectValueFrom(context.Background(), ResourceGraphRefreshAttributes, map[string]attr.Value{
+		"enabled":        basetypes.NewBoolNull(),
+		"lookback_hours": basetypes.NewInt64Null(),
+	})
+	resourceGraphRefreshList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(ResourceGraphRefreshAttributes), []attr.Value{resourceGraphRefresh})
+
+	quotaValidation, _ := basetypes.NewObjectValueFrom(context.Background(), QuotaValidationAttributes, map[string]attr.Value{
+		"enabled": basetypes.NewBoolNull(),
+	})
+	quotaValidationList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(QuotaValidationAttributes), []attr.Value{quotaValidation})
+
+	nameAvailabilityCheck, _ := basetypes.NewObjectValueFrom(context.Background(), NameAvailabilityCheckAttributes, map[string]attr.Value{
+		"enabled": basetypes.NewBoolNull(),
+	})
+	nameAvailabilityCheckList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(NameAvailabilityCheckAttributes), []attr.Value{nameAvailabilityCheck})
+
+	upgradeGuard, _ := basetypes.NewObjectValueFrom(context.Background(), UpgradeGuardAttributes, map[string]attr.Value{
+		"enabled": basetypes.NewBoolNull(),
+	})
+	upgradeGuardList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(UpgradeGuardAttributes), []attr.Value{upgradeGuard})
+
+	responseCache, _ := basetypes.NewObjectValueFrom(context.Background(), ResponseCacheAttributes, map[string]attr.Value{
+		"enabled":     basetypes.NewBoolNull(),
+		"ttl_seconds": basetypes.NewInt64Null(),
+	})
+	responseCacheList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(ResponseCacheAttributes), []attr.Value{responseCache})
+
 	fData, d := basetypes.NewObjectValue(FeaturesAttributes, map[string]attr.Value{
 		"api_management":             apiManagementList,
 		"app_configuration":          appConfigurationList,
 		"application_insights":       applicationInsightsList,
 		"cognitive_account":          cognitiveAccountList,
+		"data_factory":               dataFactoryList,
 		"key_vault":                  keyVaultList,
 		"log_analytics_workspace":    logAnalyticsWorkspaceList,
 		"template_deployment":        templateDeploymentList,
@@ -319,6 +389,11 @@ func defaultFeaturesList() types.List {
 		"machine_learning":           machineLearningList,
 		"recovery_service":           recoveryServicesList,
 		"recovery_services_vaults":   recoveryServicesVaultsList,
+		"resource_graph_refresh":     resourceGraphRefreshList,
+		"quota_validation":           quotaValidationList,
+		"name_availability_check":    nameAvailabilityCheckList,
+		"upgrade_guard":              upgradeGuardList,
+		"response_cache":             responseCacheList,
 	})
 
 	fmt.Printf("%+v", d)
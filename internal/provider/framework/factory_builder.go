@@ -34,7 +34,9 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	v2Provider := provider.AzureProvider()
 
 	providers := []func() tfprotov5.ProviderServer{
-		v2Provider.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return provider.WrapGRPCProviderServer(v2Provider.GRPCProvider())
+		},
 		providerserver.NewProtocol5(NewFrameworkProvider(v2Provider)),
 	}
 
@@ -35,16 +35,23 @@ type ProviderModel struct {
 	DisableTerraformPartnerId     types.Bool   `tfsdk:"disable_terraform_partner_id"`
 	StorageUseAzureAD             types.Bool   `tfsdk:"storage_use_azuread"`
 	Features                      types.List   `tfsdk:"features"`
+	IgnoreTags                    types.List   `tfsdk:"ignore_tags"`
 	SkipProviderRegistration      types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
 	ResourceProviderRegistrations types.String `tfsdk:"resource_provider_registrations"`
 	ResourceProvidersToRegister   types.List   `tfsdk:"resource_providers_to_register"`
 }
 
+type IgnoreTags struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
+}
+
 type Features struct {
 	APIManagement            types.List `tfsdk:"api_management"`
 	AppConfiguration         types.List `tfsdk:"app_configuration"`
 	ApplicationInsights      types.List `tfsdk:"application_insights"`
 	CognitiveAccount         types.List `tfsdk:"cognitive_account"`
+	DataFactory              types.List `tfsdk:"data_factory"`
 	KeyVault                 types.List `tfsdk:"key_vault"`
 	LogAnalyticsWorkspace    types.List `tfsdk:"log_analytics_workspace"`
 	TemplateDeployment       types.List `tfsdk:"template_deployment"`
@@ -57,6 +64,11 @@ type Features struct {
 	MachineLearning          types.List `tfsdk:"machine_learning"`
 	RecoveryService          types.List `tfsdk:"recovery_service"`
 	RecoveryServicesVaults   types.List `tfsdk:"recovery_services_vaults"`
+	ResourceGraphRefresh     types.List `tfsdk:"resource_graph_refresh"`
+	QuotaValidation          types.List `tfsdk:"quota_validation"`
+	NameAvailabilityCheck    types.List `tfsdk:"name_availability_check"`
+	UpgradeGuard             types.List `tfsdk:"upgrade_guard"`
+	ResponseCache            types.List `tfsdk:"response_cache"`
 }
 
 // FeaturesAttributes and the other block attribute vars are required for unit testing on the Load func
@@ -66,6 +78,7 @@ var FeaturesAttributes = map[string]attr.Type{
 	"app_configuration":          types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(AppConfigurationAttributes)),
 	"application_insights":       types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(ApplicationInsightsAttributes)),
 	"cognitive_account":          types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(CognitiveAccountAttributes)),
+	"data_factory":               types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(DataFactoryAttributes)),
 	"key_vault":                  types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(KeyVaultAttributes)),
 	"log_analytics_workspace":    types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(LogAnalyticsWorkspaceAttributes)),
 	"template_deployment":        types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(TemplateDeploymentAttributes)),
@@ -78,6 +91,11 @@ var FeaturesAttributes = map[string]attr.Type{
 	"machine_learning":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(MachineLearningAttributes)),
 	"recovery_service":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceAttributes)),
 	"recovery_services_vaults":   types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes)),
+	"resource_graph_refresh":     types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(ResourceGraphRefreshAttributes)),
+	"quota_validation":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(QuotaValidationAttributes)),
+	"name_availability_check":    types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(NameAvailabilityCheckAttributes)),
+	"upgrade_guard":              types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(UpgradeGuardAttributes)),
+	"response_cache":             types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(ResponseCacheAttributes)),
 }
 
 type APIManagement struct {
@@ -116,6 +134,14 @@ var CognitiveAccountAttributes = map[string]attr.Type{
 	"purge_soft_delete_on_destroy": types.BoolType,
 }
 
+type DataFactory struct {
+	LiveModeOnly types.Bool `tfsdk:"live_mode_only"`
+}
+
+var DataFactoryAttributes = map[string]attr.Type{
+	"live_mode_only": types.BoolType,
+}
+
 type KeyVault struct {
 	PurgeSoftDeleteOnDestroy                             types.Bool `tfsdk:"purge_soft_delete_on_destroy"`
 	PurgeSoftDeletedCertificatesOnDestroy                types.Bool `tfsdk:"purge_soft_deleted_certificates_on_destroy"`
@@ -189,11 +215,13 @@ var VirtualMachineScaleSetAttributes = map[string]attr.Type{
 }
 
 type ResourceGroup struct {
-	PreventDeletionIfContainsResources types.Bool `tfsdk:"prevent_deletion_if_contains_resources"`
+	PreventDeletionIfContainsResources       types.Bool `tfsdk:"prevent_deletion_if_contains_resources"`
+	RemoveOwnedManagementLocksDuringDeletion types.Bool `tfsdk:"remove_owned_management_locks_during_deletion"`
 }
 
 var ResourceGroupAttributes = map[string]attr.Type{
-	"prevent_deletion_if_contains_resources": types.BoolType,
+	"prevent_deletion_if_contains_resources":        types.BoolType,
+	"remove_owned_management_locks_during_deletion": types.BoolType,
 }
 
 type ManagedDisk struct {
@@ -245,3 +273,47 @@ type RecoveryServiceVaults struct {
 var RecoveryServiceVaultsAttributes = map[string]attr.Type{
 	"recover_soft_deleted_backup_protected_vm": types.BoolType,
 }
+
+type ResourceGraphRefresh struct {
+	Enabled       types.Bool  `tfsdk:"enabled"`
+	LookbackHours types.Int64 `tfsdk:"lookback_hours"`
+}
+
+var ResourceGraphRefreshAttributes = map[string]attr.Type{
+	"enabled":        types.BoolType,
+	"lookback_hours": types.Int64Type,
+}
+
+type QuotaValidation struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+var QuotaValidationAttributes = map[string]attr.Type{
+	"enabled": types.BoolType,
+}
+
+type NameAvailabilityCheck struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+var NameAvailabilityCheckAttributes = map[string]attr.Type{
+	"enabled": types.BoolType,
+}
+
+type UpgradeGuard struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+var UpgradeGuardAttributes = map[string]attr.Type{
+	"enabled": types.BoolType,
+}
+
+type ResponseCache struct {
+	Enabled    types.Bool  `tfsdk:"enabled"`
+	TtlSeconds types.Int64 `tfsdk:"ttl_seconds"`
+}
+
+var ResponseCacheAttributes = map[string]attr.Type{
+	"enabled":     types.BoolType,
+	"ttl_seconds": types.Int64Type,
+}
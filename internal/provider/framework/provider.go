@@ -91,7 +91,7 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 
 			"metadata_host": schema.StringAttribute{
 				Optional:    true,
-				Description: "The Hostname which should be used for the Azure Metadata Service.",
+				Description: "The Hostname (or `https://` URI) which should be used for the Azure Metadata Service, from which the endpoints for the Cloud Environment are discovered.",
 			},
 
 			// Client Certificate specific fields
@@ -225,6 +225,28 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 		},
 
 		Blocks: map[string]schema.Block{
+			"ignore_tags": schema.ListNestedBlock{
+				Description: "The tags which are managed outside of Terraform (for example by Azure Policy) and should be ignored across all resources.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "A list of tag keys which should be ignored.",
+						},
+
+						"key_prefixes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "A list of tag key prefixes, where any tag key starting with one of these prefixes should be ignored.",
+						},
+					},
+				},
+			},
+
 			"features": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeBetween(1, 1),
@@ -275,6 +297,16 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 								},
 							},
 						},
+						"data_factory": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"live_mode_only": schema.BoolAttribute{
+										Description: "When enabled, Data Factory child resources can only be managed in Data Factories which aren't configured with a git repository",
+										Optional:    true,
+									},
+								},
+							},
+						},
 						"key_vault": schema.ListNestedBlock{
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
@@ -395,6 +427,10 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 									"prevent_deletion_if_contains_resources": schema.BoolAttribute{
 										Optional: true,
 									},
+									"remove_owned_management_locks_during_deletion": schema.BoolAttribute{
+										Description: "When enabled, Management Locks owned by the authenticated Application will be removed when deleting a Resource Group",
+										Optional:    true,
+									},
 								},
 							},
 						},
@@ -455,6 +491,64 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 								},
 							},
 						},
+						"resource_graph_refresh": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Description: "When enabled, refreshes of resources which haven't changed since they were last read keep their existing state instead of being read from the API",
+										Optional:    true,
+									},
+									"lookback_hours": schema.Int64Attribute{
+										Description: "The maximum number of hours since a resource was last read for its refresh to be skipped, between 1 and 336. Defaults to 24",
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"quota_validation": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Description: "When enabled, creating, resizing or scaling Virtual Machines, Virtual Machine Scale Sets and Kubernetes Cluster Node Pools checks the available quota during the plan",
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"name_availability_check": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Description: "When enabled, creating resources with globally unique names checks the name is available during the plan",
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"upgrade_guard": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Description: "When enabled, refreshing a resource which has a value set for a deprecated field returns a warning",
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"response_cache": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Description: "When enabled, GET responses for parent resources which are read by many child resources are cached for `ttl_seconds`",
+										Optional:    true,
+									},
+									"ttl_seconds": schema.Int64Attribute{
+										Description: "The number of seconds a cached response is valid for, between 1 and 300. Defaults to 30",
+										Optional:    true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	sdkprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// TestProvider_featuresSchemaMatchesSDKProvider ensures the features blocks match between both providers, since
+// the mux server requires identical provider schemas
+func TestProvider_featuresSchemaMatchesSDKProvider(t *testing.T) {
+	resp := &provider.SchemaResponse{}
+	NewFrameworkV5Provider().Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+	frameworkFeatures := resp.Schema.Blocks["features"].(schema.ListNestedBlock).NestedObject.Blocks
+	sdkFeatures := sdkprovider.AzureProvider().Schema["features"].Elem.(*pluginsdk.Resource).Schema
+
+	for name := range frameworkFeatures {
+		if _, ok := sdkFeatures[name]; !ok {
+			t.Errorf("features block %q is defined in the framework provider but not in the SDK provider", name)
+		}
+	}
+
+	for name, sdkBlock := range sdkFeatures {
+		block, ok := frameworkFeatures[name]
+		if !ok {
+			t.Errorf("features block %q is defined in the SDK provider but not in the framework provider", name)
+			continue
+		}
+
+		expected := make([]string, 0)
+		for k := range sdkBlock.Elem.(*pluginsdk.Resource).Schema {
+			expected = append(expected, k)
+		}
+		sort.Strings(expected)
+
+		actual := make([]string, 0)
+		for k := range block.(schema.ListNestedBlock).NestedObject.Attributes {
+			actual = append(actual, k)
+		}
+		sort.Strings(actual)
+
+		if len(expected) != len(actual) {
+			t.Errorf("features block %q: expected the attributes %v but got %v", name, expected, actual)
+			continue
+		}
+		for i := range expected {
+			if expected[i] != actual[i] {
+				t.Errorf("features block %q: expected the attributes %v but got %v", name, expected, actual)
+				break
+			}
+		}
+	}
+}
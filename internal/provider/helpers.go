@@ -7,6 +7,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"strings"
 
@@ -26,6 +27,36 @@ func logEntry(f string, v ...interface{}) {
 	log.Printf(f, v...)
 }
 
+// MetadataEndpoint returns the URI of the Azure Metadata Service for the `metadata_host`, which can be specified
+// either as a Hostname (optionally including a port, for example `management.contoso.internal:8443`)
+// or as an `https://` URI - all other endpoints for the Cloud Environment are then discovered from this service
+func MetadataEndpoint(metadataHost string) (string, error) {
+	input := strings.TrimSuffix(strings.TrimSpace(metadataHost), "/")
+	if input == "" {
+		return "", fmt.Errorf("`metadata_host` must not be empty")
+	}
+
+	if !strings.Contains(input, "://") {
+		input = fmt.Sprintf("https://%s", input)
+	}
+
+	uri, err := url.Parse(input)
+	if err != nil {
+		return "", fmt.Errorf("parsing `metadata_host` %q: %+v", metadataHost, err)
+	}
+	if !strings.EqualFold(uri.Scheme, "https") {
+		return "", fmt.Errorf("`metadata_host` must use `https` but got %q", uri.Scheme)
+	}
+	if uri.Host == "" {
+		return "", fmt.Errorf("`metadata_host` must specify a Hostname but got %q", metadataHost)
+	}
+	if uri.Path != "" || uri.RawQuery != "" || uri.Fragment != "" {
+		return "", fmt.Errorf("`metadata_host` must only specify the Hostname (and optionally a port) for the Azure Metadata Service but got %q", metadataHost)
+	}
+
+	return fmt.Sprintf("https://%s", uri.Host), nil
+}
+
 func decodeCertificate(clientCertificate string) ([]byte, error) {
 	var pfx []byte
 	if clientCertificate != "" {
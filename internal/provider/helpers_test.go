@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestMetadataEndpoint(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input:    "management.azure.com",
+			Expected: "https://management.azure.com",
+		},
+		{
+			Input:    "management.contoso.internal:8443",
+			Expected: "https://management.contoso.internal:8443",
+		},
+		{
+			Input:    "https://management.contoso.internal/",
+			Expected: "https://management.contoso.internal",
+		},
+		{
+			Input:    " HTTPS://management.contoso.internal:8443 ",
+			Expected: "https://management.contoso.internal:8443",
+		},
+		{
+			Input: "http://management.contoso.internal",
+			Error: true,
+		},
+		{
+			Input: "https://",
+			Error: true,
+		},
+		{
+			Input: "management.contoso.internal/metadata/endpoints",
+			Error: true,
+		},
+		{
+			Input: "https://management.contoso.internal?api-version=2022-09-01",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := MetadataEndpoint(v.Input)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but got %q", actual)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+func expandIgnoreTags(input []interface{}) tags.IgnoreConfig {
+	config := tags.IgnoreConfig{
+		Keys:        make([]string, 0),
+		KeyPrefixes: make([]string, 0),
+	}
+	if len(input) == 0 || input[0] == nil {
+		return config
+	}
+
+	raw := input[0].(map[string]interface{})
+	if v, ok := raw["keys"].(*schema.Set); ok {
+		for _, key := range v.List() {
+			config.Keys = append(config.Keys, key.(string))
+		}
+	}
+	if v, ok := raw["key_prefixes"].(*schema.Set); ok {
+		for _, prefix := range v.List() {
+			config.KeyPrefixes = append(config.KeyPrefixes, prefix.(string))
+		}
+	}
+
+	return config
+}
+
+// wrapWithIgnoreTags wraps the Create, Read and Update functions for the specified Resource so that any tags matching
+// the `ignore_tags` block in the Provider configuration are removed from the `tags` field once these have completed -
+// meaning that tags managed outside of Terraform (for example by Azure Policy) don't produce a diff.
+//
+// Since updating a resource sends the `tags` field to Azure as-is, the Update functions are also wrapped so that any
+// ignored tags which currently exist on the resource are added to the `tags` field beforehand - retaining these tags.
+func wrapWithIgnoreTags(resource *schema.Resource) {
+	if !supportsIgnoreTags(resource) {
+		return
+	}
+
+	// the existing tags are retrieved using the Read function prior to it removing the ignored tags
+	read := readContextFunc(resource)
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = wrapContextFuncWithIgnoreTags(resource.CreateContext)
+	}
+	if resource.CreateWithoutTimeout != nil {
+		resource.CreateWithoutTimeout = wrapContextFuncWithIgnoreTags(resource.CreateWithoutTimeout)
+	}
+	if resource.Create != nil { //nolint:staticcheck
+		resource.Create = wrapFuncWithIgnoreTags(resource.Create) //nolint:staticcheck
+	}
+
+	if resource.ReadContext != nil {
+		resource.ReadContext = wrapContextFuncWithIgnoreTags(resource.ReadContext)
+	}
+	if resource.ReadWithoutTimeout != nil {
+		resource.ReadWithoutTimeout = wrapContextFuncWithIgnoreTags(resource.ReadWithoutTimeout)
+	}
+	if resource.Read != nil { //nolint:staticcheck
+		resource.Read = wrapFuncWithIgnoreTags(resource.Read) //nolint:staticcheck
+	}
+
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = wrapUpdateContextFuncWithIgnoreTags(resource, read, resource.UpdateContext)
+	}
+	if resource.UpdateWithoutTimeout != nil {
+		resource.UpdateWithoutTimeout = wrapUpdateContextFuncWithIgnoreTags(resource, read, resource.UpdateWithoutTimeout)
+	}
+	if resource.Update != nil { //nolint:staticcheck
+		resource.Update = wrapUpdateFuncWithIgnoreTags(resource, read, resource.Update) //nolint:staticcheck
+	}
+}
+
+// readContextFunc returns the Read function for the specified Resource as a context-aware function
+func readContextFunc(resource *schema.Resource) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	switch {
+	case resource.ReadContext != nil:
+		return resource.ReadContext
+
+	case resource.ReadWithoutTimeout != nil:
+		return resource.ReadWithoutTimeout
+
+	case resource.Read != nil: //nolint:staticcheck
+		read := resource.Read //nolint:staticcheck
+		return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(read(d, meta))
+		}
+	}
+
+	return nil
+}
+
+// supportsIgnoreTags returns whether the Resource exposes a user-configurable top-level `tags` map
+func supportsIgnoreTags(resource *schema.Resource) bool {
+	v, ok := resource.SchemaMap()["tags"]
+	if !ok || v.Type != schema.TypeMap {
+		return false
+	}
+	return v.Optional || v.Required
+}
+
+func wrapContextFuncWithIgnoreTags(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		if err := removeIgnoredTags(d, meta); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func wrapFuncWithIgnoreTags(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil {
+			return err
+		}
+		return removeIgnoredTags(d, meta)
+	}
+}
+
+func wrapUpdateContextFuncWithIgnoreTags(resource *schema.Resource, read func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	update := wrapContextFuncWithIgnoreTags(f)
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := retainIgnoredTags(ctx, resource, read, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		return update(ctx, d, meta)
+	}
+}
+
+func wrapUpdateFuncWithIgnoreTags(resource *schema.Resource, read func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	update := wrapFuncWithIgnoreTags(f)
+	return func(d *schema.ResourceData, meta interface{}) error {
+		ctx := context.Background()
+		if client, ok := meta.(*clients.Client); ok && client.StopContext != nil {
+			ctx = client.StopContext
+		}
+		if err := retainIgnoredTags(ctx, resource, read, d, meta); err != nil {
+			return err
+		}
+		return update(d, meta)
+	}
+}
+
+// retainIgnoredTags adds any tags matching the `ignore_tags` block in the Provider configuration which currently exist
+// on the resource to the `tags` field, so that these are sent to Azure alongside the configured tags when updating it
+func retainIgnoredTags(ctx context.Context, resource *schema.Resource, read func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || !client.IgnoreTags.Enabled() || read == nil {
+		return nil
+	}
+
+	// the existing tags are read into a copy, to avoid overwriting the configured values for the update
+	existing := resource.Data(d.State())
+	for _, v := range read(ctx, existing, meta) {
+		if v.Severity == diag.Error {
+			return fmt.Errorf("retrieving the existing tags to retain the tags specified in `ignore_tags`: %s: %s", v.Summary, v.Detail)
+		}
+	}
+
+	// the resource has been removed, so there's nothing to retain
+	if existing.Id() == "" {
+		return nil
+	}
+
+	existingTags, ok := existing.Get("tags").(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	configuredTags, ok := d.Get("tags").(map[string]interface{})
+	if !ok {
+		configuredTags = make(map[string]interface{})
+	}
+
+	merged := make(map[string]interface{}, len(configuredTags))
+	for k, v := range configuredTags {
+		merged[k] = v
+	}
+
+	retained := false
+	for k, v := range existingTags {
+		if !client.IgnoreTags.IsIgnored(k) || hasTagKey(merged, k) {
+			continue
+		}
+		merged[k] = v
+		retained = true
+	}
+
+	if !retained {
+		return nil
+	}
+
+	if err := d.Set("tags", merged); err != nil {
+		return fmt.Errorf("setting `tags` to retain the tags specified in `ignore_tags`: %+v", err)
+	}
+
+	return nil
+}
+
+// hasTagKey returns whether the tags contain the specified key, since tag keys in Azure are case-insensitive
+func hasTagKey(input map[string]interface{}, key string) bool {
+	for k := range input {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeIgnoredTags removes any tags matching the `ignore_tags` block in the Provider configuration from the state
+func removeIgnoredTags(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || !client.IgnoreTags.Enabled() {
+		return nil
+	}
+
+	// the resource has been removed (or was never created)
+	if d.Id() == "" {
+		return nil
+	}
+
+	existing, ok := d.Get("tags").(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	filtered, removed := client.IgnoreTags.RemoveIgnored(existing)
+	if !removed {
+		return nil
+	}
+
+	if err := d.Set("tags", filtered); err != nil {
+		return fmt.Errorf("setting `tags` after removing the tags specified in `ignore_tags`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+func TestExpandIgnoreTags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected tags.IgnoreConfig
+	}{
+		{
+			Name:  "Empty Block",
+			Input: []interface{}{},
+			Expected: tags.IgnoreConfig{
+				Keys:        []string{},
+				KeyPrefixes: []string{},
+			},
+		},
+		{
+			Name: "Keys and Key Prefixes",
+			Input: []interface{}{
+				map[string]interface{}{
+					"keys":         schema.NewSet(schema.HashString, []interface{}{"CreatedBy"}),
+					"key_prefixes": schema.NewSet(schema.HashString, []interface{}{"hidden-"}),
+				},
+			},
+			Expected: tags.IgnoreConfig{
+				Keys:        []string{"CreatedBy"},
+				KeyPrefixes: []string{"hidden-"},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandIgnoreTags(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
+
+func TestWrapWithIgnoreTags(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.Set("tags", map[string]interface{}{
+				"environment": "production",
+				"CreatedBy":   "policy",
+				"hidden-link": "/subscriptions/00000000-0000-0000-0000-000000000000",
+			})
+			return nil
+		},
+	}
+	wrapWithIgnoreTags(resource)
+
+	client := &clients.Client{
+		IgnoreTags: tags.IgnoreConfig{
+			Keys:        []string{"createdby"},
+			KeyPrefixes: []string{"hidden-"},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+	if diags := resource.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading: %+v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"environment": "production",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestWrapWithIgnoreTagsRetainsIgnoredTagsOnUpdate(t *testing.T) {
+	// remote represents the tags which exist on the resource in Azure
+	remote := map[string]interface{}{
+		"environment": "production",
+		"CreatedBy":   "policy",
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			existing := make(map[string]interface{}, len(remote))
+			for k, v := range remote {
+				existing[k] = v
+			}
+			d.Set("tags", existing)
+			return nil
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			// the tags are replaced with those being sent, as when the resource is updated using a PUT
+			remote = d.Get("tags").(map[string]interface{})
+			return nil
+		},
+	}
+	wrapWithIgnoreTags(resource)
+
+	client := &clients.Client{
+		IgnoreTags: tags.IgnoreConfig{
+			Keys: []string{"createdby"},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "staging",
+		},
+	})
+	d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+	if diags := resource.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("updating: %+v", diags)
+	}
+
+	expectedRemote := map[string]interface{}{
+		"environment": "staging",
+		"CreatedBy":   "policy",
+	}
+	if !reflect.DeepEqual(remote, expectedRemote) {
+		t.Fatalf("Expected the remote tags to be %+v but got %+v", expectedRemote, remote)
+	}
+
+	expected := map[string]interface{}{
+		"environment": "staging",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
@@ -139,6 +139,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	for resourceType, resource := range resources {
+		wrapReadWithResourceGraphRefresh(resource)
+		wrapWithIgnoreTags(resource)
+		wrapReadWithUpgradeGuard(resourceType, resource)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -175,7 +181,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_METADATA_HOSTNAME", nil),
-				Description: "The Hostname which should be used for the Azure Metadata Service.",
+				Description: "The Hostname (or `https://` URI) which should be used for the Azure Metadata Service, from which the endpoints for the Cloud Environment are discovered.",
 			},
 
 			"client_id": {
@@ -321,6 +327,36 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The tags which are managed outside of Terraform (for example by Azure Policy) and should be ignored across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "A list of tag keys which should be ignored.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "A list of tag key prefixes, where any tag key starting with one of these prefixes should be ignored.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
 			// Advanced feature flags
 			"resource_provider_registrations": {
 				Type:        schema.TypeString,
@@ -433,10 +469,18 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 		)
 
 		if metadataHost != "" {
-			logEntry("[DEBUG] Configuring cloud environment from Metadata Service at %q", metadataHost)
-			if env, err = environments.FromEndpoint(ctx, fmt.Sprintf("https://%s", metadataHost)); err != nil {
+			metadataEndpoint, endpointErr := MetadataEndpoint(metadataHost)
+			if endpointErr != nil {
+				return nil, diag.FromErr(endpointErr)
+			}
+
+			logEntry("[DEBUG] Configuring cloud environment from Metadata Service at %q", metadataEndpoint)
+			if env, err = environments.FromEndpoint(ctx, metadataEndpoint); err != nil {
 				return nil, diag.FromErr(err)
 			}
+			if endpoint, ok := env.ResourceManager.Endpoint(); ok {
+				logEntry("[DEBUG] Discovered cloud environment %q with the Resource Manager endpoint %q", env.Name, *endpoint)
+			}
 		} else {
 			logEntry("[DEBUG] Configuring built-in cloud environment by name: %q", envName)
 			if env, err = environments.FromName(envName); err != nil {
@@ -516,6 +560,7 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
+		IgnoreTags:                  expandIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
 		RegisteredResourceProviders: requiredResourceProviders,
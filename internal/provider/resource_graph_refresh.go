@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// resourceGraphRefreshLastReadKey is the key within the private state of a resource which contains the time at which
+// the resource was last read from the API
+const resourceGraphRefreshLastReadKey = "azurerm_resource_graph_refresh_last_read"
+
+type resourceGraphRefreshContextKey struct{}
+
+// resourceGraphRefreshRead tracks a single refresh of a resource, so that the time at which the resource was last read
+// can be passed from (and back to) the private state of the resource
+type resourceGraphRefreshRead struct {
+	lastRead time.Time
+	enabled  bool
+	skipped  bool
+}
+
+// AzureGRPCProvider returns the gRPC Provider Server for the Plugin SDKv2 Provider, which records the time at which
+// each resource was last read in the private state of the resource for the `resource_graph_refresh` feature
+func AzureGRPCProvider() tfprotov5.ProviderServer {
+	return WrapGRPCProviderServer(AzureProvider().GRPCProvider())
+}
+
+// WrapGRPCProviderServer wraps the specified gRPC Provider Server so that the time at which each resource was last
+// read is recorded in the private state of the resource, which is required by the `resource_graph_refresh` feature
+func WrapGRPCProviderServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return resourceGraphRefreshProviderServer{
+		ProviderServer: server,
+	}
+}
+
+type resourceGraphRefreshProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s resourceGraphRefreshProviderServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	private := make(map[string]interface{})
+	if len(req.Private) > 0 {
+		if err := json.Unmarshal(req.Private, &private); err != nil {
+			// the Plugin SDK surfaces this error when reading the resource
+			return s.ProviderServer.ReadResource(ctx, req)
+		}
+	}
+
+	refresh := &resourceGraphRefreshRead{}
+	if v, ok := private[resourceGraphRefreshLastReadKey].(string); ok {
+		if lastRead, err := time.Parse(time.RFC3339, v); err == nil {
+			refresh.lastRead = lastRead
+		}
+	}
+
+	readTime := time.Now()
+	resp, err := s.ProviderServer.ReadResource(context.WithValue(ctx, resourceGraphRefreshContextKey{}, refresh), req)
+	if err != nil || resp == nil || !refresh.enabled || refresh.skipped {
+		return resp, err
+	}
+	for _, d := range resp.Diagnostics {
+		if d != nil && d.Severity == tfprotov5.DiagnosticSeverityError {
+			return resp, err
+		}
+	}
+
+	// the resource was read from the API, so the time this started is recorded for the next refresh - any changes
+	// made by applying the resource reset this, meaning the next refresh always reads the resource
+	private[resourceGraphRefreshLastReadKey] = readTime.UTC().Format(time.RFC3339)
+	updatedPrivate, err := json.Marshal(private)
+	if err != nil {
+		log.Printf("[WARN] recording the time the resource was last read, it will be refreshed next time: %+v", err)
+		return resp, nil
+	}
+	resp.Private = updatedPrivate
+
+	return resp, nil
+}
+
+// wrapReadWithResourceGraphRefresh wraps the Read function for the specified Resource so that, when the
+// `resource_graph_refresh` feature is enabled, the Read is skipped for resources which Resource Graph reports as
+// unchanged since they were last read - retaining the existing state for these resources.
+//
+// NOTE: this only applies when Terraform refreshes the resource (via the gRPC Provider Server returned from
+// WrapGRPCProviderServer), since Create and Update call the resource's Read function directly.
+func wrapReadWithResourceGraphRefresh(resource *schema.Resource) {
+	switch {
+	case resource.ReadContext != nil:
+		read := resource.ReadContext
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if canSkipRead(ctx, d, meta) {
+				return nil
+			}
+			return read(ctx, d, meta)
+		}
+
+	case resource.ReadWithoutTimeout != nil:
+		read := resource.ReadWithoutTimeout
+		resource.ReadWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if canSkipRead(ctx, d, meta) {
+				return nil
+			}
+			return read(ctx, d, meta)
+		}
+
+	case resource.Read != nil: //nolint:staticcheck
+		// the legacy Read function doesn't receive the context of the refresh, so this is replaced with a ReadContext
+		read := resource.Read //nolint:staticcheck
+		resource.Read = nil   //nolint:staticcheck
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if canSkipRead(ctx, d, meta) {
+				return nil
+			}
+			return diag.FromErr(read(d, meta))
+		}
+	}
+}
+
+func canSkipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) bool {
+	client, ok := meta.(*clients.Client)
+	if !ok || client.Resource == nil || client.Resource.ResourceGraphRefreshCache == nil {
+		return false
+	}
+
+	refresh, ok := ctx.Value(resourceGraphRefreshContextKey{}).(*resourceGraphRefreshRead)
+	if !ok {
+		return false
+	}
+	refresh.enabled = true
+
+	// resources which have never been read (or which were changed by the last apply) must be read, this includes
+	// resources which are being imported
+	if d.Id() == "" || refresh.lastRead.IsZero() {
+		return false
+	}
+
+	if !client.Resource.ResourceGraphRefreshCache.IsUnchangedSince(ctx, d.Id(), refresh.lastRead) {
+		return false
+	}
+
+	refresh.skipped = true
+	log.Printf("[DEBUG] Skipping the refresh of %q since Resource Graph reports it as unchanged since %s", d.Id(), refresh.lastRead.Format(time.RFC3339))
+	return true
+}
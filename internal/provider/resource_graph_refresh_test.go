@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// resourceGraphRefreshTestServer simulates the refresh of a resource, where the Read is skipped when a last read time
+// is available
+type resourceGraphRefreshTestServer struct {
+	tfprotov5.ProviderServer
+
+	enabled bool
+}
+
+func (s resourceGraphRefreshTestServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	if refresh, ok := ctx.Value(resourceGraphRefreshContextKey{}).(*resourceGraphRefreshRead); ok && s.enabled {
+		refresh.enabled = true
+		refresh.skipped = !refresh.lastRead.IsZero()
+	}
+
+	return &tfprotov5.ReadResourceResponse{
+		Private: req.Private,
+	}, nil
+}
+
+func TestResourceGraphRefreshProviderServerReadResource(t *testing.T) {
+	lastRead := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC).Format(time.RFC3339)
+
+	testData := []struct {
+		Name           string
+		Enabled        bool
+		Private        string
+		ExpectRecorded bool
+	}{
+		{
+			Name:           "Disabled",
+			Enabled:        false,
+			Private:        `{"schema_version":"1"}`,
+			ExpectRecorded: false,
+		},
+		{
+			Name:           "Never Read",
+			Enabled:        true,
+			Private:        `{"schema_version":"1"}`,
+			ExpectRecorded: true,
+		},
+		{
+			Name:           "Skipped",
+			Enabled:        true,
+			Private:        `{"schema_version":"1","` + resourceGraphRefreshLastReadKey + `":"` + lastRead + `"}`,
+			ExpectRecorded: false,
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+
+		server := WrapGRPCProviderServer(resourceGraphRefreshTestServer{enabled: testCase.Enabled})
+		resp, err := server.ReadResource(context.TODO(), &tfprotov5.ReadResourceRequest{
+			Private: []byte(testCase.Private),
+		})
+		if err != nil {
+			t.Fatalf("reading resource: %+v", err)
+		}
+
+		if !testCase.ExpectRecorded {
+			if string(resp.Private) != testCase.Private {
+				t.Fatalf("expected the private state to be unchanged but got %s", string(resp.Private))
+			}
+			continue
+		}
+
+		private := make(map[string]interface{})
+		if err := json.Unmarshal(resp.Private, &private); err != nil {
+			t.Fatalf("unmarshaling private state: %+v", err)
+		}
+		if private["schema_version"] != "1" {
+			t.Fatalf("expected the existing private state to be retained but got %s", string(resp.Private))
+		}
+		v, ok := private[resourceGraphRefreshLastReadKey].(string)
+		if !ok {
+			t.Fatalf("expected the last read time to be recorded but got %s", string(resp.Private))
+		}
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			t.Fatalf("parsing the last read time %q: %+v", v, err)
+		}
+	}
+}
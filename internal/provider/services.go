@@ -104,6 +104,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/quota"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redhatopenshift"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis"
@@ -141,6 +142,7 @@ import (
 func SupportedTypedServices() []sdk.TypedServiceRegistration {
 	services := []sdk.TypedServiceRegistration{
 		aadb2c.Registration{},
+		advisor.Registration{},
 		apimanagement.Registration{},
 		appconfiguration.Registration{},
 		applicationinsights.Registration{},
@@ -170,6 +172,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		disks.Registration{},
 		domainservices.Registration{},
 		elasticsan.Registration{},
+		eventgrid.Registration{},
 		eventhub.Registration{},
 		fluidrelay.Registration{},
 		graphservices.Registration{},
@@ -200,6 +203,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		paloalto.Registration{},
 		policy.Registration{},
 		privatednsresolver.Registration{},
+		quota.Registration{},
 		recoveryservices.Registration{},
 		redis.Registration{},
 		redhatopenshift.Registration{},
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// wrapReadWithUpgradeGuard wraps the Read function for the specified Resource so that, when the `upgrade_guard`
+// feature is enabled, a warning is returned for each deprecated field which is set in the state - surfacing these
+// during a plan, rather than when the next major version of the Provider removes them.
+//
+// NOTE: Resources using the legacy `Read` function are switched over to `ReadContext`, since only the latter can
+// return warnings - as such only Resources which contain deprecated fields are wrapped.
+func wrapReadWithUpgradeGuard(resourceType string, resource *schema.Resource) {
+	if !hasDeprecatedFields(resource.SchemaMap()) {
+		return
+	}
+
+	switch {
+	case resource.ReadContext != nil:
+		read := resource.ReadContext
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := read(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, upgradeGuardWarnings(resourceType, resource.SchemaMap(), d, meta)...)
+		}
+
+	case resource.ReadWithoutTimeout != nil:
+		read := resource.ReadWithoutTimeout
+		resource.ReadWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := read(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, upgradeGuardWarnings(resourceType, resource.SchemaMap(), d, meta)...)
+		}
+
+	case resource.Read != nil: //nolint:staticcheck
+		read := resource.Read //nolint:staticcheck
+		resource.Read = nil   //nolint:staticcheck
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := read(d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+			return upgradeGuardWarnings(resourceType, resource.SchemaMap(), d, meta)
+		}
+	}
+}
+
+func upgradeGuardWarnings(resourceType string, schemaMap map[string]*schema.Schema, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, ok := meta.(*clients.Client)
+	if !ok || !client.Features.UpgradeGuard.Enabled {
+		return nil
+	}
+
+	// the resource has been removed
+	if d.Id() == "" {
+		return nil
+	}
+
+	values := make(map[string]interface{})
+	for key := range schemaMap {
+		values[key] = d.Get(key)
+	}
+
+	inUse := make(map[string]string)
+	findDeprecatedFieldsInUse(schemaMap, values, "", inUse)
+
+	paths := make([]string, 0, len(inUse))
+	for path := range inUse {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	diags := make(diag.Diagnostics, 0, len(paths))
+	for _, path := range paths {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("`%s` uses the deprecated field `%s`", resourceType, path),
+			Detail: fmt.Sprintf("The %s %q has a value for `%s` in the state: %s\n\nThis field will stop working in the next major version of the AzureRM Provider - update the configuration before upgrading the Provider.",
+				resourceType, d.Id(), path, inUse[path]),
+		})
+	}
+	return diags
+}
+
+// findDeprecatedFieldsInUse populates `output` with the path and deprecation message for each deprecated field
+// which has a non-default value within `values`, walking into any nested blocks
+func findDeprecatedFieldsInUse(schemaMap map[string]*schema.Schema, values map[string]interface{}, prefix string, output map[string]string) {
+	for key, s := range schemaMap {
+		value, ok := values[key]
+		if !ok || !hasValue(value) {
+			continue
+		}
+
+		path := prefix + key
+		if s.Deprecated != "" && (s.Optional || s.Required) {
+			if s.Default == nil || !reflect.DeepEqual(value, s.Default) {
+				output[path] = s.Deprecated
+			}
+		}
+
+		nested, ok := s.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+
+		items := make([]interface{}, 0)
+		switch v := value.(type) {
+		case []interface{}:
+			items = v
+		case *schema.Set:
+			items = v.List()
+		}
+		for _, item := range items {
+			if raw, ok := item.(map[string]interface{}); ok {
+				findDeprecatedFieldsInUse(nested.SchemaMap(), raw, path+".", output)
+			}
+		}
+	}
+}
+
+func hasDeprecatedFields(schemaMap map[string]*schema.Schema) bool {
+	for _, s := range schemaMap {
+		if s.Deprecated != "" && (s.Optional || s.Required) {
+			return true
+		}
+		if nested, ok := s.Elem.(*schema.Resource); ok && hasDeprecatedFields(nested.SchemaMap()) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasValue(input interface{}) bool {
+	switch v := input.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case bool:
+		return v
+	case int:
+		return v != 0
+	case float64:
+		return v != 0
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	case *schema.Set:
+		return v.Len() > 0
+	}
+	return true
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+func TestWrapReadWithUpgradeGuard(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Enabled  bool
+		Expected []string
+	}{
+		{
+			Name:     "Disabled",
+			Enabled:  false,
+			Expected: []string{},
+		},
+		{
+			Name:    "Enabled",
+			Enabled: true,
+			Expected: []string{
+				"`azurerm_example` uses the deprecated field `addon.legacy_enabled`",
+				"`azurerm_example` uses the deprecated field `legacy_name`",
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+
+		resource := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"legacy_name": {
+					Type:       schema.TypeString,
+					Optional:   true,
+					Deprecated: "`legacy_name` has been deprecated in favour of `name`",
+				},
+				"legacy_unset": {
+					Type:       schema.TypeString,
+					Optional:   true,
+					Deprecated: "`legacy_unset` has been deprecated",
+				},
+				"legacy_default": {
+					Type:       schema.TypeBool,
+					Optional:   true,
+					Default:    true,
+					Deprecated: "`legacy_default` has been deprecated",
+				},
+				"addon": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"legacy_enabled": {
+								Type:       schema.TypeBool,
+								Optional:   true,
+								Deprecated: "`legacy_enabled` has been deprecated",
+							},
+						},
+					},
+				},
+			},
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				d.Set("name", "example")
+				d.Set("legacy_name", "example")
+				d.Set("legacy_default", true)
+				d.Set("addon", []interface{}{
+					map[string]interface{}{
+						"legacy_enabled": true,
+					},
+				})
+				return nil
+			},
+		}
+		wrapReadWithUpgradeGuard("azurerm_example", resource)
+
+		if resource.Read != nil { //nolint:staticcheck
+			t.Fatalf("expected the legacy Read function to be replaced")
+		}
+
+		client := &clients.Client{
+			Features: features.UserFeatures{
+				UpgradeGuard: features.UpgradeGuardFeatures{
+					Enabled: testCase.Enabled,
+				},
+			},
+		}
+
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+		d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+		diags := resource.ReadContext(context.Background(), d, client)
+		if diags.HasError() {
+			t.Fatalf("reading: %+v", diags)
+		}
+
+		if len(diags) != len(testCase.Expected) {
+			t.Fatalf("expected %d warnings but got %d: %+v", len(testCase.Expected), len(diags), diags)
+		}
+		for i, expected := range testCase.Expected {
+			if diags[i].Severity != diag.Warning {
+				t.Fatalf("expected diagnostic %d to be a warning but got %+v", i, diags[i])
+			}
+			if diags[i].Summary != expected {
+				t.Fatalf("expected diagnostic %d to be %q but got %q", i, expected, diags[i].Summary)
+			}
+		}
+	}
+}
+
+func TestWrapReadWithUpgradeGuardNoDeprecatedFields(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	wrapReadWithUpgradeGuard("azurerm_example", resource)
+
+	if resource.Read == nil || resource.ReadContext != nil { //nolint:staticcheck
+		t.Fatalf("expected a Resource without deprecated fields not to be wrapped")
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// the Compute Usages API isn't exposed via an SDK package, so this is a minimal implementation of the `Usage_List`
+// operation - which is available in the same API Version as the Compute SKUs client this is issued from
+
+type computeUsage struct {
+	CurrentValue int64            `json:"currentValue"`
+	Limit        int64            `json:"limit"`
+	Name         computeUsageName `json:"name"`
+}
+
+type computeUsageName struct {
+	LocalizedValue *string `json:"localizedValue,omitempty"`
+	Value          *string `json:"value,omitempty"`
+}
+
+type computeUsagesPager struct {
+	NextLink *odata.Link `json:"nextLink"`
+}
+
+func (p *computeUsagesPager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+	}()
+
+	return p.NextLink
+}
+
+func listComputeUsages(ctx context.Context, c *resourcemanager.Client, subscriptionId, location string) ([]computeUsage, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Pager:      &computeUsagesPager{},
+		Path:       fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Compute/locations/%s/usages", subscriptionId, location),
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.ExecutePaged(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var values struct {
+		Values *[]computeUsage `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return nil, err
+	}
+
+	return pointer.From(values.Values), nil
+}
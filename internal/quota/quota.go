@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/usages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+const (
+	usageRegionalVCPUs     = "cores"
+	usageSpotVCPUs         = "lowPriorityCores"
+	usageVirtualMachines   = "virtualMachines"
+	usagePublicIPAddresses = "PublicIPAddresses"
+)
+
+// Allocation is the quota-consuming resources used by a Virtual Machine, Virtual Machine Scale Set or Node Pool
+type Allocation struct {
+	// VMSize is the size of each Virtual Machine, for example `Standard_D2s_v3`
+	VMSize string
+
+	// VirtualMachines is the number of Virtual Machines of this size
+	VirtualMachines int64
+
+	// Spot specifies whether these are Spot Virtual Machines, which consume the Spot vCPU quota rather than the
+	// Regional and Family vCPU quotas
+	Spot bool
+
+	// PublicIPAddresses is the number of Public IP Addresses which are created alongside these Virtual Machines
+	PublicIPAddresses int64
+}
+
+// Change is a change from the `Before` allocation (which is empty for a new resource) to the `After` allocation
+type Change struct {
+	Location string
+	Before   Allocation
+	After    Allocation
+}
+
+// ValidateHeadroom checks that there's enough quota available in the Location to apply the Change - returning an
+// error describing the quota which would be exceeded when there isn't.
+//
+// This is a best-effort check which is only performed when opted into via the `quota_validation` feature - quota
+// which can't be determined (for example when the Location isn't known until apply) is skipped, and failures to
+// retrieve the current usage are logged rather than returned, since these shouldn't block a plan.
+func ValidateHeadroom(ctx context.Context, client *clients.Client, change Change) error {
+	if !client.Features.QuotaValidation.Enabled {
+		return nil
+	}
+
+	loc := location.Normalize(change.Location)
+	if loc == "" {
+		return nil
+	}
+	subscriptionId := client.Account.SubscriptionId
+
+	problems := make([]string, 0)
+
+	computeRequired := make(map[string]int64)
+	if change.Before.VMSize != "" || change.After.VMSize != "" {
+		sizes, err := listVMSizes(ctx, client.Compute.SkusClient, subscriptionId, loc)
+		if err == nil {
+			computeRequired, err = requiredComputeQuota(sizes, change)
+		}
+		if err != nil {
+			log.Printf("[WARN] skipping the Compute quota validation in %q: %+v", loc, err)
+			computeRequired = make(map[string]int64)
+		}
+	}
+	if len(computeRequired) > 0 {
+		computeUsages, err := listComputeUsages(ctx, client.Compute.SkusClient.Client, subscriptionId, loc)
+		if err != nil {
+			log.Printf("[WARN] skipping the Compute quota validation in %q, retrieving the current usage: %+v", loc, err)
+		} else {
+			for _, usage := range computeUsages {
+				name := pointer.From(usage.Name.Value)
+				displayName := pointer.From(usage.Name.LocalizedValue)
+				if displayName == "" {
+					displayName = name
+				}
+				if problem := checkHeadroom(displayName, usage.CurrentValue, usage.Limit, computeRequired[name]); problem != "" {
+					problems = append(problems, problem)
+				}
+			}
+		}
+	}
+
+	if required := change.After.PublicIPAddresses - change.Before.PublicIPAddresses; required > 0 {
+		networkUsages, err := client.Network.Usages.ListComplete(ctx, usages.NewLocationID(subscriptionId, loc))
+		if err != nil {
+			log.Printf("[WARN] skipping the Network quota validation in %q, retrieving the current usage: %+v", loc, err)
+		} else {
+			for _, usage := range networkUsages.Items {
+				if !strings.EqualFold(pointer.From(usage.Name.Value), usagePublicIPAddresses) {
+					continue
+				}
+				displayName := pointer.From(usage.Name.LocalizedValue)
+				if displayName == "" {
+					displayName = usagePublicIPAddresses
+				}
+				if problem := checkHeadroom(displayName, usage.CurrentValue, usage.Limit, required); problem != "" {
+					problems = append(problems, problem)
+				}
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("insufficient quota is available in %q to apply this change:\n\n* %s\n\nrequest a quota increase for this Subscription or reduce the size of this change - alternatively this validation can be disabled by setting `enabled` to `false` within the `quota_validation` block in the Provider `features` block", loc, strings.Join(problems, "\n* "))
+	}
+
+	return nil
+}
+
+// checkHeadroom returns a description of the problem when `required` more of this quota isn't available
+func checkHeadroom(name string, current, limit, required int64) string {
+	if required <= 0 || current+required <= limit {
+		return ""
+	}
+	return fmt.Sprintf("%s: %d of %d used, %d more are required", name, current, limit, required)
+}
+
+type vmSizeInfo struct {
+	family string
+	vCPUs  int64
+}
+
+// requiredComputeQuota returns the additional Compute quota required by the Change, keyed by the name of the quota -
+// which is negative when the Change frees up some of this quota
+func requiredComputeQuota(sizes map[string]vmSizeInfo, change Change) (map[string]int64, error) {
+	required := make(map[string]int64)
+	for _, v := range []struct {
+		allocation Allocation
+		sign       int64
+	}{
+		{allocation: change.After, sign: 1},
+		{allocation: change.Before, sign: -1},
+	} {
+		if v.allocation.VMSize == "" || v.allocation.VirtualMachines == 0 {
+			continue
+		}
+
+		size, ok := sizes[strings.ToLower(v.allocation.VMSize)]
+		if !ok {
+			return nil, fmt.Errorf("the Virtual Machine size %q was not found", v.allocation.VMSize)
+		}
+
+		vCPUs := v.sign * v.allocation.VirtualMachines * size.vCPUs
+		required[usageVirtualMachines] += v.sign * v.allocation.VirtualMachines
+		if v.allocation.Spot {
+			required[usageSpotVCPUs] += vCPUs
+			continue
+		}
+		required[usageRegionalVCPUs] += vCPUs
+		if size.family != "" {
+			required[size.family] += vCPUs
+		}
+	}
+
+	return required, nil
+}
+
+func listVMSizes(ctx context.Context, client *skus.SkusClient, subscriptionId, location string) (map[string]vmSizeInfo, error) {
+	opts := skus.DefaultResourceSkusListOperationOptions()
+	opts.Filter = pointer.To(fmt.Sprintf("location eq '%s'", location))
+	resp, err := client.ResourceSkusListComplete(ctx, commonids.NewSubscriptionID(subscriptionId), opts)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Virtual Machine sizes: %+v", err)
+	}
+
+	sizes := make(map[string]vmSizeInfo)
+	for _, sku := range resp.Items {
+		if !strings.EqualFold(pointer.From(sku.ResourceType), "virtualMachines") || sku.Name == nil {
+			continue
+		}
+
+		info := vmSizeInfo{
+			family: pointer.From(sku.Family),
+		}
+		if sku.Capabilities != nil {
+			for _, capability := range *sku.Capabilities {
+				if strings.EqualFold(pointer.From(capability.Name), "vCPUs") {
+					if v, err := strconv.ParseInt(pointer.From(capability.Value), 10, 64); err == nil {
+						info.vCPUs = v
+					}
+				}
+			}
+		}
+		sizes[strings.ToLower(*sku.Name)] = info
+	}
+
+	return sizes, nil
+}
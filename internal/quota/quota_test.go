@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRequiredComputeQuota(t *testing.T) {
+	sizes := map[string]vmSizeInfo{
+		"standard_d2s_v3": {
+			family: "standardDSv3Family",
+			vCPUs:  2,
+		},
+		"standard_d4s_v3": {
+			family: "standardDSv3Family",
+			vCPUs:  4,
+		},
+		"standard_e4s_v5": {
+			family: "standardESv5Family",
+			vCPUs:  4,
+		},
+	}
+
+	testData := []struct {
+		name     string
+		input    Change
+		expected map[string]int64
+		error    bool
+	}{
+		{
+			name: "new resource",
+			input: Change{
+				After: Allocation{VMSize: "Standard_D2s_v3", VirtualMachines: 3},
+			},
+			expected: map[string]int64{
+				"cores":              6,
+				"standardDSv3Family": 6,
+				"virtualMachines":    3,
+			},
+		},
+		{
+			name: "resize within the same family",
+			input: Change{
+				Before: Allocation{VMSize: "Standard_D2s_v3", VirtualMachines: 2},
+				After:  Allocation{VMSize: "Standard_D4s_v3", VirtualMachines: 2},
+			},
+			expected: map[string]int64{
+				"cores":              4,
+				"standardDSv3Family": 4,
+				"virtualMachines":    0,
+			},
+		},
+		{
+			name: "resize to a different family",
+			input: Change{
+				Before: Allocation{VMSize: "Standard_D4s_v3", VirtualMachines: 1},
+				After:  Allocation{VMSize: "Standard_E4s_v5", VirtualMachines: 1},
+			},
+			expected: map[string]int64{
+				"cores":              0,
+				"standardDSv3Family": -4,
+				"standardESv5Family": 4,
+				"virtualMachines":    0,
+			},
+		},
+		{
+			name: "spot",
+			input: Change{
+				After: Allocation{VMSize: "Standard_D2s_v3", VirtualMachines: 5, Spot: true},
+			},
+			expected: map[string]int64{
+				"lowPriorityCores": 10,
+				"virtualMachines":  5,
+			},
+		},
+		{
+			name: "unknown size",
+			input: Change{
+				After: Allocation{VMSize: "Standard_Unknown", VirtualMachines: 1},
+			},
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := requiredComputeQuota(sizes, v.input)
+		if err != nil {
+			if v.error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestCheckHeadroom(t *testing.T) {
+	if v := checkHeadroom("Total Regional vCPUs", 8, 10, 2); v != "" {
+		t.Fatalf("expected no problem when the limit is reached exactly but got %q", v)
+	}
+	if v := checkHeadroom("Total Regional vCPUs", 8, 10, -4); v != "" {
+		t.Fatalf("expected no problem when quota is freed up but got %q", v)
+	}
+	if v := checkHeadroom("Total Regional vCPUs", 8, 10, 4); v != "Total Regional vCPUs: 8 of 10 used, 4 more are required" {
+		t.Fatalf("unexpected problem %q", v)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcemove
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ResourceGroupMoveEnabledField is the name of the field used to opt into moving a resource between Resource Groups,
+// rather than recreating the resource when the `resource_group_name` field changes.
+const ResourceGroupMoveEnabledField = "resource_group_move_enabled"
+
+// ResourceGroupNameSchema returns the schema for the `resource_group_name` field for a resource which can be moved
+// between Resource Groups - this isn't ForceNew since that's conditionally set by ForceNewResourceGroupNameUnlessMoveEnabled
+func ResourceGroupNameSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ValidateFunc: resourcegroups.ValidateName,
+	}
+}
+
+func ResourceGroupMoveEnabledSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+// ForceNewResourceGroupNameUnlessMoveEnabled recreates the resource when the `resource_group_name` field changes,
+// unless moving the resource has been opted into via the `resource_group_move_enabled` field
+func ForceNewResourceGroupNameUnlessMoveEnabled() pluginsdk.CustomizeDiffFunc {
+	return pluginsdk.ForceNewIf("resource_group_name", func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) bool {
+		return d.HasChange("resource_group_name") && !d.Get(ResourceGroupMoveEnabledField).(bool)
+	})
+}
+
+// IDSchema returns the schema for an explicit `id` field, which a resource that can be moved between Resource Groups
+// must define so that ComputeNewIDWhenMoved can mark it as unknown
+func IDSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+}
+
+// ComputeNewIDWhenMoved marks the `id` field as unknown when the resource is being moved to another Resource Group,
+// since the ID contains the Resource Group name and so changes once the move has completed
+func ComputeNewIDWhenMoved() pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || !d.HasChange("resource_group_name") || !d.Get(ResourceGroupMoveEnabledField).(bool) {
+			return nil
+		}
+
+		return d.SetNewComputed("id")
+	}
+}
+
+// MoveToResourceGroup validates that the resource with the ID `resourceId` can be moved from the Resource Group `source`
+// into the Resource Group `targetResourceGroupName` (within the same Subscription) and then moves it.
+func MoveToResourceGroup(ctx context.Context, client *resources.ResourcesClient, resourceId string, source commonids.ResourceGroupId, targetResourceGroupName string) error {
+	target := commonids.NewResourceGroupID(source.SubscriptionId, targetResourceGroupName)
+	input := resources.ResourcesMoveInfo{
+		Resources:           pointer.To([]string{resourceId}),
+		TargetResourceGroup: pointer.To(target.ID()),
+	}
+
+	log.Printf("[DEBUG] Validating the move of %q to %s..", resourceId, target)
+	if err := client.ValidateMoveResourcesThenPoll(ctx, source, input); err != nil {
+		return fmt.Errorf("validating the move of %q to %s: %+v", resourceId, target, err)
+	}
+
+	log.Printf("[DEBUG] Moving %q to %s..", resourceId, target)
+	if err := client.MoveResourcesThenPoll(ctx, source, input); err != nil {
+		return fmt.Errorf("moving %q to %s: %+v", resourceId, target, err)
+	}
+
+	return nil
+}
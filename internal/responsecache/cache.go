@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package responsecache
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache caches the responses of identical GET requests for a short period of time, so that when many resources read
+// the same parent resource during a single operation (for example each Node Pool reading the Kubernetes Cluster) the
+// parent resource is only retrieved once.
+//
+// Concurrent lookups for the same key wait for the in-flight request rather than sending their own. Failed requests
+// aren't cached.
+type Cache struct {
+	ttl time.Duration
+
+	lock    sync.Mutex
+	entries map[string]*entry
+}
+
+type entry struct {
+	// done is closed once the request has completed, at which point `value`, `err` and `expiresAt` are populated
+	done chan struct{}
+
+	value     interface{}
+	err       error
+	expiresAt time.Time
+}
+
+// New returns a Cache which caches each response for the specified duration
+func New(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		entries: make(map[string]*entry),
+	}
+}
+
+// Get returns the cached response of type T for the (case-insensitive) Resource ID `id` when one exists - otherwise
+// calling `fetch` and caching the response if this succeeds.
+//
+// When the Cache is nil (e.g. the `response_cache` feature isn't enabled) `fetch` is always called.
+func Get[T any](c *Cache, id string, fetch func() (T, error)) (T, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	// the type is part of the key since the same Resource ID can be retrieved using different API Versions
+	var zero T
+	key := fmt.Sprintf("%T|%s", zero, strings.ToLower(id))
+
+	c.lock.Lock()
+	if existing, ok := c.entries[key]; ok {
+		c.lock.Unlock()
+
+		<-existing.done
+		if existing.err == nil && time.Now().Before(existing.expiresAt) {
+			if v, ok := existing.value.(T); ok {
+				log.Printf("[DEBUG] Using the cached response for %q", id)
+				return v, nil
+			}
+		}
+
+		c.lock.Lock()
+		// another caller may have already replaced the expired (or failed) entry
+		if c.entries[key] == existing {
+			delete(c.entries, key)
+		}
+		c.lock.Unlock()
+		return Get(c, id, fetch)
+	}
+
+	item := &entry{
+		done: make(chan struct{}),
+	}
+	c.entries[key] = item
+	c.lock.Unlock()
+
+	value, err := fetch()
+	item.value = value
+	item.err = err
+	item.expiresAt = time.Now().Add(c.ttl)
+	close(item.done)
+
+	if err != nil {
+		c.lock.Lock()
+		if c.entries[key] == item {
+			delete(c.entries, key)
+		}
+		c.lock.Unlock()
+	}
+
+	return value, err
+}
+
+// Invalidate removes any cached responses for the (case-insensitive) Resource ID `id`, which should be called once the
+// resource has been changed
+func (c *Cache) Invalidate(id string) {
+	if c == nil {
+		return
+	}
+
+	suffix := "|" + strings.ToLower(id)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for key := range c.entries {
+		if strings.HasSuffix(key, suffix) {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package responsecache
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testResourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ContainerService/managedClusters/example"
+
+func TestGetNilCache(t *testing.T) {
+	var cache *Cache
+	calls := 0
+	for i := 0; i < 2; i++ {
+		if _, err := Get(cache, testResourceId, func() (string, error) {
+			calls++
+			return "cluster", nil
+		}); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected a nil Cache to always call fetch but got %d calls", calls)
+	}
+}
+
+func TestGetCachesResponses(t *testing.T) {
+	cache := New(time.Minute)
+	calls := 0
+	fetch := func() (string, error) {
+		calls++
+		return "cluster", nil
+	}
+
+	for _, id := range []string{testResourceId, testResourceId, "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ContainerService/managedClusters/example"} {
+		value, err := Get(cache, id, fetch)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if value != "cluster" {
+			t.Fatalf("expected %q but got %q", "cluster", value)
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected 1 call but got %d", calls)
+	}
+
+	// a different type for the same ID is cached separately
+	if _, err := Get(cache, testResourceId, func() (int, error) {
+		calls++
+		return 1, nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls but got %d", calls)
+	}
+}
+
+func TestGetDoesNotCacheErrors(t *testing.T) {
+	cache := New(time.Minute)
+	calls := 0
+	fetch := func() (string, error) {
+		calls++
+		if calls == 1 {
+			return "", fmt.Errorf("throttled")
+		}
+		return "cluster", nil
+	}
+
+	if _, err := Get(cache, testResourceId, fetch); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if _, err := Get(cache, testResourceId, fetch); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls but got %d", calls)
+	}
+}
+
+func TestGetExpiry(t *testing.T) {
+	cache := New(time.Millisecond)
+	calls := 0
+	fetch := func() (string, error) {
+		calls++
+		return "cluster", nil
+	}
+
+	if _, err := Get(cache, testResourceId, fetch); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := Get(cache, testResourceId, fetch); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected 2 calls but got %d", calls)
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	cache := New(time.Minute)
+	calls := 0
+	fetch := func() (string, error) {
+		calls++
+		return "cluster", nil
+	}
+
+	if _, err := Get(cache, testResourceId, fetch); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	cache.Invalidate(testResourceId)
+	if _, err := Get(cache, testResourceId, fetch); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected 2 calls but got %d", calls)
+	}
+}
+
+func TestGetConcurrent(t *testing.T) {
+	cache := New(time.Minute)
+	var calls int32
+	release := make(chan struct{})
+	fetch := func() (string, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "cluster", nil
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := Get(cache, testResourceId, fetch); err != nil {
+				t.Errorf("unexpected error: %+v", err)
+			}
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("expected 1 call but got %d", calls)
+	}
+}
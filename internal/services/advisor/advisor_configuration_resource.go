@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package advisor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/advisor/2023-01-01/configurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/advisor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/advisor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// the Advisor Configuration is a singleton per Subscription which always has the name `default`
+const advisorConfigurationName = "default"
+
+type AdvisorConfigurationResource struct{}
+
+var _ sdk.ResourceWithUpdate = AdvisorConfigurationResource{}
+
+type AdvisorConfigurationResourceModel struct {
+	SubscriptionId           string   `tfschema:"subscription_id"`
+	LowCpuThreshold          string   `tfschema:"low_cpu_threshold"`
+	Excluded                 bool     `tfschema:"excluded"`
+	ExcludedResourceGroupIds []string `tfschema:"excluded_resource_group_ids"`
+}
+
+func (AdvisorConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"subscription_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSubscriptionID,
+		},
+
+		"low_cpu_threshold": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(configurations.CPUThresholdFive),
+			ValidateFunc: validation.StringInSlice(configurations.PossibleValuesForCPUThreshold(), false),
+		},
+
+		"excluded": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"excluded_resource_group_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: commonids.ValidateResourceGroupID,
+			},
+		},
+	}
+}
+
+func (AdvisorConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (AdvisorConfigurationResource) ModelObject() interface{} {
+	return &AdvisorConfigurationResourceModel{}
+}
+
+func (AdvisorConfigurationResource) ResourceType() string {
+	return "azurerm_advisor_configuration"
+}
+
+func (AdvisorConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.AdvisorConfigurationID
+}
+
+func (r AdvisorConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.ConfigurationsClient
+
+			var model AdvisorConfigurationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			subscriptionId, err := commonids.ParseSubscriptionID(model.SubscriptionId)
+			if err != nil {
+				return err
+			}
+
+			// the Advisor Configuration always exists, so there's no requires import check here
+			id := parse.NewAdvisorConfigurationID(subscriptionId.SubscriptionId, advisorConfigurationName)
+
+			payload := configurations.ConfigData{
+				Properties: &configurations.ConfigDataProperties{
+					Exclude:         pointer.To(model.Excluded),
+					LowCPUThreshold: pointer.To(configurations.CPUThreshold(model.LowCpuThreshold)),
+				},
+			}
+			if _, err := client.CreateInSubscription(ctx, *subscriptionId, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := updateAdvisorResourceGroupExclusions(ctx, client, model.ExcludedResourceGroupIds, true); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (AdvisorConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.ConfigurationsClient
+
+			id, err := parse.AdvisorConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			subscriptionId := commonids.NewSubscriptionID(id.SubscriptionId)
+
+			// the Subscription-level Configuration is returned alongside the Configurations for each Resource Group
+			resp, err := client.ListBySubscriptionComplete(ctx, subscriptionId)
+			if err != nil {
+				if response.WasNotFound(resp.LatestHttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := AdvisorConfigurationResourceModel{
+				SubscriptionId:           subscriptionId.ID(),
+				LowCpuThreshold:          string(configurations.CPUThresholdFive),
+				ExcludedResourceGroupIds: make([]string, 0),
+			}
+
+			for _, item := range resp.Items {
+				if item.Id == nil || item.Properties == nil {
+					continue
+				}
+				props := item.Properties
+
+				if strings.EqualFold(*item.Id, id.ID()) {
+					state.Excluded = pointer.From(props.Exclude)
+					if props.LowCPUThreshold != nil {
+						state.LowCpuThreshold = string(*props.LowCPUThreshold)
+					}
+					continue
+				}
+
+				if !pointer.From(props.Exclude) {
+					continue
+				}
+
+				index := strings.Index(strings.ToLower(*item.Id), "/providers/microsoft.advisor/configurations/")
+				if index == -1 {
+					continue
+				}
+				resourceGroupId, err := commonids.ParseResourceGroupIDInsensitively((*item.Id)[:index])
+				if err != nil {
+					return err
+				}
+				state.ExcludedResourceGroupIds = append(state.ExcludedResourceGroupIds, resourceGroupId.ID())
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (AdvisorConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.ConfigurationsClient
+
+			id, err := parse.AdvisorConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AdvisorConfigurationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("low_cpu_threshold", "excluded") {
+				payload := configurations.ConfigData{
+					Properties: &configurations.ConfigDataProperties{
+						Exclude:         pointer.To(model.Excluded),
+						LowCPUThreshold: pointer.To(configurations.CPUThreshold(model.LowCpuThreshold)),
+					},
+				}
+				if _, err := client.CreateInSubscription(ctx, commonids.NewSubscriptionID(id.SubscriptionId), payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("excluded_resource_group_ids") {
+				oldRaw, newRaw := metadata.ResourceData.GetChange("excluded_resource_group_ids")
+				oldIds := oldRaw.(*pluginsdk.Set)
+				newIds := newRaw.(*pluginsdk.Set)
+
+				removed := make([]string, 0)
+				for _, v := range oldIds.Difference(newIds).List() {
+					removed = append(removed, v.(string))
+				}
+				if err := updateAdvisorResourceGroupExclusions(ctx, client, removed, false); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+
+				added := make([]string, 0)
+				for _, v := range newIds.Difference(oldIds).List() {
+					added = append(added, v.(string))
+				}
+				if err := updateAdvisorResourceGroupExclusions(ctx, client, added, true); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (AdvisorConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.ConfigurationsClient
+
+			id, err := parse.AdvisorConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AdvisorConfigurationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Advisor Configuration can't be deleted, so it's reset to the defaults instead
+			if err := updateAdvisorResourceGroupExclusions(ctx, client, model.ExcludedResourceGroupIds, false); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			payload := configurations.ConfigData{
+				Properties: &configurations.ConfigDataProperties{
+					Exclude:         pointer.To(false),
+					LowCPUThreshold: pointer.To(configurations.CPUThresholdFive),
+				},
+			}
+			if _, err := client.CreateInSubscription(ctx, commonids.NewSubscriptionID(id.SubscriptionId), payload); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func updateAdvisorResourceGroupExclusions(ctx context.Context, client *configurations.ConfigurationsClient, input []string, exclude bool) error {
+	for _, v := range input {
+		resourceGroupId, err := commonids.ParseResourceGroupID(v)
+		if err != nil {
+			return err
+		}
+
+		payload := configurations.ConfigData{
+			Properties: &configurations.ConfigDataProperties{
+				Exclude: pointer.To(exclude),
+			},
+		}
+		if _, err := client.CreateInResourceGroup(ctx, *resourceGroupId, payload); err != nil {
+			return fmt.Errorf("updating the Advisor Configuration for %s: %+v", resourceGroupId, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package advisor_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/advisor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AdvisorConfigurationResource struct{}
+
+// NOTE: the Advisor Configuration is a singleton for the Subscription, so all steps are combined in a single test
+func TestAccAdvisorConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_advisor_configuration", "test")
+	r := AdvisorConfigurationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("excluded_resource_group_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (AdvisorConfigurationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.AdvisorConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Advisor.ConfigurationsClient.ListBySubscriptionComplete(ctx, commonids.NewSubscriptionID(id.SubscriptionId))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	for _, item := range resp.Items {
+		if item.Id != nil && strings.EqualFold(*item.Id, id.ID()) {
+			return pointer.To(true), nil
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (AdvisorConfigurationResource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_advisor_configuration" "test" {
+  subscription_id = data.azurerm_subscription.current.id
+}
+`
+}
+
+func (AdvisorConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-advisor-%d"
+  location = "%s"
+}
+
+resource "azurerm_advisor_configuration" "test" {
+  subscription_id             = data.azurerm_subscription.current.id
+  low_cpu_threshold           = "20"
+  excluded_resource_group_ids = [azurerm_resource_group.test.id]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -64,6 +64,11 @@ func dataSourceAdvisorRecommendations() *pluginsdk.Resource {
 							Computed: true,
 						},
 
+						"recommendation_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"recommendation_name": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -162,6 +167,7 @@ func flattenAzureRmAdvisorRecommendations(recommends []getrecommendations.Resour
 			"category":               string(pointer.From(v.Category)),
 			"description":            description,
 			"impact":                 string(pointer.From(v.Impact)),
+			"recommendation_id":      pointer.From(r.Id),
 			"recommendation_name":    pointer.From(r.Name),
 			"recommendation_type_id": pointer.From(v.RecommendationTypeId),
 			"resource_name":          pointer.From(v.ImpactedValue),
@@ -24,6 +24,7 @@ func TestAccAdvisorRecommendationsDataSource_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("recommendations.0.category").Exists(),
 				check.That(data.ResourceName).Key("recommendations.0.description").Exists(),
 				check.That(data.ResourceName).Key("recommendations.0.impact").Exists(),
+				check.That(data.ResourceName).Key("recommendations.0.recommendation_id").Exists(),
 				check.That(data.ResourceName).Key("recommendations.0.recommendation_name").Exists(),
 				check.That(data.ResourceName).Key("recommendations.0.recommendation_type_id").Exists(),
 				check.That(data.ResourceName).Key("recommendations.0.resource_name").Exists(),
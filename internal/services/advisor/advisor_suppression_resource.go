@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package advisor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/advisor/2023-01-01/getrecommendations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/advisor/2023-01-01/suppressions"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AdvisorSuppressionResource struct{}
+
+var _ sdk.ResourceWithUpdate = AdvisorSuppressionResource{}
+
+type AdvisorSuppressionResourceModel struct {
+	Name             string `tfschema:"name"`
+	RecommendationId string `tfschema:"recommendation_id"`
+	Ttl              string `tfschema:"ttl"`
+	SuppressionId    string `tfschema:"suppression_id"`
+}
+
+func (AdvisorSuppressionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"recommendation_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: getrecommendations.ValidateScopedRecommendationID,
+		},
+
+		"ttl": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(\d+\.)?\d{2}:\d{2}:\d{2}$`),
+				"`ttl` must be in the format `dd.hh:mm:ss`",
+			),
+		},
+	}
+}
+
+func (AdvisorSuppressionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"suppression_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (AdvisorSuppressionResource) ModelObject() interface{} {
+	return &AdvisorSuppressionResourceModel{}
+}
+
+func (AdvisorSuppressionResource) ResourceType() string {
+	return "azurerm_advisor_suppression"
+}
+
+func (AdvisorSuppressionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return suppressions.ValidateScopedSuppressionID
+}
+
+func (r AdvisorSuppressionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.SuppressionsClient
+
+			var model AdvisorSuppressionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			recommendationId, err := getrecommendations.ParseScopedRecommendationID(model.RecommendationId)
+			if err != nil {
+				return err
+			}
+
+			id := suppressions.NewScopedSuppressionID(recommendationId.ResourceUri, recommendationId.RecommendationId, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			payload := suppressions.SuppressionContract{
+				Properties: &suppressions.SuppressionProperties{},
+			}
+			if model.Ttl != "" {
+				payload.Properties.Ttl = pointer.To(model.Ttl)
+			}
+
+			if _, err := client.Create(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (AdvisorSuppressionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.SuppressionsClient
+
+			id, err := suppressions.ParseScopedSuppressionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AdvisorSuppressionResourceModel{
+				Name:             id.SuppressionName,
+				RecommendationId: getrecommendations.NewScopedRecommendationID(id.ResourceUri, id.RecommendationId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.SuppressionId = pointer.From(props.SuppressionId)
+
+					// a TTL of `-1` is returned when the Recommendation is dismissed indefinitely
+					if ttl := pointer.From(props.Ttl); ttl != "-1" {
+						state.Ttl = ttl
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (AdvisorSuppressionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.SuppressionsClient
+
+			id, err := suppressions.ParseScopedSuppressionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AdvisorSuppressionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := suppressions.SuppressionContract{
+				Properties: &suppressions.SuppressionProperties{},
+			}
+			if model.Ttl != "" {
+				payload.Properties.Ttl = pointer.To(model.Ttl)
+			}
+
+			if _, err := client.Create(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (AdvisorSuppressionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Advisor.SuppressionsClient
+
+			id, err := suppressions.ParseScopedSuppressionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package advisor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/advisor/2023-01-01/suppressions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AdvisorSuppressionResource struct{}
+
+func TestAccAdvisorSuppression_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_advisor_suppression", "test")
+	r := AdvisorSuppressionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("suppression_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAdvisorSuppression_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_advisor_suppression", "test")
+	r := AdvisorSuppressionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAdvisorSuppression_ttl(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_advisor_suppression", "test")
+	r := AdvisorSuppressionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ttl(data, "01:00:00"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.ttl(data, "7.00:00:00"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (AdvisorSuppressionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := suppressions.ParseScopedSuppressionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Advisor.SuppressionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (AdvisorSuppressionResource) template() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_advisor_recommendations" "test" {}
+`
+}
+
+func (r AdvisorSuppressionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_advisor_suppression" "test" {
+  name              = "acctest-as-%d"
+  recommendation_id = data.azurerm_advisor_recommendations.test.recommendations.0.recommendation_id
+}
+`, r.template(), data.RandomInteger)
+}
+
+func (r AdvisorSuppressionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_advisor_suppression" "import" {
+  name              = azurerm_advisor_suppression.test.name
+  recommendation_id = azurerm_advisor_suppression.test.recommendation_id
+}
+`, r.basic(data))
+}
+
+func (r AdvisorSuppressionResource) ttl(data acceptance.TestData, ttl string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_advisor_suppression" "test" {
+  name              = "acctest-as-%d"
+  recommendation_id = data.azurerm_advisor_recommendations.test.recommendations.0.recommendation_id
+  ttl               = "%s"
+}
+`, r.template(), data.RandomInteger, ttl)
+}
@@ -6,22 +6,40 @@ package client
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/advisor/2023-01-01/configurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/advisor/2023-01-01/getrecommendations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/advisor/2023-01-01/suppressions"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
+	ConfigurationsClient  *configurations.ConfigurationsClient
 	RecommendationsClient *getrecommendations.GetRecommendationsClient
+	SuppressionsClient    *suppressions.SuppressionsClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	configurationsClient, err := configurations.NewConfigurationsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Configurations client: %+v", err)
+	}
+	o.Configure(configurationsClient.Client, o.Authorizers.ResourceManager)
+
 	recommendationsClient, err := getrecommendations.NewGetRecommendationsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Recommendations client: %+v", err)
 	}
 	o.Configure(recommendationsClient.Client, o.Authorizers.ResourceManager)
 
+	suppressionsClient, err := suppressions.NewSuppressionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Suppressions client: %+v", err)
+	}
+	o.Configure(suppressionsClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
+		ConfigurationsClient:  configurationsClient,
 		RecommendationsClient: recommendationsClient,
+		SuppressionsClient:    suppressionsClient,
 	}, nil
 }
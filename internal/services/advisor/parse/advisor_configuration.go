@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type AdvisorConfigurationId struct {
+	SubscriptionId    string
+	ConfigurationName string
+}
+
+func NewAdvisorConfigurationID(subscriptionId, configurationName string) AdvisorConfigurationId {
+	return AdvisorConfigurationId{
+		SubscriptionId:    subscriptionId,
+		ConfigurationName: configurationName,
+	}
+}
+
+func (id AdvisorConfigurationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Configuration Name %q", id.ConfigurationName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Advisor Configuration", segmentsStr)
+}
+
+func (id AdvisorConfigurationId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Advisor/configurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ConfigurationName)
+}
+
+// AdvisorConfigurationID parses a AdvisorConfiguration ID into an AdvisorConfigurationId struct
+func AdvisorConfigurationID(input string) (*AdvisorConfigurationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an AdvisorConfiguration ID: %+v", input, err)
+	}
+
+	resourceId := AdvisorConfigurationId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ConfigurationName, err = id.PopSegment("configurations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"fmt"
+	"html"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/graphqlapiresolverpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceApiManagementApiResolverPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApiManagementApiResolverPolicyCreateUpdate,
+		Read:   resourceApiManagementApiResolverPolicyRead,
+		Update: resourceApiManagementApiResolverPolicyCreateUpdate,
+		Delete: resourceApiManagementApiResolverPolicyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := graphqlapiresolverpolicy.ParseResolverID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"api_resolver_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: graphqlapiresolverpolicy.ValidateResolverID,
+			},
+
+			"xml_content": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"xml_link"},
+				ExactlyOneOf:     []string{"xml_link", "xml_content"},
+				DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+			},
+
+			"xml_link": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"xml_content"},
+				ExactlyOneOf:  []string{"xml_link", "xml_content"},
+			},
+		},
+	}
+}
+
+func resourceApiManagementApiResolverPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.GraphQLApiResolverPolicyClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := graphqlapiresolverpolicy.ParseResolverID(d.Get("api_resolver_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := graphqlapiresolverpolicy.PolicyContract{}
+
+	xmlContent := d.Get("xml_content").(string)
+	xmlLink := d.Get("xml_link").(string)
+
+	if xmlLink != "" {
+		parameters.Properties = &graphqlapiresolverpolicy.PolicyContractProperties{
+			Format: pointer.To(graphqlapiresolverpolicy.PolicyContentFormatRawxmlNegativelink),
+			Value:  xmlLink,
+		}
+	} else if xmlContent != "" {
+		// this is intentionally an else-if since `xml_content` is computed
+
+		// clear out any existing value for xml_link
+		if !d.IsNewResource() {
+			d.Set("xml_link", "")
+		}
+
+		parameters.Properties = &graphqlapiresolverpolicy.PolicyContractProperties{
+			Format: pointer.To(graphqlapiresolverpolicy.PolicyContentFormatRawxml),
+			Value:  xmlContent,
+		}
+	}
+
+	if parameters.Properties == nil {
+		return fmt.Errorf("Either `xml_content` or `xml_link` must be set")
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, *id, parameters, graphqlapiresolverpolicy.CreateOrUpdateOperationOptions{}); err != nil {
+		return fmt.Errorf("creating/updating Policy for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApiManagementApiResolverPolicyRead(d, meta)
+}
+
+func resourceApiManagementApiResolverPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.GraphQLApiResolverPolicyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := graphqlapiresolverpolicy.ParseResolverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id, graphqlapiresolverpolicy.GetOperationOptions{Format: pointer.To(graphqlapiresolverpolicy.PolicyExportFormatXml)})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] Policy for %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Policy for %s: %+v", *id, err)
+	}
+
+	d.Set("api_resolver_id", id.ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			// when you submit an `xml_link` to the API, the API downloads this link and stores it as `xml_content`
+			// as such there is no way to set `xml_link` and we'll let Terraform handle it
+			d.Set("xml_content", html.UnescapeString(props.Value))
+		}
+	}
+
+	return nil
+}
+
+func resourceApiManagementApiResolverPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.GraphQLApiResolverPolicyClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := graphqlapiresolverpolicy.ParseResolverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, *id, graphqlapiresolverpolicy.DeleteOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting Policy for %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
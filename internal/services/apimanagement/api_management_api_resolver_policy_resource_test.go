@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/graphqlapiresolverpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementApiResolverPolicyResource struct{}
+
+func TestAccApiManagementApiResolverPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_resolver_policy", "test")
+	r := ApiManagementApiResolverPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementApiResolverPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_resolver_policy", "test")
+	r := ApiManagementApiResolverPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementApiResolverPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := graphqlapiresolverpolicy.ParseResolverID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.GraphQLApiResolverPolicyClient.Get(ctx, *id, graphqlapiresolverpolicy.GetOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Policy for %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil && resp.Model.Id != nil), nil
+}
+
+func (ApiManagementApiResolverPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_resolver_policy" "test" {
+  api_resolver_id = azurerm_api_management_api_resolver.test.id
+
+  xml_content = <<XML
+<http-data-source>
+  <http-request>
+    <set-method>GET</set-method>
+    <set-url>https://example.com/users</set-url>
+  </http-request>
+</http-data-source>
+XML
+}
+`, ApiManagementApiResolverResource{}.basic(data))
+}
+
+func (ApiManagementApiResolverPolicyResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_resolver_policy" "test" {
+  api_resolver_id = azurerm_api_management_api_resolver.test.id
+
+  xml_content = <<XML
+<http-data-source>
+  <http-request>
+    <set-method>GET</set-method>
+    <set-url>https://example.com/v2/users</set-url>
+    <set-header name="Accept" exists-action="override">
+      <value>application/json</value>
+    </set-header>
+  </http-request>
+</http-data-source>
+XML
+}
+`, ApiManagementApiResolverResource{}.basic(data))
+}
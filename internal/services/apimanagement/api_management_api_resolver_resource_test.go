@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/graphqlapiresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementApiResolverResource struct{}
+
+func TestAccApiManagementApiResolver_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_resolver", "test")
+	r := ApiManagementApiResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementApiResolver_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_resolver", "test")
+	r := ApiManagementApiResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementApiResolver_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_resolver", "test")
+	r := ApiManagementApiResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("path").HasValue("Query/user"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementApiResolverResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := graphqlapiresolver.ParseResolverID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.GraphQLApiResolverClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil && resp.Model.Id != nil), nil
+}
+
+func (ApiManagementApiResolverResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku_name = "Developer_1"
+}
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "Synthetic GraphQL API"
+  path                = "graphql"
+  protocols           = ["https"]
+  revision            = "1"
+  api_type            = "graphql"
+}
+
+resource "azurerm_api_management_api_schema" "test" {
+  api_name            = azurerm_api_management_api.test.name
+  api_management_name = azurerm_api_management_api.test.api_management_name
+  resource_group_name = azurerm_api_management_api.test.resource_group_name
+  schema_id           = "graphql"
+  content_type        = "application/vnd.ms-azure-apim.graphql.schema"
+  value               = <<SCHEMA
+type Query {
+  users: [User]
+  user(id: Int!): User
+}
+
+type User {
+  id: Int!
+  name: String!
+}
+SCHEMA
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ApiManagementApiResolverResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_resolver" "test" {
+  name         = "acctest-resolver-%d"
+  api_id       = azurerm_api_management_api.test.id
+  display_name = "Users"
+  path         = "Query/users"
+
+  depends_on = [azurerm_api_management_api_schema.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementApiResolverResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_resolver" "import" {
+  name         = azurerm_api_management_api_resolver.test.name
+  api_id       = azurerm_api_management_api_resolver.test.api_id
+  display_name = azurerm_api_management_api_resolver.test.display_name
+  path         = azurerm_api_management_api_resolver.test.path
+}
+`, r.basic(data))
+}
+
+func (r ApiManagementApiResolverResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_resolver" "test" {
+  name         = "acctest-resolver-%d"
+  api_id       = azurerm_api_management_api.test.id
+  display_name = "User"
+  path         = "Query/user"
+  description  = "Resolves a single user by ID"
+
+  depends_on = [azurerm_api_management_api_schema.test]
+}
+`, r.template(data), data.RandomInteger)
+}
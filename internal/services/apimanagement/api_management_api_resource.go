@@ -156,6 +156,7 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
+								string(api.ContentFormatGraphqlNegativelink),
 								string(api.ContentFormatOpenapi),
 								string(api.ContentFormatOpenapiPositivejson),
 								string(api.ContentFormatOpenapiPositivejsonNegativelink),
@@ -52,6 +52,22 @@ func TestAccApiManagementApiSchema_basicSwagger(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementApiSchema_graphql(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_schema", "test")
+	r := ApiManagementApiSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.graphql(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApiManagementApiSchema_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_api_schema", "test")
 	r := ApiManagementApiSchemaResource{}
@@ -142,6 +158,48 @@ resource "azurerm_api_management_api_schema" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (ApiManagementApiSchemaResource) graphql(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Consumption_0"
+}
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  api_type            = "graphql"
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["https"]
+  revision            = "1"
+}
+
+resource "azurerm_api_management_api_schema" "test" {
+  api_name            = azurerm_api_management_api.test.name
+  api_management_name = azurerm_api_management_api.test.api_management_name
+  resource_group_name = azurerm_api_management_api.test.resource_group_name
+  schema_id           = "graphql"
+  content_type        = "application/vnd.ms-azure-apim.graphql.schema"
+  value               = file("testdata/api_management_api_schema.graphql")
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (r ApiManagementApiSchemaResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorizationaccesspolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementAuthorizationAccessPolicyModel struct {
+	Name            string `tfschema:"name"`
+	AuthorizationId string `tfschema:"api_management_authorization_id"`
+	ObjectId        string `tfschema:"object_id"`
+	TenantId        string `tfschema:"tenant_id"`
+}
+
+type ApiManagementAuthorizationAccessPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementAuthorizationAccessPolicyResource{}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) ResourceType() string {
+	return "azurerm_api_management_authorization_access_policy"
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) ModelObject() interface{} {
+	return &ApiManagementAuthorizationAccessPolicyModel{}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return authorizationaccesspolicy.ValidateAccessPolicyID
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_management_authorization_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: authorizationaccesspolicy.ValidateAuthorizationID,
+		},
+
+		"object_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"tenant_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationAccessPolicyClient
+
+			var model ApiManagementAuthorizationAccessPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			authorizationId, err := authorizationaccesspolicy.ParseAuthorizationID(model.AuthorizationId)
+			if err != nil {
+				return err
+			}
+
+			id := authorizationaccesspolicy.NewAccessPolicyID(authorizationId.SubscriptionId, authorizationId.ResourceGroupName, authorizationId.ServiceName, authorizationId.AuthorizationProviderId, authorizationId.AuthorizationId, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := authorizationaccesspolicy.AuthorizationAccessPolicyContract{
+				Properties: &authorizationaccesspolicy.AuthorizationAccessPolicyContractProperties{
+					ObjectId: pointer.To(model.ObjectId),
+					TenantId: pointer.To(model.TenantId),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload, authorizationaccesspolicy.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationAccessPolicyClient
+
+			id, err := authorizationaccesspolicy.ParseAccessPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementAuthorizationAccessPolicyModel{
+				Name:            id.AuthorizationAccessPolicyId,
+				AuthorizationId: authorizationaccesspolicy.NewAuthorizationID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.AuthorizationProviderId, id.AuthorizationId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ObjectId = pointer.From(props.ObjectId)
+					state.TenantId = pointer.From(props.TenantId)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationAccessPolicyClient
+
+			id, err := authorizationaccesspolicy.ParseAccessPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementAuthorizationAccessPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := authorizationaccesspolicy.AuthorizationAccessPolicyContract{
+				Properties: &authorizationaccesspolicy.AuthorizationAccessPolicyContractProperties{
+					ObjectId: pointer.To(model.ObjectId),
+					TenantId: pointer.To(model.TenantId),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload, authorizationaccesspolicy.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationAccessPolicyClient
+
+			id, err := authorizationaccesspolicy.ParseAccessPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id, authorizationaccesspolicy.DeleteOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorizationprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementAuthorizationProviderModel struct {
+	Name             string                                     `tfschema:"name"`
+	ApiManagementId  string                                     `tfschema:"api_management_id"`
+	IdentityProvider string                                     `tfschema:"identity_provider"`
+	DisplayName      string                                     `tfschema:"display_name"`
+	OAuth2           []ApiManagementAuthorizationProviderOAuth2 `tfschema:"oauth2"`
+}
+
+type ApiManagementAuthorizationProviderOAuth2 struct {
+	RedirectUrl                 string            `tfschema:"redirect_url"`
+	AuthorizationCodeParameters map[string]string `tfschema:"authorization_code_parameters"`
+	ClientCredentialsParameters map[string]string `tfschema:"client_credentials_parameters"`
+}
+
+type ApiManagementAuthorizationProviderResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementAuthorizationProviderResource{}
+
+func (r ApiManagementAuthorizationProviderResource) ResourceType() string {
+	return "azurerm_api_management_authorization_provider"
+}
+
+func (r ApiManagementAuthorizationProviderResource) ModelObject() interface{} {
+	return &ApiManagementAuthorizationProviderModel{}
+}
+
+func (r ApiManagementAuthorizationProviderResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return authorizationprovider.ValidateAuthorizationProviderID
+}
+
+func (r ApiManagementAuthorizationProviderResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_management_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: apimanagementservice.ValidateServiceID,
+		},
+
+		"identity_provider": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"oauth2": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"authorization_code_parameters": {
+						Type:      pluginsdk.TypeMap,
+						Optional:  true,
+						Sensitive: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+						AtLeastOneOf: []string{"oauth2.0.authorization_code_parameters", "oauth2.0.client_credentials_parameters"},
+					},
+
+					"client_credentials_parameters": {
+						Type:      pluginsdk.TypeMap,
+						Optional:  true,
+						Sensitive: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+						AtLeastOneOf: []string{"oauth2.0.authorization_code_parameters", "oauth2.0.client_credentials_parameters"},
+					},
+
+					"redirect_url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+				},
+			},
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			var model ApiManagementAuthorizationProviderModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			apiManagementId, err := apimanagementservice.ParseServiceID(model.ApiManagementId)
+			if err != nil {
+				return err
+			}
+
+			id := authorizationprovider.NewAuthorizationProviderID(apiManagementId.SubscriptionId, apiManagementId.ResourceGroupName, apiManagementId.ServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := authorizationprovider.AuthorizationProviderContract{
+				Properties: &authorizationprovider.AuthorizationProviderContractProperties{
+					IdentityProvider: pointer.To(model.IdentityProvider),
+					Oauth2:           expandApiManagementAuthorizationProviderOAuth2(model.OAuth2),
+				},
+			}
+
+			if model.DisplayName != "" {
+				payload.Properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload, authorizationprovider.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			id, err := authorizationprovider.ParseAuthorizationProviderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the grant type parameters contain secrets which the API doesn't return, so these are pulled from the config
+			var config ApiManagementAuthorizationProviderModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ApiManagementAuthorizationProviderModel{
+				Name:            id.AuthorizationProviderId,
+				ApiManagementId: apimanagementservice.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.IdentityProvider = pointer.From(props.IdentityProvider)
+					state.OAuth2 = flattenApiManagementAuthorizationProviderOAuth2(props.Oauth2, config.OAuth2)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			id, err := authorizationprovider.ParseAuthorizationProviderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementAuthorizationProviderModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("display_name") {
+				payload.Properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			// the existing grant type parameters don't contain the secrets, so these always need to be sent
+			payload.Properties.Oauth2 = expandApiManagementAuthorizationProviderOAuth2(model.OAuth2)
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload, authorizationprovider.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			id, err := authorizationprovider.ParseAuthorizationProviderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id, authorizationprovider.DeleteOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementAuthorizationProviderOAuth2(input []ApiManagementAuthorizationProviderOAuth2) *authorizationprovider.AuthorizationProviderOAuth2Settings {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := &authorizationprovider.AuthorizationProviderOAuth2Settings{
+		GrantTypes: &authorizationprovider.AuthorizationProviderOAuth2GrantTypes{},
+	}
+
+	if v := input[0].RedirectUrl; v != "" {
+		output.RedirectUrl = pointer.To(v)
+	}
+
+	if v := input[0].AuthorizationCodeParameters; len(v) > 0 {
+		output.GrantTypes.AuthorizationCode = pointer.To(v)
+	}
+
+	if v := input[0].ClientCredentialsParameters; len(v) > 0 {
+		output.GrantTypes.ClientCredentials = pointer.To(v)
+	}
+
+	return output
+}
+
+func flattenApiManagementAuthorizationProviderOAuth2(input *authorizationprovider.AuthorizationProviderOAuth2Settings, config []ApiManagementAuthorizationProviderOAuth2) []ApiManagementAuthorizationProviderOAuth2 {
+	if input == nil {
+		return []ApiManagementAuthorizationProviderOAuth2{}
+	}
+
+	output := ApiManagementAuthorizationProviderOAuth2{
+		RedirectUrl: pointer.From(input.RedirectUrl),
+	}
+
+	if len(config) > 0 {
+		output.AuthorizationCodeParameters = config[0].AuthorizationCodeParameters
+		output.ClientCredentialsParameters = config[0].ClientCredentialsParameters
+	} else if grantTypes := input.GrantTypes; grantTypes != nil {
+		output.AuthorizationCodeParameters = pointer.From(grantTypes.AuthorizationCode)
+		output.ClientCredentialsParameters = pointer.From(grantTypes.ClientCredentials)
+	}
+
+	return []ApiManagementAuthorizationProviderOAuth2{output}
+}
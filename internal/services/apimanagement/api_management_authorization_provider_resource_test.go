@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorizationprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementAuthorizationProviderResource struct{}
+
+func TestAccApiManagementAuthorizationProvider_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_provider", "test")
+	r := ApiManagementAuthorizationProviderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("oauth2.0.redirect_url").Exists(),
+			),
+		},
+		data.ImportStep("oauth2.0.authorization_code_parameters", "oauth2.0.client_credentials_parameters"),
+	})
+}
+
+func TestAccApiManagementAuthorizationProvider_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_provider", "test")
+	r := ApiManagementAuthorizationProviderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementAuthorizationProvider_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_provider", "test")
+	r := ApiManagementAuthorizationProviderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("oauth2.0.authorization_code_parameters", "oauth2.0.client_credentials_parameters"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("oauth2.0.authorization_code_parameters", "oauth2.0.client_credentials_parameters"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("oauth2.0.authorization_code_parameters", "oauth2.0.client_credentials_parameters"),
+	})
+}
+
+func (ApiManagementAuthorizationProviderResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := authorizationprovider.ParseAuthorizationProviderID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ApiManagement.AuthorizationProviderClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiManagementAuthorizationProviderResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization_provider" "test" {
+  name              = "acctest-ap-%d"
+  api_management_id = azurerm_api_management.test.id
+  identity_provider = "oauth2"
+
+  oauth2 {
+    authorization_code_parameters = {
+      clientId         = "00000000-0000-0000-0000-000000000000"
+      clientSecret     = "secret"
+      authorizationUrl = "https://login.example.com/oauth2/authorize"
+      tokenUrl         = "https://login.example.com/oauth2/token"
+      refreshUrl       = "https://login.example.com/oauth2/token"
+      scopes           = "read"
+    }
+  }
+}
+`, ApiManagementResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationProviderResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization_provider" "import" {
+  name              = azurerm_api_management_authorization_provider.test.name
+  api_management_id = azurerm_api_management_authorization_provider.test.api_management_id
+  identity_provider = azurerm_api_management_authorization_provider.test.identity_provider
+
+  oauth2 {
+    authorization_code_parameters = {
+      clientId         = "00000000-0000-0000-0000-000000000000"
+      clientSecret     = "secret"
+      authorizationUrl = "https://login.example.com/oauth2/authorize"
+      tokenUrl         = "https://login.example.com/oauth2/token"
+      refreshUrl       = "https://login.example.com/oauth2/token"
+      scopes           = "read"
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r ApiManagementAuthorizationProviderResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization_provider" "test" {
+  name              = "acctest-ap-%d"
+  api_management_id = azurerm_api_management.test.id
+  identity_provider = "oauth2"
+  display_name      = "Example Provider"
+
+  oauth2 {
+    authorization_code_parameters = {
+      clientId         = "00000000-0000-0000-0000-000000000000"
+      clientSecret     = "secret2"
+      authorizationUrl = "https://login.example.com/oauth2/authorize"
+      tokenUrl         = "https://login.example.com/oauth2/token"
+      refreshUrl       = "https://login.example.com/oauth2/token"
+      scopes           = "read write"
+    }
+
+    client_credentials_parameters = {
+      clientId     = "00000000-0000-0000-0000-000000000000"
+      clientSecret = "secret2"
+      tokenUrl     = "https://login.example.com/oauth2/token"
+      refreshUrl   = "https://login.example.com/oauth2/token"
+      scopes       = "read write"
+    }
+  }
+}
+`, ApiManagementResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorization"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorizationprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementAuthorizationModel struct {
+	Name                    string            `tfschema:"name"`
+	AuthorizationProviderId string            `tfschema:"authorization_provider_id"`
+	OAuth2GrantType         string            `tfschema:"oauth2_grant_type"`
+	Parameters              map[string]string `tfschema:"parameters"`
+	Status                  string            `tfschema:"status"`
+	ErrorCode               string            `tfschema:"error_code"`
+	ErrorMessage            string            `tfschema:"error_message"`
+}
+
+type ApiManagementAuthorizationResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementAuthorizationResource{}
+
+func (r ApiManagementAuthorizationResource) ResourceType() string {
+	return "azurerm_api_management_authorization"
+}
+
+func (r ApiManagementAuthorizationResource) ModelObject() interface{} {
+	return &ApiManagementAuthorizationModel{}
+}
+
+func (r ApiManagementAuthorizationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return authorization.ValidateAuthorizationID
+}
+
+func (r ApiManagementAuthorizationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"authorization_provider_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: authorizationprovider.ValidateAuthorizationProviderID,
+		},
+
+		"oauth2_grant_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(authorization.PossibleValuesForOAuth2GrantType(), false),
+		},
+
+		"parameters": {
+			Type:      pluginsdk.TypeMap,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"error_code": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"error_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			var model ApiManagementAuthorizationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			providerId, err := authorizationprovider.ParseAuthorizationProviderID(model.AuthorizationProviderId)
+			if err != nil {
+				return err
+			}
+
+			id := authorization.NewAuthorizationID(providerId.SubscriptionId, providerId.ResourceGroupName, providerId.ServiceName, providerId.AuthorizationProviderId, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := authorization.AuthorizationContract{
+				Properties: &authorization.AuthorizationContractProperties{
+					AuthorizationType: pointer.To(authorization.AuthorizationTypeOAuthTwo),
+					Oauth2grantType:   pointer.To(authorization.OAuth2GrantType(model.OAuth2GrantType)),
+				},
+			}
+
+			if len(model.Parameters) > 0 {
+				payload.Properties.Parameters = pointer.To(model.Parameters)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload, authorization.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			id, err := authorization.ParseAuthorizationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementAuthorizationModel{
+				Name:                    id.AuthorizationId,
+				AuthorizationProviderId: authorizationprovider.NewAuthorizationProviderID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.AuthorizationProviderId).ID(),
+			}
+
+			// the parameters contain secrets which the API doesn't return, so these are pulled from the config
+			if v, ok := metadata.ResourceData.GetOk("parameters"); ok {
+				parameters := make(map[string]string)
+				for k, val := range v.(map[string]interface{}) {
+					parameters[k] = val.(string)
+				}
+				state.Parameters = parameters
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.OAuth2GrantType = string(pointer.From(props.Oauth2grantType))
+					state.Status = pointer.From(props.Status)
+
+					if e := props.Error; e != nil {
+						state.ErrorCode = pointer.From(e.Code)
+						state.ErrorMessage = pointer.From(e.Message)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			id, err := authorization.ParseAuthorizationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementAuthorizationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := authorization.AuthorizationContract{
+				Properties: &authorization.AuthorizationContractProperties{
+					AuthorizationType: pointer.To(authorization.AuthorizationTypeOAuthTwo),
+					Oauth2grantType:   pointer.To(authorization.OAuth2GrantType(model.OAuth2GrantType)),
+					Parameters:        pointer.To(model.Parameters),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload, authorization.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			id, err := authorization.ParseAuthorizationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id, authorization.DeleteOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
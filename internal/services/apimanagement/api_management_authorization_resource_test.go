@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementAuthorizationResource struct{}
+
+func TestAccApiManagementAuthorization_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization", "test")
+	r := ApiManagementAuthorizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementAuthorization_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization", "test")
+	r := ApiManagementAuthorizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (ApiManagementAuthorizationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := authorization.ParseAuthorizationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ApiManagement.AuthorizationClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiManagementAuthorizationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization" "test" {
+  name                      = "acctest-auth-%d"
+  authorization_provider_id = azurerm_api_management_authorization_provider.test.id
+  oauth2_grant_type         = "AuthorizationCode"
+}
+`, ApiManagementAuthorizationProviderResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization" "import" {
+  name                      = azurerm_api_management_authorization.test.name
+  authorization_provider_id = azurerm_api_management_authorization.test.authorization_provider_id
+  oauth2_grant_type         = azurerm_api_management_authorization.test.oauth2_grant_type
+}
+`, r.basic(data))
+}
@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/delegationsettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/deletedservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/policy"
@@ -28,12 +27,14 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/signinsettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/signupsettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/tenantaccess"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/apimanagementservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/nameavailability"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
 	apimValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -95,6 +96,18 @@ func resourceApiManagementService() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("virtual_network_configuration", func(ctx context.Context, old, new, meta interface{}) bool {
 				return !(len(old.([]interface{})) == 0 && len(new.([]interface{})) > 0)
 			}),
+
+			// the v2 tiers run on a different platform, so moving between a classic tier and a v2 tier isn't possible in-place
+			pluginsdk.ForceNewIfChange("sku_name", func(ctx context.Context, old, new, meta interface{}) bool {
+				if old.(string) == "" {
+					return false
+				}
+				return skuNameIsV2(old.(string)) != skuNameIsV2(new.(string))
+			}),
+
+			pluginsdk.CustomizeDiffShim(apiManagementServiceV2SkuCustomizeDiff),
+
+			nameavailability.CustomizeDiff("API Management Service", apiManagementServiceNameAvailability),
 		),
 	}
 }
@@ -1764,6 +1777,49 @@ func expandAzureRmApiManagementSkuName(input string) apimanagementservice.ApiMan
 	}
 }
 
+// skuNameIsV2 returns whether the specified `sku_name` is one of the v2 tiers (e.g. `BasicV2_1` or `StandardV2_1`)
+func skuNameIsV2(input string) bool {
+	name := strings.Split(input, "_")[0]
+	return name == string(apimanagementservice.SkuTypeBasicVTwo) || name == string(apimanagementservice.SkuTypeStandardVTwo)
+}
+
+// apiManagementServiceV2SkuCustomizeDiff validates the networking model of the v2 tiers, which differs from the
+// classic tiers: the gateway is integrated into a subnet delegated to `Microsoft.Web/serverFarms` for outbound traffic
+// only, rather than being injected into the Virtual Network.
+func apiManagementServiceV2SkuCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	skuName := diff.Get("sku_name").(string)
+	if !skuNameIsV2(skuName) {
+		return nil
+	}
+	sku := expandAzureRmApiManagementSkuName(skuName)
+
+	virtualNetworkType := diff.Get("virtual_network_type").(string)
+	switch sku.Name {
+	case apimanagementservice.SkuTypeBasicVTwo:
+		if virtualNetworkType != string(apimanagementservice.VirtualNetworkTypeNone) {
+			return fmt.Errorf("`virtual_network_type` must be `None` when `sku_name` is a `BasicV2` tier")
+		}
+	case apimanagementservice.SkuTypeStandardVTwo:
+		if virtualNetworkType == string(apimanagementservice.VirtualNetworkTypeInternal) {
+			return fmt.Errorf("`virtual_network_type` must be either `None` or `External` when `sku_name` is a `StandardV2` tier")
+		}
+	}
+
+	if v, ok := diff.GetOk("public_ip_address_id"); ok && v.(string) != "" {
+		return fmt.Errorf("`public_ip_address_id` is not supported when `sku_name` is a `%s` tier", sku.Name)
+	}
+
+	if v, ok := diff.GetOk("additional_location"); ok && len(v.([]interface{})) > 0 {
+		return fmt.Errorf("`additional_location` is not supported when `sku_name` is a `%s` tier", sku.Name)
+	}
+
+	if v, ok := diff.GetOk("zones"); ok && v.(*schema.Set).Len() > 0 {
+		return fmt.Errorf("`zones` is not supported when `sku_name` is a `%s` tier", sku.Name)
+	}
+
+	return nil
+}
+
 func flattenApiManagementServiceSkuName(input *apimanagementservice.ApiManagementServiceSkuProperties) string {
 	if input == nil {
 		return ""
@@ -2264,3 +2320,21 @@ this into Terraform via "terraform import", or pick a different name/location.
 `
 	return fmt.Sprintf(message, name, location)
 }
+
+func apiManagementServiceNameAvailability(ctx context.Context, client *clients.Client, _ *pluginsdk.ResourceDiff, name string) (*nameavailability.Result, error) {
+	input := apimanagementservice.ApiManagementServiceCheckNameAvailabilityParameters{
+		Name: name,
+	}
+	resp, err := client.ApiManagement.ServiceClient.CheckNameAvailability(ctx, commonids.NewSubscriptionID(client.Account.SubscriptionId), input)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Model == nil || resp.Model.NameAvailable == nil {
+		return nil, fmt.Errorf("`model` was nil")
+	}
+
+	return &nameavailability.Result{
+		Available: *resp.Model.NameAvailable,
+		Message:   pointer.From(resp.Model.Message),
+	}, nil
+}
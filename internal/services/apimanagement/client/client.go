@@ -8,7 +8,6 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apidiagnostic"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperation"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperationpolicy"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperationtag"
@@ -19,6 +18,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apitagdescription"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apiversionset"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apiversionsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorization"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorizationaccesspolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorizationprovider"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorizationserver"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/backend"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/cache"
@@ -53,6 +55,11 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/tag"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/tenantaccess"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/user"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/apimanagementservice"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/graphqlapiresolver"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/graphqlapiresolverpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/workspace"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/workspacepolicy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -69,6 +76,9 @@ type Client struct {
 	ApiTagDescriptionClient            *apitagdescription.ApiTagDescriptionClient
 	ApiVersionSetClient                *apiversionset.ApiVersionSetClient
 	ApiVersionSetsClient               *apiversionsets.ApiVersionSetsClient
+	AuthorizationAccessPolicyClient    *authorizationaccesspolicy.AuthorizationAccessPolicyClient
+	AuthorizationClient                *authorization.AuthorizationClient
+	AuthorizationProviderClient        *authorizationprovider.AuthorizationProviderClient
 	AuthorizationServersClient         *authorizationserver.AuthorizationServerClient
 	BackendClient                      *backend.BackendClient
 	CacheClient                        *cache.CacheClient
@@ -82,6 +92,8 @@ type Client struct {
 	GatewayClient                      *gateway.GatewayClient
 	GatewayHostNameConfigurationClient *gatewayhostnameconfiguration.GatewayHostnameConfigurationClient
 	GlobalSchemaClient                 *schema.SchemaClient
+	GraphQLApiResolverClient           *graphqlapiresolver.GraphQLApiResolverClient
+	GraphQLApiResolverPolicyClient     *graphqlapiresolverpolicy.GraphQLApiResolverPolicyClient
 	GroupClient                        *group.GroupClient
 	GroupUsersClient                   *groupuser.GroupUserClient
 	IdentityProviderClient             *identityprovider.IdentityProviderClient
@@ -104,6 +116,8 @@ type Client struct {
 	TagClient                          *tag.TagClient
 	TenantAccessClient                 *tenantaccess.TenantAccessClient
 	UsersClient                        *user.UserClient
+	WorkspaceClient                    *workspace.WorkspaceClient
+	WorkspacePolicyClient              *workspacepolicy.WorkspacePolicyClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -179,6 +193,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(apiTagDescriptionClient.Client, o.Authorizers.ResourceManager)
 
+	authorizationAccessPolicyClient, err := authorizationaccesspolicy.NewAuthorizationAccessPolicyClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorization Access Policy client: %+v", err)
+	}
+	o.Configure(authorizationAccessPolicyClient.Client, o.Authorizers.ResourceManager)
+
+	authorizationClient, err := authorization.NewAuthorizationClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorization client: %+v", err)
+	}
+	o.Configure(authorizationClient.Client, o.Authorizers.ResourceManager)
+
+	authorizationProviderClient, err := authorizationprovider.NewAuthorizationProviderClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorization Provider client: %+v", err)
+	}
+	o.Configure(authorizationProviderClient.Client, o.Authorizers.ResourceManager)
+
 	authorizationServersClient, err := authorizationserver.NewAuthorizationServerClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Authorization Servers client: %+v", err)
@@ -257,6 +289,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(globalSchemaClient.Client, o.Authorizers.ResourceManager)
 
+	graphQLApiResolverClient, err := graphqlapiresolver.NewGraphQLApiResolverClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building GraphQL API Resolver client: %+v", err)
+	}
+	o.Configure(graphQLApiResolverClient.Client, o.Authorizers.ResourceManager)
+
+	graphQLApiResolverPolicyClient, err := graphqlapiresolverpolicy.NewGraphQLApiResolverPolicyClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building GraphQL API Resolver Policy client: %+v", err)
+	}
+	o.Configure(graphQLApiResolverPolicyClient.Client, o.Authorizers.ResourceManager)
+
 	groupClient, err := group.NewGroupClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Group client: %+v", err)
@@ -389,6 +433,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(usersClient.Client, o.Authorizers.ResourceManager)
 
+	workspaceClient, err := workspace.NewWorkspaceClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Workspace client: %+v", err)
+	}
+	o.Configure(workspaceClient.Client, o.Authorizers.ResourceManager)
+
+	workspacePolicyClient, err := workspacepolicy.NewWorkspacePolicyClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Workspace Policy client: %+v", err)
+	}
+	o.Configure(workspacePolicyClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ApiClient:                          apiClient,
 		ApiDiagnosticClient:                apiDiagnosticClient,
@@ -402,6 +458,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ApiTagDescriptionClient:            apiTagDescriptionClient,
 		ApiVersionSetClient:                apiVersionSetClient,
 		ApiVersionSetsClient:               apiVersionSetsClient,
+		AuthorizationAccessPolicyClient:    authorizationAccessPolicyClient,
+		AuthorizationClient:                authorizationClient,
+		AuthorizationProviderClient:        authorizationProviderClient,
 		AuthorizationServersClient:         authorizationServersClient,
 		BackendClient:                      backendClient,
 		CacheClient:                        cacheClient,
@@ -415,6 +474,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		GatewayClient:                      gatewayClient,
 		GatewayHostNameConfigurationClient: gatewayHostnameConfigurationClient,
 		GlobalSchemaClient:                 globalSchemaClient,
+		GraphQLApiResolverClient:           graphQLApiResolverClient,
+		GraphQLApiResolverPolicyClient:     graphQLApiResolverPolicyClient,
 		GroupClient:                        groupClient,
 		GroupUsersClient:                   groupUsersClient,
 		IdentityProviderClient:             identityProviderClient,
@@ -437,5 +498,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		TagClient:                          tagClient,
 		TenantAccessClient:                 tenantAccessClient,
 		UsersClient:                        usersClient,
+		WorkspaceClient:                    workspaceClient,
+		WorkspacePolicyClient:              workspacePolicyClient,
 	}, nil
 }
@@ -53,6 +53,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_api_management_api_operation_tag":               resourceApiManagementApiOperationTag(),
 		"azurerm_api_management_api_policy":                      resourceApiManagementApiPolicy(),
 		"azurerm_api_management_api_release":                     resourceApiManagementApiRelease(),
+		"azurerm_api_management_api_resolver":                    resourceApiManagementApiResolver(),
+		"azurerm_api_management_api_resolver_policy":             resourceApiManagementApiResolverPolicy(),
 		"azurerm_api_management_api_schema":                      resourceApiManagementApiSchema(),
 		"azurerm_api_management_api_tag":                         resourceApiManagementApiTag(),
 		"azurerm_api_management_api_tag_description":             resourceApiManagementApiTagDescription(),
@@ -90,6 +92,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_api_management_subscription":                    resourceApiManagementSubscription(),
 		"azurerm_api_management_tag":                             resourceApiManagementTag(),
 		"azurerm_api_management_user":                            resourceApiManagementUser(),
+		"azurerm_api_management_workspace":                       resourceApiManagementWorkspace(),
+		"azurerm_api_management_workspace_policy":                resourceApiManagementWorkspacePolicy(),
 	}
 }
 
@@ -99,6 +103,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ApiManagementAuthorizationAccessPolicyResource{},
+		ApiManagementAuthorizationProviderResource{},
+		ApiManagementAuthorizationResource{},
 		ApiManagementNotificationRecipientEmailResource{},
 		ApiManagementNotificationRecipientUserResource{},
 	}
@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/appconfiguration/1.0/appconfiguration"
+)
+
+// KeyValuesAllKeys is used as the Key within the ID of an `azurerm_app_configuration_key_values` resource,
+// since it manages every key-value within a Label rather than a single Key
+const KeyValuesAllKeys = "*"
+
+type KeyValuesResource struct{}
+
+var _ sdk.ResourceWithUpdate = KeyValuesResource{}
+
+type KeyValuesResourceModel struct {
+	ConfigurationStoreId string            `tfschema:"configuration_store_id"`
+	Label                string            `tfschema:"label"`
+	ContentType          string            `tfschema:"content_type"`
+	KeyValues            map[string]string `tfschema:"key_values"`
+}
+
+func (k KeyValuesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: configurationstores.ValidateConfigurationStoreID,
+		},
+
+		"key_values": {
+			Type:     pluginsdk.TypeMap,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+			ValidateFunc: validateAppConfigurationKeyValuesKeys,
+		},
+
+		"label": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+
+		"content_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func (k KeyValuesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (k KeyValuesResource) ModelObject() interface{} {
+	return &KeyValuesResourceModel{}
+}
+
+func (k KeyValuesResource) ResourceType() string {
+	return "azurerm_app_configuration_key_values"
+}
+
+func (k KeyValuesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NestedItemId
+}
+
+func (k KeyValuesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KeyValuesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			configurationStoreEndpoint, err := metadata.Client.AppConfiguration.EndpointForConfigurationStore(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("retrieving Endpoint for key values with label %q in %q: %s", model.Label, *configurationStoreId, err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(*configurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			// the workaround client is needed to list all the key-values, since the `nextLink` doesn't contain the endpoint
+			listClient, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(*configurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			id, err := parse.NewNestedItemID(*configurationStoreEndpoint, KeyValuesAllKeys, model.Label)
+			if err != nil {
+				return err
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+
+			// from https://learn.microsoft.com/en-us/azure/azure-app-configuration/concept-enable-rbac#azure-built-in-roles-for-azure-app-configuration
+			// allow some time for role permission to be propagated
+			metadata.Logger.Infof("[DEBUG] Waiting for App Configuration key values with label %q read permission to be propagated", model.Label)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:                   []string{"Forbidden"},
+				Target:                    []string{"Error", "Exists"},
+				Refresh:                   appConfigurationListKeyValuesRefreshFunc(ctx, listClient, model.Label),
+				PollInterval:              10 * time.Second,
+				ContinuousTargetOccurence: 3,
+				Timeout:                   time.Until(deadline),
+			}
+
+			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for App Configuration key values with label %q read permission to be propagated: %+v", model.Label, err)
+			}
+
+			existing, err := listAppConfigurationKeyValues(ctx, listClient, model.Label)
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if len(existing) > 0 {
+				return tf.ImportAsExistsError(k.ResourceType(), id.ID())
+			}
+
+			for key, value := range model.KeyValues {
+				if err := putAppConfigurationKeyValue(ctx, client, key, model.Label, value, model.ContentType); err != nil {
+					return err
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 45 * time.Minute,
+	}
+}
+
+func (k KeyValuesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+			if id.Key != KeyValuesAllKeys {
+				return fmt.Errorf("expected the Key within the ID %q to be %q but got %q", metadata.ResourceData.Id(), KeyValuesAllKeys, id.Key)
+			}
+
+			domainSuffix, ok := metadata.Client.Account.Environment.AppConfiguration.DomainSuffix()
+			if !ok {
+				return fmt.Errorf("could not determine AppConfiguration domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+			}
+
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+			configurationStoreIdRaw, err := metadata.Client.AppConfiguration.ConfigurationStoreIDFromEndpoint(ctx, subscriptionId, id.ConfigurationStoreEndpoint, *domainSuffix)
+			if err != nil {
+				return fmt.Errorf("while retrieving the Resource ID of Configuration Store at Endpoint: %q: %s", id.ConfigurationStoreEndpoint, err)
+			}
+			if configurationStoreIdRaw == nil {
+				// if the AppConfiguration is gone then all the data inside it is too
+				log.Printf("[DEBUG] Unable to determine the Resource ID for Configuration Store at Endpoint %q - removing from state", id.ConfigurationStoreEndpoint)
+				return metadata.MarkAsGone(id)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(*configurationStoreIdRaw)
+			if err != nil {
+				return err
+			}
+
+			exists, err := metadata.Client.AppConfiguration.Exists(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("while checking Configuration Store %q for key values %q existence: %v", *configurationStoreId, *id, err)
+			}
+			if !exists {
+				log.Printf("[DEBUG] Configuration Store %q for key values %q was not found - removing from state", *configurationStoreId, *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			items, err := listAppConfigurationKeyValues(ctx, client, id.Label)
+			if err != nil {
+				return fmt.Errorf("listing %s: %+v", *id, err)
+			}
+
+			model := KeyValuesResourceModel{
+				ConfigurationStoreId: configurationStoreId.ID(),
+				Label:                id.Label,
+				KeyValues:            make(map[string]string),
+			}
+
+			// the content type is applied to every key-value, so only surface it when they all agree
+			contentTypes := make(map[string]struct{})
+			for _, item := range items {
+				model.KeyValues[pointer.From(item.Key)] = pointer.From(item.Value)
+				contentTypes[pointer.From(item.ContentType)] = struct{}{}
+			}
+			if len(contentTypes) == 1 {
+				for contentType := range contentTypes {
+					model.ContentType = contentType
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (k KeyValuesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			listClient, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			var model KeyValuesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			existing, err := listAppConfigurationKeyValues(ctx, listClient, id.Label)
+			if err != nil {
+				return fmt.Errorf("listing %s: %+v", *id, err)
+			}
+
+			// only write the key-values which have changed, since each key-value is a separate request
+			existingKeyValues := make(map[string]appconfiguration.KeyValue)
+			for _, item := range existing {
+				existingKeyValues[pointer.From(item.Key)] = item
+			}
+
+			for key, value := range model.KeyValues {
+				if item, ok := existingKeyValues[key]; ok && pointer.From(item.Value) == value && pointer.From(item.ContentType) == model.ContentType {
+					continue
+				}
+
+				if err := putAppConfigurationKeyValue(ctx, client, key, id.Label, value, model.ContentType); err != nil {
+					return err
+				}
+			}
+
+			// this resource is authoritative for the key-values within the label, so remove anything which isn't defined
+			for key := range existingKeyValues {
+				if _, ok := model.KeyValues[key]; ok {
+					continue
+				}
+
+				if _, err := client.DeleteKeyValue(ctx, key, id.Label, ""); err != nil {
+					return fmt.Errorf("while removing key %q with label %q: %+v", key, id.Label, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (k KeyValuesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			var model KeyValuesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			for key := range model.KeyValues {
+				resp, err := client.DeleteKeyValue(ctx, key, id.Label, "")
+				if err != nil {
+					if utils.ResponseWasNotFound(resp.Response) {
+						continue
+					}
+					return fmt.Errorf("while removing key %q with label %q: %+v", key, id.Label, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+// listAppConfigurationKeyValues returns the plain key-values within the specified label, excluding
+// Feature Flags and Key Vault references since these are managed by other resources
+func listAppConfigurationKeyValues(ctx context.Context, client *azuresdkhacks.DataPlaneClient, label string) ([]appconfiguration.KeyValue, error) {
+	iter, err := client.GetKeyValuesComplete(ctx, "", appConfigurationLabelFilter(label), "", "", []appconfiguration.KeyValueFields{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]appconfiguration.KeyValue, 0)
+	for iter.NotDone() {
+		kv := iter.Value()
+		contentType := pointer.From(kv.ContentType)
+		if contentType != VaultKeyContentType && contentType != FeatureKeyContentType && !strings.HasPrefix(pointer.From(kv.Key), FeatureKeyPrefix) {
+			result = append(result, appconfiguration.KeyValue{
+				Key:         kv.Key,
+				Label:       kv.Label,
+				ContentType: kv.ContentType,
+				Value:       kv.Value,
+			})
+		}
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func putAppConfigurationKeyValue(ctx context.Context, client *appconfiguration.BaseClient, key, label, value, contentType string) error {
+	entity := appconfiguration.KeyValue{
+		Key:         utils.String(key),
+		Label:       utils.String(label),
+		Value:       utils.String(value),
+		ContentType: utils.String(contentType),
+	}
+
+	if _, err := client.PutKeyValue(ctx, key, label, &entity, "", ""); err != nil {
+		return fmt.Errorf("while setting key %q with label %q: %+v", key, label, err)
+	}
+
+	return nil
+}
+
+// appConfigurationLabelFilter returns the label filter used when listing key-values, where the
+// null character is used to filter on key-values without a label
+func appConfigurationLabelFilter(label string) string {
+	if label == "" {
+		return "\x00"
+	}
+
+	return label
+}
+
+func appConfigurationListKeyValuesRefreshFunc(ctx context.Context, client *azuresdkhacks.DataPlaneClient, label string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.GetKeyValues(ctx, "", appConfigurationLabelFilter(label), "", "", []appconfiguration.KeyValueFields{})
+		if err != nil {
+			if v, ok := err.(autorest.DetailedError); ok {
+				if utils.ResponseWasForbidden(autorest.Response{Response: v.Response}) {
+					return "Forbidden", "Forbidden", nil
+				}
+			}
+			return res, "Error", nil
+		}
+
+		return res, "Exists", nil
+	}
+}
+
+func validateAppConfigurationKeyValuesKeys(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be map", k))
+		return warnings, errors
+	}
+
+	for key := range v {
+		if strings.TrimSpace(key) == "" {
+			errors = append(errors, fmt.Errorf("%q cannot contain an empty key", k))
+		}
+		if key == "." || key == ".." || strings.Contains(key, "%") {
+			errors = append(errors, fmt.Errorf("%q contains the invalid key %q: keys cannot be `.` or `..` or contain `%%`", k, key))
+		}
+		if strings.HasPrefix(key, FeatureKeyPrefix) {
+			errors = append(errors, fmt.Errorf("%q contains the key %q: Feature Flags should be managed using the `azurerm_app_configuration_feature` resource", k, key))
+		}
+	}
+
+	return warnings, errors
+}
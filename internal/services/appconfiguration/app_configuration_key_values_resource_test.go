@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/appconfiguration/1.0/appconfiguration"
+)
+
+type AppConfigurationKeyValuesResource struct{}
+
+func TestAccAppConfigurationKeyValues_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_key_values", "test")
+	r := AppConfigurationKeyValuesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_values.%").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationKeyValues_noLabel(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_key_values", "test")
+	r := AppConfigurationKeyValuesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.noLabel(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationKeyValues_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_key_values", "test")
+	r := AppConfigurationKeyValuesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationKeyValues_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_key_values", "test")
+	r := AppConfigurationKeyValuesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_values.%").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t AppConfigurationKeyValuesResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	nestedItemId, err := parse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing resource ID: %+v", err)
+	}
+
+	client, err := clients.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(nestedItemId.ConfigurationStoreEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	label := nestedItemId.Label
+	if label == "" {
+		label = "\x00"
+	}
+
+	res, err := client.GetKeyValues(ctx, "", label, "", "", []appconfiguration.KeyValueFields{})
+	if err != nil {
+		return nil, fmt.Errorf("while checking for key values with label %q existence: %+v", nestedItemId.Label, err)
+	}
+
+	return utils.Bool(len(res.Values()) > 0), nil
+}
+
+func (t AppConfigurationKeyValuesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key_values" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  label                  = "acctest-label-%d"
+  content_type           = "text/plain"
+
+  key_values = {
+    "app:name"    = "acctest"
+    "app:version" = "1.0"
+  }
+}
+`, AppConfigurationKeyResource{}.base(data), data.RandomInteger)
+}
+
+func (t AppConfigurationKeyValuesResource) noLabel(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key_values" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+
+  key_values = jsondecode(<<JSON
+{
+  "app:name": "acctest",
+  "app:settings:colour": "blue"
+}
+JSON
+  )
+}
+`, AppConfigurationKeyResource{}.base(data))
+}
+
+func (t AppConfigurationKeyValuesResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key_values" "import" {
+  configuration_store_id = azurerm_app_configuration_key_values.test.configuration_store_id
+  label                  = azurerm_app_configuration_key_values.test.label
+  content_type           = azurerm_app_configuration_key_values.test.content_type
+  key_values             = azurerm_app_configuration_key_values.test.key_values
+}
+`, t.basic(data))
+}
+
+func (t AppConfigurationKeyValuesResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key_values" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  label                  = "acctest-label-%d"
+  content_type           = "application/json"
+
+  key_values = {
+    "app:name"    = "acctest-updated"
+    "app:version" = "2.0"
+    "app:owner"   = "acctest"
+  }
+}
+`, AppConfigurationKeyResource{}.base(data), data.RandomInteger)
+}
@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SnapshotResource struct{}
+
+var _ sdk.Resource = SnapshotResource{}
+
+type SnapshotResourceModel struct {
+	Name                     string                 `tfschema:"name"`
+	ConfigurationStoreId     string                 `tfschema:"configuration_store_id"`
+	CompositionType          string                 `tfschema:"composition_type"`
+	Filter                   []SnapshotFilterModel  `tfschema:"filter"`
+	RetentionPeriodInSeconds int64                  `tfschema:"retention_period_in_seconds"`
+	Tags                     map[string]interface{} `tfschema:"tags"`
+	Etag                     string                 `tfschema:"etag"`
+	ItemsCount               int64                  `tfschema:"items_count"`
+	SizeInBytes              int64                  `tfschema:"size_in_bytes"`
+	Status                   string                 `tfschema:"status"`
+}
+
+type SnapshotFilterModel struct {
+	Key   string `tfschema:"key"`
+	Label string `tfschema:"label"`
+}
+
+func (r SnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: configurationstores.ValidateConfigurationStoreID,
+		},
+
+		"filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			MaxItems: 3,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"label": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"composition_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(azuresdkhacks.SnapshotCompositionTypeKey),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(azuresdkhacks.SnapshotCompositionTypeKey),
+				string(azuresdkhacks.SnapshotCompositionTypeKeyLabel),
+			}, false),
+		},
+
+		"retention_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(3600, 7776000),
+		},
+
+		"tags": tags.ForceNewSchema(),
+	}
+}
+
+func (r SnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"etag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"items_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"size_in_bytes": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SnapshotResource) ModelObject() interface{} {
+	return &SnapshotResourceModel{}
+}
+
+func (r SnapshotResource) ResourceType() string {
+	return "azurerm_app_configuration_snapshot"
+}
+
+func (r SnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SnapshotId
+}
+
+func (r SnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SnapshotResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			configurationStoreEndpoint, err := metadata.Client.AppConfiguration.EndpointForConfigurationStore(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("retrieving Endpoint for snapshot %q in %q: %s", model.Name, *configurationStoreId, err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(*configurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			id, err := parse.NewSnapshotID(*configurationStoreEndpoint, model.Name)
+			if err != nil {
+				return err
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+
+			// from https://learn.microsoft.com/en-us/azure/azure-app-configuration/concept-enable-rbac#azure-built-in-roles-for-azure-app-configuration
+			// allow some time for role permission to be propagated
+			metadata.Logger.Infof("[DEBUG] Waiting for App Configuration Snapshot %q read permission to be propagated", model.Name)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:                   []string{"Forbidden"},
+				Target:                    []string{"Error", "Exists", "NotFound"},
+				Refresh:                   appConfigurationGetSnapshotRefreshFunc(ctx, client, model.Name),
+				PollInterval:              10 * time.Second,
+				ContinuousTargetOccurence: 3,
+				Timeout:                   time.Until(deadline),
+			}
+
+			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for App Configuration Snapshot %q read permission to be propagated: %+v", model.Name, err)
+			}
+
+			existing, err := client.GetSnapshot(ctx, model.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			} else {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			compositionType := azuresdkhacks.SnapshotCompositionType(model.CompositionType)
+			snapshot := azuresdkhacks.Snapshot{
+				CompositionType: &compositionType,
+				Filters:         expandAppConfigurationSnapshotFilters(model.Filter),
+				Tags:            tags.Expand(model.Tags),
+			}
+
+			if model.RetentionPeriodInSeconds != 0 {
+				snapshot.RetentionPeriod = pointer.To(model.RetentionPeriodInSeconds)
+			}
+
+			if _, err := client.PutSnapshot(ctx, model.Name, snapshot); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.Logger.Infof("[DEBUG] Waiting for App Configuration Snapshot %q to be provisioned", model.Name)
+			stateConf = &pluginsdk.StateChangeConf{
+				Pending:    []string{"NotFound", "Forbidden", string(azuresdkhacks.SnapshotStatusProvisioning)},
+				Target:     []string{string(azuresdkhacks.SnapshotStatusReady)},
+				Refresh:    appConfigurationGetSnapshotRefreshFunc(ctx, client, model.Name),
+				MinTimeout: 5 * time.Second,
+				Timeout:    time.Until(deadline),
+			}
+
+			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for App Configuration Snapshot %q to be provisioned: %+v", model.Name, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 45 * time.Minute,
+	}
+}
+
+func (r SnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			domainSuffix, ok := metadata.Client.Account.Environment.AppConfiguration.DomainSuffix()
+			if !ok {
+				return fmt.Errorf("could not determine AppConfiguration domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+			}
+
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+			configurationStoreIdRaw, err := metadata.Client.AppConfiguration.ConfigurationStoreIDFromEndpoint(ctx, subscriptionId, id.ConfigurationStoreEndpoint, *domainSuffix)
+			if err != nil {
+				return fmt.Errorf("while retrieving the Resource ID of Configuration Store at Endpoint: %q: %s", id.ConfigurationStoreEndpoint, err)
+			}
+			if configurationStoreIdRaw == nil {
+				// if the AppConfiguration is gone then all the data inside it is too
+				log.Printf("[DEBUG] Unable to determine the Resource ID for Configuration Store at Endpoint %q - removing from state", id.ConfigurationStoreEndpoint)
+				return metadata.MarkAsGone(id)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(*configurationStoreIdRaw)
+			if err != nil {
+				return err
+			}
+
+			exists, err := metadata.Client.AppConfiguration.Exists(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("while checking Configuration Store %q for snapshot %q existence: %v", *configurationStoreId, *id, err)
+			}
+			if !exists {
+				log.Printf("[DEBUG] Configuration Store %q for snapshot %q was not found - removing from state", *configurationStoreId, *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := client.GetSnapshot(ctx, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(snapshot.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// Snapshots can't be deleted, only archived - so an archived Snapshot is treated as gone
+			if pointer.From(snapshot.Status) == azuresdkhacks.SnapshotStatusArchived {
+				log.Printf("[DEBUG] %s has been archived - removing from state", *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			model := SnapshotResourceModel{
+				Name:                     id.Name,
+				ConfigurationStoreId:     configurationStoreId.ID(),
+				CompositionType:          string(pointer.From(snapshot.CompositionType)),
+				Filter:                   flattenAppConfigurationSnapshotFilters(snapshot.Filters),
+				RetentionPeriodInSeconds: pointer.From(snapshot.RetentionPeriod),
+				Tags:                     tags.Flatten(snapshot.Tags),
+				Etag:                     pointer.From(snapshot.Etag),
+				ItemsCount:               pointer.From(snapshot.ItemsCount),
+				SizeInBytes:              pointer.From(snapshot.Size),
+				Status:                   string(pointer.From(snapshot.Status)),
+			}
+
+			return metadata.Encode(&model)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r SnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			// Snapshots can't be deleted, instead they're archived and then removed once the retention period expires
+			input := azuresdkhacks.SnapshotUpdateParameters{
+				Status: pointer.To(azuresdkhacks.SnapshotStatusArchived),
+			}
+			if _, err := client.UpdateSnapshot(ctx, id.Name, input); err != nil {
+				return fmt.Errorf("archiving %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func appConfigurationGetSnapshotRefreshFunc(ctx context.Context, client *azuresdkhacks.DataPlaneClient, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.GetSnapshot(ctx, name)
+		if err != nil {
+			if v, ok := err.(autorest.DetailedError); ok {
+				if utils.ResponseWasForbidden(autorest.Response{Response: v.Response}) {
+					return "Forbidden", "Forbidden", nil
+				}
+				if utils.ResponseWasNotFound(autorest.Response{Response: v.Response}) {
+					return "NotFound", "NotFound", nil
+				}
+			}
+			return res, "Error", nil
+		}
+
+		if res.Status == nil {
+			return res, "Exists", nil
+		}
+
+		if *res.Status == azuresdkhacks.SnapshotStatusFailed {
+			return res, string(*res.Status), fmt.Errorf("provisioning of snapshot %q failed", name)
+		}
+
+		return res, string(*res.Status), nil
+	}
+}
+
+func expandAppConfigurationSnapshotFilters(input []SnapshotFilterModel) *[]azuresdkhacks.SnapshotKeyValueFilter {
+	result := make([]azuresdkhacks.SnapshotKeyValueFilter, 0)
+	for _, v := range input {
+		filter := azuresdkhacks.SnapshotKeyValueFilter{
+			Key: pointer.To(v.Key),
+		}
+		if v.Label != "" {
+			filter.Label = pointer.To(v.Label)
+		}
+		result = append(result, filter)
+	}
+
+	return &result
+}
+
+func flattenAppConfigurationSnapshotFilters(input *[]azuresdkhacks.SnapshotKeyValueFilter) []SnapshotFilterModel {
+	result := make([]SnapshotFilterModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, SnapshotFilterModel{
+			Key:   pointer.From(v.Key),
+			Label: pointer.From(v.Label),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppConfigurationSnapshotResource struct{}
+
+func TestAccAppConfigurationSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("ready"),
+				check.That(data.ResourceName).Key("items_count").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("items_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t AppConfigurationSnapshotResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseSnapshotID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing resource ID: %+v", err)
+	}
+
+	client, err := clients.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSnapshot(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(pointer.From(resp.Status) != azuresdkhacks.SnapshotStatusArchived), nil
+}
+
+func (t AppConfigurationSnapshotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  name                   = "acctest-snapshot-%d"
+  configuration_store_id = azurerm_app_configuration.test.id
+
+  filter {
+    key = "acctest-ackey-*"
+  }
+
+  depends_on = [
+    azurerm_app_configuration_key.first,
+  ]
+}
+`, t.template(data), data.RandomInteger)
+}
+
+func (t AppConfigurationSnapshotResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "import" {
+  name                   = azurerm_app_configuration_snapshot.test.name
+  configuration_store_id = azurerm_app_configuration_snapshot.test.configuration_store_id
+
+  filter {
+    key = "acctest-ackey-*"
+  }
+}
+`, t.basic(data))
+}
+
+func (t AppConfigurationSnapshotResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key" "second" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest-other-%[2]d"
+  label                  = "acctest-label"
+  value                  = "second"
+}
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  name                        = "acctest-snapshot-%[2]d"
+  configuration_store_id      = azurerm_app_configuration.test.id
+  composition_type            = "key_label"
+  retention_period_in_seconds = 3600
+
+  filter {
+    key = "acctest-ackey-*"
+  }
+
+  filter {
+    key   = "acctest-other-*"
+    label = "acctest-label"
+  }
+
+  tags = {
+    environment = "test"
+  }
+
+  depends_on = [
+    azurerm_app_configuration_key.first,
+    azurerm_app_configuration_key.second,
+  ]
+}
+`, t.template(data), data.RandomInteger)
+}
+
+func (t AppConfigurationSnapshotResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key" "first" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest-ackey-%d"
+  value                  = "first"
+}
+`, AppConfigurationKeyResource{}.base(data), data.RandomInteger)
+}
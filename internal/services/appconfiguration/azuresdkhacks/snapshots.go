@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: the `1.0` data plane API used by the base client doesn't support Snapshots, which were only
+// introduced in `2023-10-01` - so until the base layer supports this version we call it directly
+
+const snapshotsAPIVersion = "2023-10-01"
+
+type SnapshotCompositionType string
+
+const (
+	SnapshotCompositionTypeKey      SnapshotCompositionType = "key"
+	SnapshotCompositionTypeKeyLabel SnapshotCompositionType = "key_label"
+)
+
+type SnapshotStatus string
+
+const (
+	SnapshotStatusArchived     SnapshotStatus = "archived"
+	SnapshotStatusFailed       SnapshotStatus = "failed"
+	SnapshotStatusProvisioning SnapshotStatus = "provisioning"
+	SnapshotStatusReady        SnapshotStatus = "ready"
+)
+
+type Snapshot struct {
+	autorest.Response `json:"-"`
+	Name              *string                   `json:"name,omitempty"`
+	Status            *SnapshotStatus           `json:"status,omitempty"`
+	Filters           *[]SnapshotKeyValueFilter `json:"filters,omitempty"`
+	CompositionType   *SnapshotCompositionType  `json:"composition_type,omitempty"`
+	Created           *string                   `json:"created,omitempty"`
+	Expires           *string                   `json:"expires,omitempty"`
+	RetentionPeriod   *int64                    `json:"retention_period,omitempty"`
+	Size              *int64                    `json:"size,omitempty"`
+	ItemsCount        *int64                    `json:"items_count,omitempty"`
+	Tags              map[string]*string        `json:"tags,omitempty"`
+	Etag              *string                   `json:"etag,omitempty"`
+}
+
+type SnapshotKeyValueFilter struct {
+	Key   *string `json:"key,omitempty"`
+	Label *string `json:"label,omitempty"`
+}
+
+type SnapshotUpdateParameters struct {
+	Status *SnapshotStatus `json:"status,omitempty"`
+}
+
+// PutSnapshot starts the creation of a Snapshot, the Snapshot should then be polled using GetSnapshot
+// until it's no longer provisioning.
+func (c DataPlaneClient) PutSnapshot(ctx context.Context, name string, input Snapshot) (result autorest.Response, err error) {
+	req, err := c.snapshotPreparer(ctx, name,
+		autorest.AsContentType("application/vnd.microsoft.appconfig.snapshot+json"),
+		autorest.AsPut(),
+		autorest.WithJSON(input))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "PutSnapshot", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, autorest.DoRetryForStatusCodes(c.client.RetryAttempts, c.client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = resp
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "PutSnapshot", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+	result.Response = resp
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "PutSnapshot", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func (c DataPlaneClient) GetSnapshot(ctx context.Context, name string) (result Snapshot, err error) {
+	req, err := c.snapshotPreparer(ctx, name, autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, autorest.DoRetryForStatusCodes(c.client.RetryAttempts, c.client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func (c DataPlaneClient) UpdateSnapshot(ctx context.Context, name string, input SnapshotUpdateParameters) (result Snapshot, err error) {
+	req, err := c.snapshotPreparer(ctx, name,
+		autorest.AsContentType("application/merge-patch+json"),
+		autorest.AsPatch(),
+		autorest.WithJSON(input))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "UpdateSnapshot", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, autorest.DoRetryForStatusCodes(c.client.RetryAttempts, c.client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "UpdateSnapshot", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "UpdateSnapshot", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func (c DataPlaneClient) snapshotPreparer(ctx context.Context, name string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": c.client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"name": autorest.Encode("path", name),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": snapshotsAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/snapshots/{name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+
+	if len(c.client.SyncToken) > 0 {
+		decorators = append(decorators, autorest.WithHeader("Sync-Token", autorest.String(c.client.SyncToken)))
+	}
+
+	return autorest.Prepare((&http.Request{}).WithContext(ctx), decorators...)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "testing"
+
+func TestParseSnapshotID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    *SnapshotId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/snapshots",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv/testKey?label=testLabel",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/snapshots/testSnapshot?label=testLabel",
+			ExpectError: true,
+		},
+		{
+			Input: "https://testappconf1.azconfig.io/snapshots/testSnapshot",
+			Expected: &SnapshotId{
+				ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+				Name:                       "testSnapshot",
+			},
+		},
+		{
+			Input: "https://testappconf1.azconfig.io/snapshots/test%2F123",
+			Expected: &SnapshotId{
+				ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+				Name:                       "test/123",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		id, err := ParseSnapshotID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for %q: %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for %q but got none", tc.Input)
+		}
+
+		if id.ConfigurationStoreEndpoint != tc.Expected.ConfigurationStoreEndpoint {
+			t.Fatalf("Expected ConfigurationStoreEndpoint to be %q but got %q", tc.Expected.ConfigurationStoreEndpoint, id.ConfigurationStoreEndpoint)
+		}
+
+		if id.Name != tc.Expected.Name {
+			t.Fatalf("Expected Name to be %q but got %q", tc.Expected.Name, id.Name)
+		}
+
+		if roundTrip := id.ID(); roundTrip != tc.Input {
+			t.Fatalf("Expected ID() to round-trip to %q but got %q", tc.Input, roundTrip)
+		}
+	}
+}
@@ -29,7 +29,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		KeyResource{},
+		KeyValuesResource{},
 		FeatureResource{},
+		SnapshotResource{},
 	}
 }
 
@@ -12,7 +12,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-11-01/fluxconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2023-05-01/fluxconfiguration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -316,6 +316,24 @@ resource "azurerm_arc_kubernetes_flux_configuration" "test" {
     retry_interval_in_seconds  = 800
     recreating_enabled         = true
     garbage_collection_enabled = true
+    wait                       = false
+
+    post_build {
+      substitute = {
+        environment = "test"
+      }
+
+      substitute_from {
+        kind = "ConfigMap"
+        name = "cluster-settings"
+      }
+
+      substitute_from {
+        kind     = "Secret"
+        name     = "cluster-secrets"
+        optional = true
+      }
+    }
   }
 
   kustomizations {
@@ -158,7 +158,11 @@ func (m Python3PackageResource) Read() sdk.ResourceFunc {
 			client := meta.Client.Automation.Python3Package
 			result, err := client.Get(ctx, *id)
 			if err != nil {
-				return err
+				if response.WasNotFound(result.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
 
 			if result.Model == nil {
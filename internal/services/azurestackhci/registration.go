@@ -51,6 +51,8 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		StackHCIDeploymentSettingResource{},
 		StackHCILogicalNetworkResource{},
+		StackHCIMarketplaceGalleryImageResource{},
 	}
 }
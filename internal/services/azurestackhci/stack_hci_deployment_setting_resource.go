@@ -0,0 +1,1189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/deploymentsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = StackHCIDeploymentSettingResource{}
+
+// the Deployment Setting of a Cluster is a singleton which is always named `default`
+const stackHCIDeploymentSettingName = "default"
+
+type StackHCIDeploymentSettingResource struct{}
+
+func (StackHCIDeploymentSettingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return deploymentsettings.ValidateDeploymentSettingID
+}
+
+func (StackHCIDeploymentSettingResource) ResourceType() string {
+	return "azurerm_stack_hci_deployment_setting"
+}
+
+func (StackHCIDeploymentSettingResource) ModelObject() interface{} {
+	return &StackHCIDeploymentSettingModel{}
+}
+
+type StackHCIDeploymentSettingModel struct {
+	StackHCIClusterId string           `tfschema:"stack_hci_cluster_id"`
+	ArcResourceIds    []string         `tfschema:"arc_resource_ids"`
+	Version           string           `tfschema:"version"`
+	ScaleUnit         []ScaleUnitModel `tfschema:"scale_unit"`
+}
+
+type ScaleUnitModel struct {
+	AdouPath                      string                       `tfschema:"active_directory_organizational_unit_path"`
+	Cluster                       []ClusterModel               `tfschema:"cluster"`
+	DomainFqdn                    string                       `tfschema:"domain_fqdn"`
+	HostNetwork                   []HostNetworkModel           `tfschema:"host_network"`
+	InfrastructureNetwork         []InfrastructureNetworkModel `tfschema:"infrastructure_network"`
+	NamePrefix                    string                       `tfschema:"name_prefix"`
+	OptionalService               []OptionalServiceModel       `tfschema:"optional_service"`
+	PhysicalNode                  []PhysicalNodeModel          `tfschema:"physical_node"`
+	SecretsLocation               string                       `tfschema:"secrets_location"`
+	Storage                       []StorageModel               `tfschema:"storage"`
+	BitlockerBootVolumeEnabled    bool                         `tfschema:"bitlocker_boot_volume_enabled"`
+	BitlockerDataVolumeEnabled    bool                         `tfschema:"bitlocker_data_volume_enabled"`
+	CredentialGuardEnforced       bool                         `tfschema:"credential_guard_enforced"`
+	DriftControlEnforced          bool                         `tfschema:"drift_control_enforced"`
+	DrtmProtectionEnabled         bool                         `tfschema:"drtm_protection_enabled"`
+	HvciProtectionEnabled         bool                         `tfschema:"hvci_protection_enabled"`
+	SideChannelMitigationEnforced bool                         `tfschema:"side_channel_mitigation_enforced"`
+	SmbClusterEncryptionEnabled   bool                         `tfschema:"smb_cluster_encryption_enabled"`
+	SmbSigningEnforced            bool                         `tfschema:"smb_signing_enforced"`
+	WdacEnforced                  bool                         `tfschema:"wdac_enforced"`
+	EpisodicDataUploadEnabled     bool                         `tfschema:"episodic_data_upload_enabled"`
+	EuLocationEnabled             bool                         `tfschema:"eu_location_enabled"`
+	StreamingDataClientEnabled    bool                         `tfschema:"streaming_data_client_enabled"`
+}
+
+type ClusterModel struct {
+	AzureServiceEndpoint string `tfschema:"azure_service_endpoint"`
+	CloudAccountName     string `tfschema:"cloud_account_name"`
+	Name                 string `tfschema:"name"`
+	WitnessType          string `tfschema:"witness_type"`
+	WitnessPath          string `tfschema:"witness_path"`
+}
+
+type HostNetworkModel struct {
+	Intent                               []HostNetworkIntentModel         `tfschema:"intent"`
+	StorageNetwork                       []HostNetworkStorageNetworkModel `tfschema:"storage_network"`
+	StorageAutoIpEnabled                 bool                             `tfschema:"storage_auto_ip_enabled"`
+	StorageConnectivitySwitchlessEnabled bool                             `tfschema:"storage_connectivity_switchless_enabled"`
+}
+
+type HostNetworkIntentModel struct {
+	Adapter                                   []string                                  `tfschema:"adapter"`
+	AdapterPropertyOverride                   []AdapterPropertyOverrideModel            `tfschema:"adapter_property_override"`
+	AdapterPropertyOverrideEnabled            bool                                      `tfschema:"adapter_property_override_enabled"`
+	Name                                      string                                    `tfschema:"name"`
+	QosPolicyOverride                         []QosPolicyOverrideModel                  `tfschema:"qos_policy_override"`
+	QosPolicyOverrideEnabled                  bool                                      `tfschema:"qos_policy_override_enabled"`
+	TrafficType                               []string                                  `tfschema:"traffic_type"`
+	VirtualSwitchConfigurationOverride        []VirtualSwitchConfigurationOverrideModel `tfschema:"virtual_switch_configuration_override"`
+	VirtualSwitchConfigurationOverrideEnabled bool                                      `tfschema:"virtual_switch_configuration_override_enabled"`
+}
+
+type AdapterPropertyOverrideModel struct {
+	JumboPacket             string `tfschema:"jumbo_packet"`
+	NetworkDirect           string `tfschema:"network_direct"`
+	NetworkDirectTechnology string `tfschema:"network_direct_technology"`
+}
+
+type QosPolicyOverrideModel struct {
+	BandwidthPercentageSMB         string `tfschema:"bandwidth_percentage_smb"`
+	PriorityValue8021ActionCluster string `tfschema:"priority_value8021_action_cluster"`
+	PriorityValue8021ActionSMB     string `tfschema:"priority_value8021_action_smb"`
+}
+
+type VirtualSwitchConfigurationOverrideModel struct {
+	EnableIov              string `tfschema:"enable_iov"`
+	LoadBalancingAlgorithm string `tfschema:"load_balancing_algorithm"`
+}
+
+type HostNetworkStorageNetworkModel struct {
+	Name               string `tfschema:"name"`
+	NetworkAdapterName string `tfschema:"network_adapter_name"`
+	VlanId             string `tfschema:"vlan_id"`
+}
+
+type InfrastructureNetworkModel struct {
+	DhcpEnabled bool                               `tfschema:"dhcp_enabled"`
+	DnsServer   []string                           `tfschema:"dns_server"`
+	Gateway     string                             `tfschema:"gateway"`
+	IpPool      []InfrastructureNetworkIpPoolModel `tfschema:"ip_pool"`
+	SubnetMask  string                             `tfschema:"subnet_mask"`
+}
+
+type InfrastructureNetworkIpPoolModel struct {
+	StartingAddress string `tfschema:"starting_address"`
+	EndingAddress   string `tfschema:"ending_address"`
+}
+
+type OptionalServiceModel struct {
+	CustomLocation string `tfschema:"custom_location"`
+}
+
+type PhysicalNodeModel struct {
+	Name        string `tfschema:"name"`
+	Ipv4Address string `tfschema:"ipv4_address"`
+}
+
+type StorageModel struct {
+	ConfigurationMode string `tfschema:"configuration_mode"`
+}
+
+func (StackHCIDeploymentSettingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"stack_hci_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: deploymentsettings.ValidateClusterID,
+		},
+
+		"arc_resource_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: machines.ValidateMachineID,
+			},
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"scale_unit": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"active_directory_organizational_unit_path": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"cluster": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"azure_service_endpoint": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"cloud_account_name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"witness_type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringInSlice([]string{"Cloud", "FileShare"}, false),
+								},
+
+								"witness_path": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"domain_fqdn": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"host_network": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"intent": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									ForceNew: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"name": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"adapter": {
+												Type:     pluginsdk.TypeList,
+												Required: true,
+												ForceNew: true,
+												MinItems: 1,
+												Elem: &pluginsdk.Schema{
+													Type:         pluginsdk.TypeString,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+											},
+
+											"traffic_type": {
+												Type:     pluginsdk.TypeList,
+												Required: true,
+												ForceNew: true,
+												MinItems: 1,
+												Elem: &pluginsdk.Schema{
+													Type: pluginsdk.TypeString,
+													ValidateFunc: validation.StringInSlice([]string{
+														"Compute",
+														"Management",
+														"Storage",
+													}, false),
+												},
+											},
+
+											"adapter_property_override_enabled": {
+												Type:     pluginsdk.TypeBool,
+												Optional: true,
+												ForceNew: true,
+												Default:  false,
+											},
+
+											"adapter_property_override": {
+												Type:     pluginsdk.TypeList,
+												Optional: true,
+												ForceNew: true,
+												MaxItems: 1,
+												Elem: &pluginsdk.Resource{
+													Schema: map[string]*pluginsdk.Schema{
+														"jumbo_packet": {
+															Type:         pluginsdk.TypeString,
+															Optional:     true,
+															ForceNew:     true,
+															ValidateFunc: validation.StringIsNotEmpty,
+														},
+
+														"network_direct": {
+															Type:         pluginsdk.TypeString,
+															Optional:     true,
+															ForceNew:     true,
+															ValidateFunc: validation.StringIsNotEmpty,
+														},
+
+														"network_direct_technology": {
+															Type:         pluginsdk.TypeString,
+															Optional:     true,
+															ForceNew:     true,
+															ValidateFunc: validation.StringIsNotEmpty,
+														},
+													},
+												},
+											},
+
+											"qos_policy_override_enabled": {
+												Type:     pluginsdk.TypeBool,
+												Optional: true,
+												ForceNew: true,
+												Default:  false,
+											},
+
+											"qos_policy_override": {
+												Type:     pluginsdk.TypeList,
+												Optional: true,
+												ForceNew: true,
+												MaxItems: 1,
+												Elem: &pluginsdk.Resource{
+													Schema: map[string]*pluginsdk.Schema{
+														"bandwidth_percentage_smb": {
+															Type:         pluginsdk.TypeString,
+															Optional:     true,
+															ForceNew:     true,
+															ValidateFunc: validation.StringIsNotEmpty,
+														},
+
+														"priority_value8021_action_cluster": {
+															Type:         pluginsdk.TypeString,
+															Optional:     true,
+															ForceNew:     true,
+															ValidateFunc: validation.StringIsNotEmpty,
+														},
+
+														"priority_value8021_action_smb": {
+															Type:         pluginsdk.TypeString,
+															Optional:     true,
+															ForceNew:     true,
+															ValidateFunc: validation.StringIsNotEmpty,
+														},
+													},
+												},
+											},
+
+											"virtual_switch_configuration_override_enabled": {
+												Type:     pluginsdk.TypeBool,
+												Optional: true,
+												ForceNew: true,
+												Default:  false,
+											},
+
+											"virtual_switch_configuration_override": {
+												Type:     pluginsdk.TypeList,
+												Optional: true,
+												ForceNew: true,
+												MaxItems: 1,
+												Elem: &pluginsdk.Resource{
+													Schema: map[string]*pluginsdk.Schema{
+														"enable_iov": {
+															Type:         pluginsdk.TypeString,
+															Optional:     true,
+															ForceNew:     true,
+															ValidateFunc: validation.StringIsNotEmpty,
+														},
+
+														"load_balancing_algorithm": {
+															Type:         pluginsdk.TypeString,
+															Optional:     true,
+															ForceNew:     true,
+															ValidateFunc: validation.StringIsNotEmpty,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+
+								"storage_network": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									ForceNew: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"name": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"network_adapter_name": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"vlan_id": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+										},
+									},
+								},
+
+								"storage_auto_ip_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"storage_connectivity_switchless_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+							},
+						},
+					},
+
+					"infrastructure_network": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"dns_server": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									ForceNew: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.IsIPv4Address,
+									},
+								},
+
+								"gateway": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsIPv4Address,
+								},
+
+								"ip_pool": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									ForceNew: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"starting_address": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.IsIPv4Address,
+											},
+
+											"ending_address": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.IsIPv4Address,
+											},
+										},
+									},
+								},
+
+								"subnet_mask": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsIPv4Address,
+								},
+
+								"dhcp_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+							},
+						},
+					},
+
+					"name_prefix": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringLenBetween(1, 8),
+					},
+
+					"optional_service": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"custom_location": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"physical_node": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"ipv4_address": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsIPv4Address,
+								},
+							},
+						},
+					},
+
+					"secrets_location": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+
+					"storage": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"configuration_mode": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ForceNew: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										"Express",
+										"InfraOnly",
+										"KeepStorage",
+									}, false),
+								},
+							},
+						},
+					},
+
+					"bitlocker_boot_volume_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"bitlocker_data_volume_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"credential_guard_enforced": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"drift_control_enforced": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"drtm_protection_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"hvci_protection_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"side_channel_mitigation_enforced": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"smb_cluster_encryption_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"smb_signing_enforced": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"wdac_enforced": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"episodic_data_upload_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"eu_location_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"streaming_data_client_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (StackHCIDeploymentSettingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCIDeploymentSettingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// the deployment of a cluster validates and then configures every node, which can take several hours
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.DeploymentSettings
+
+			var config StackHCIDeploymentSettingModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := deploymentsettings.ParseClusterID(config.StackHCIClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := deploymentsettings.NewDeploymentSettingID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.ClusterName, stackHCIDeploymentSettingName)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := deploymentsettings.DeploymentSetting{
+				Properties: &deploymentsettings.DeploymentSettingsProperties{
+					ArcNodeResourceIds: config.ArcResourceIds,
+					DeploymentMode:     deploymentsettings.DeploymentModeValidate,
+					DeploymentConfiguration: deploymentsettings.DeploymentConfiguration{
+						ScaleUnits: expandDeploymentSettingScaleUnits(config.ScaleUnit),
+						Version:    pointer.To(config.Version),
+					},
+				},
+			}
+
+			// the deployment has to be validated prior to being deployed, both of which are performed via a PUT
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("validating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			payload.Properties.DeploymentMode = deploymentsettings.DeploymentModeDeploy
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("deploying %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIDeploymentSettingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.DeploymentSettings
+
+			id, err := deploymentsettings.ParseDeploymentSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			schema := StackHCIDeploymentSettingModel{
+				StackHCIClusterId: deploymentsettings.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					arcResourceIds, err := flattenDeploymentSettingArcResourceIds(props.ArcNodeResourceIds)
+					if err != nil {
+						return err
+					}
+					schema.ArcResourceIds = arcResourceIds
+					schema.Version = pointer.From(props.DeploymentConfiguration.Version)
+					schema.ScaleUnit = flattenDeploymentSettingScaleUnits(props.DeploymentConfiguration.ScaleUnits)
+				}
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r StackHCIDeploymentSettingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.DeploymentSettings
+
+			id, err := deploymentsettings.ParseDeploymentSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDeploymentSettingScaleUnits(input []ScaleUnitModel) []deploymentsettings.ScaleUnits {
+	results := make([]deploymentsettings.ScaleUnits, 0)
+
+	for _, item := range input {
+		results = append(results, deploymentsettings.ScaleUnits{
+			DeploymentData: deploymentsettings.DeploymentData{
+				AdouPath:              pointer.To(item.AdouPath),
+				Cluster:               expandDeploymentSettingCluster(item.Cluster),
+				DomainFqdn:            pointer.To(item.DomainFqdn),
+				HostNetwork:           expandDeploymentSettingHostNetwork(item.HostNetwork),
+				InfrastructureNetwork: expandDeploymentSettingInfrastructureNetwork(item.InfrastructureNetwork),
+				NamingPrefix:          pointer.To(item.NamePrefix),
+				Observability: &deploymentsettings.Observability{
+					EpisodicDataUpload:  pointer.To(item.EpisodicDataUploadEnabled),
+					EuLocation:          pointer.To(item.EuLocationEnabled),
+					StreamingDataClient: pointer.To(item.StreamingDataClientEnabled),
+				},
+				OptionalServices: expandDeploymentSettingOptionalService(item.OptionalService),
+				PhysicalNodes:    expandDeploymentSettingPhysicalNodes(item.PhysicalNode),
+				SecretsLocation:  pointer.To(item.SecretsLocation),
+				SecuritySettings: &deploymentsettings.DeploymentSecuritySettings{
+					BitlockerBootVolume:           pointer.To(item.BitlockerBootVolumeEnabled),
+					BitlockerDataVolumes:          pointer.To(item.BitlockerDataVolumeEnabled),
+					CredentialGuardEnforced:       pointer.To(item.CredentialGuardEnforced),
+					DriftControlEnforced:          pointer.To(item.DriftControlEnforced),
+					DrtmProtection:                pointer.To(item.DrtmProtectionEnabled),
+					HvciProtection:                pointer.To(item.HvciProtectionEnabled),
+					SideChannelMitigationEnforced: pointer.To(item.SideChannelMitigationEnforced),
+					SmbClusterEncryption:          pointer.To(item.SmbClusterEncryptionEnabled),
+					SmbSigningEnforced:            pointer.To(item.SmbSigningEnforced),
+					WdacEnforced:                  pointer.To(item.WdacEnforced),
+				},
+				Storage: expandDeploymentSettingStorage(item.Storage),
+			},
+		})
+	}
+
+	return results
+}
+
+func flattenDeploymentSettingScaleUnits(input []deploymentsettings.ScaleUnits) []ScaleUnitModel {
+	results := make([]ScaleUnitModel, 0)
+
+	for _, item := range input {
+		data := item.DeploymentData
+		result := ScaleUnitModel{
+			AdouPath:              pointer.From(data.AdouPath),
+			Cluster:               flattenDeploymentSettingCluster(data.Cluster),
+			DomainFqdn:            pointer.From(data.DomainFqdn),
+			HostNetwork:           flattenDeploymentSettingHostNetwork(data.HostNetwork),
+			InfrastructureNetwork: flattenDeploymentSettingInfrastructureNetwork(data.InfrastructureNetwork),
+			NamePrefix:            pointer.From(data.NamingPrefix),
+			OptionalService:       flattenDeploymentSettingOptionalService(data.OptionalServices),
+			PhysicalNode:          flattenDeploymentSettingPhysicalNodes(data.PhysicalNodes),
+			SecretsLocation:       pointer.From(data.SecretsLocation),
+			Storage:               flattenDeploymentSettingStorage(data.Storage),
+		}
+
+		if v := data.Observability; v != nil {
+			result.EpisodicDataUploadEnabled = pointer.From(v.EpisodicDataUpload)
+			result.EuLocationEnabled = pointer.From(v.EuLocation)
+			result.StreamingDataClientEnabled = pointer.From(v.StreamingDataClient)
+		}
+
+		if v := data.SecuritySettings; v != nil {
+			result.BitlockerBootVolumeEnabled = pointer.From(v.BitlockerBootVolume)
+			result.BitlockerDataVolumeEnabled = pointer.From(v.BitlockerDataVolumes)
+			result.CredentialGuardEnforced = pointer.From(v.CredentialGuardEnforced)
+			result.DriftControlEnforced = pointer.From(v.DriftControlEnforced)
+			result.DrtmProtectionEnabled = pointer.From(v.DrtmProtection)
+			result.HvciProtectionEnabled = pointer.From(v.HvciProtection)
+			result.SideChannelMitigationEnforced = pointer.From(v.SideChannelMitigationEnforced)
+			result.SmbClusterEncryptionEnabled = pointer.From(v.SmbClusterEncryption)
+			result.SmbSigningEnforced = pointer.From(v.SmbSigningEnforced)
+			result.WdacEnforced = pointer.From(v.WdacEnforced)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func flattenDeploymentSettingArcResourceIds(input []string) ([]string, error) {
+	results := make([]string, 0)
+
+	for _, item := range input {
+		id, err := machines.ParseMachineIDInsensitively(item)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, id.ID())
+	}
+
+	return results, nil
+}
+
+func expandDeploymentSettingCluster(input []ClusterModel) *deploymentsettings.DeploymentCluster {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	return &deploymentsettings.DeploymentCluster{
+		AzureServiceEndpoint: pointer.To(v.AzureServiceEndpoint),
+		CloudAccountName:     pointer.To(v.CloudAccountName),
+		Name:                 pointer.To(v.Name),
+		WitnessType:          pointer.To(v.WitnessType),
+		WitnessPath:          pointer.To(v.WitnessPath),
+	}
+}
+
+func flattenDeploymentSettingCluster(input *deploymentsettings.DeploymentCluster) []ClusterModel {
+	if input == nil {
+		return make([]ClusterModel, 0)
+	}
+
+	return []ClusterModel{
+		{
+			AzureServiceEndpoint: pointer.From(input.AzureServiceEndpoint),
+			CloudAccountName:     pointer.From(input.CloudAccountName),
+			Name:                 pointer.From(input.Name),
+			WitnessType:          pointer.From(input.WitnessType),
+			WitnessPath:          pointer.From(input.WitnessPath),
+		},
+	}
+}
+
+func expandDeploymentSettingHostNetwork(input []HostNetworkModel) *deploymentsettings.HostNetwork {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+
+	intents := make([]deploymentsettings.Intents, 0)
+	for _, intent := range v.Intent {
+		result := deploymentsettings.Intents{
+			Adapter:                            pointer.To(intent.Adapter),
+			Name:                               pointer.To(intent.Name),
+			OverrideAdapterProperty:            pointer.To(intent.AdapterPropertyOverrideEnabled),
+			OverrideQosPolicy:                  pointer.To(intent.QosPolicyOverrideEnabled),
+			OverrideVirtualSwitchConfiguration: pointer.To(intent.VirtualSwitchConfigurationOverrideEnabled),
+			TrafficType:                        pointer.To(intent.TrafficType),
+		}
+
+		if len(intent.AdapterPropertyOverride) > 0 {
+			override := intent.AdapterPropertyOverride[0]
+			result.AdapterPropertyOverrides = &deploymentsettings.AdapterPropertyOverrides{
+				JumboPacket:             pointer.To(override.JumboPacket),
+				NetworkDirect:           pointer.To(override.NetworkDirect),
+				NetworkDirectTechnology: pointer.To(override.NetworkDirectTechnology),
+			}
+		}
+
+		if len(intent.QosPolicyOverride) > 0 {
+			override := intent.QosPolicyOverride[0]
+			result.QosPolicyOverrides = &deploymentsettings.QosPolicyOverrides{
+				BandwidthPercentageSMB:         pointer.To(override.BandwidthPercentageSMB),
+				PriorityValue8021ActionCluster: pointer.To(override.PriorityValue8021ActionCluster),
+				PriorityValue8021ActionSMB:     pointer.To(override.PriorityValue8021ActionSMB),
+			}
+		}
+
+		if len(intent.VirtualSwitchConfigurationOverride) > 0 {
+			override := intent.VirtualSwitchConfigurationOverride[0]
+			result.VirtualSwitchConfigurationOverrides = &deploymentsettings.VirtualSwitchConfigurationOverrides{
+				EnableIov:              pointer.To(override.EnableIov),
+				LoadBalancingAlgorithm: pointer.To(override.LoadBalancingAlgorithm),
+			}
+		}
+
+		intents = append(intents, result)
+	}
+
+	storageNetworks := make([]deploymentsettings.StorageNetworks, 0)
+	for _, storageNetwork := range v.StorageNetwork {
+		storageNetworks = append(storageNetworks, deploymentsettings.StorageNetworks{
+			Name:               pointer.To(storageNetwork.Name),
+			NetworkAdapterName: pointer.To(storageNetwork.NetworkAdapterName),
+			VlanId:             pointer.To(storageNetwork.VlanId),
+		})
+	}
+
+	return &deploymentsettings.HostNetwork{
+		EnableStorageAutoIP:           pointer.To(v.StorageAutoIpEnabled),
+		Intents:                       pointer.To(intents),
+		StorageConnectivitySwitchless: pointer.To(v.StorageConnectivitySwitchlessEnabled),
+		StorageNetworks:               pointer.To(storageNetworks),
+	}
+}
+
+func flattenDeploymentSettingHostNetwork(input *deploymentsettings.HostNetwork) []HostNetworkModel {
+	if input == nil {
+		return make([]HostNetworkModel, 0)
+	}
+
+	intents := make([]HostNetworkIntentModel, 0)
+	if input.Intents != nil {
+		for _, intent := range *input.Intents {
+			result := HostNetworkIntentModel{
+				Adapter:                            pointer.From(intent.Adapter),
+				AdapterPropertyOverride:            make([]AdapterPropertyOverrideModel, 0),
+				AdapterPropertyOverrideEnabled:     pointer.From(intent.OverrideAdapterProperty),
+				Name:                               pointer.From(intent.Name),
+				QosPolicyOverride:                  make([]QosPolicyOverrideModel, 0),
+				QosPolicyOverrideEnabled:           pointer.From(intent.OverrideQosPolicy),
+				TrafficType:                        pointer.From(intent.TrafficType),
+				VirtualSwitchConfigurationOverride: make([]VirtualSwitchConfigurationOverrideModel, 0),
+				VirtualSwitchConfigurationOverrideEnabled: pointer.From(intent.OverrideVirtualSwitchConfiguration),
+			}
+
+			if v := intent.AdapterPropertyOverrides; v != nil {
+				result.AdapterPropertyOverride = append(result.AdapterPropertyOverride, AdapterPropertyOverrideModel{
+					JumboPacket:             pointer.From(v.JumboPacket),
+					NetworkDirect:           pointer.From(v.NetworkDirect),
+					NetworkDirectTechnology: pointer.From(v.NetworkDirectTechnology),
+				})
+			}
+
+			if v := intent.QosPolicyOverrides; v != nil {
+				result.QosPolicyOverride = append(result.QosPolicyOverride, QosPolicyOverrideModel{
+					BandwidthPercentageSMB:         pointer.From(v.BandwidthPercentageSMB),
+					PriorityValue8021ActionCluster: pointer.From(v.PriorityValue8021ActionCluster),
+					PriorityValue8021ActionSMB:     pointer.From(v.PriorityValue8021ActionSMB),
+				})
+			}
+
+			if v := intent.VirtualSwitchConfigurationOverrides; v != nil {
+				result.VirtualSwitchConfigurationOverride = append(result.VirtualSwitchConfigurationOverride, VirtualSwitchConfigurationOverrideModel{
+					EnableIov:              pointer.From(v.EnableIov),
+					LoadBalancingAlgorithm: pointer.From(v.LoadBalancingAlgorithm),
+				})
+			}
+
+			intents = append(intents, result)
+		}
+	}
+
+	storageNetworks := make([]HostNetworkStorageNetworkModel, 0)
+	if input.StorageNetworks != nil {
+		for _, storageNetwork := range *input.StorageNetworks {
+			storageNetworks = append(storageNetworks, HostNetworkStorageNetworkModel{
+				Name:               pointer.From(storageNetwork.Name),
+				NetworkAdapterName: pointer.From(storageNetwork.NetworkAdapterName),
+				VlanId:             pointer.From(storageNetwork.VlanId),
+			})
+		}
+	}
+
+	return []HostNetworkModel{
+		{
+			Intent:                               intents,
+			StorageNetwork:                       storageNetworks,
+			StorageAutoIpEnabled:                 pointer.From(input.EnableStorageAutoIP),
+			StorageConnectivitySwitchlessEnabled: pointer.From(input.StorageConnectivitySwitchless),
+		},
+	}
+}
+
+func expandDeploymentSettingInfrastructureNetwork(input []InfrastructureNetworkModel) *[]deploymentsettings.InfrastructureNetwork {
+	results := make([]deploymentsettings.InfrastructureNetwork, 0)
+
+	for _, item := range input {
+		ipPools := make([]deploymentsettings.IPPools, 0)
+		for _, ipPool := range item.IpPool {
+			ipPools = append(ipPools, deploymentsettings.IPPools{
+				StartingAddress: pointer.To(ipPool.StartingAddress),
+				EndingAddress:   pointer.To(ipPool.EndingAddress),
+			})
+		}
+
+		results = append(results, deploymentsettings.InfrastructureNetwork{
+			DnsServers: pointer.To(item.DnsServer),
+			Gateway:    pointer.To(item.Gateway),
+			IPPools:    pointer.To(ipPools),
+			SubnetMask: pointer.To(item.SubnetMask),
+			UseDhcp:    pointer.To(item.DhcpEnabled),
+		})
+	}
+
+	return &results
+}
+
+func flattenDeploymentSettingInfrastructureNetwork(input *[]deploymentsettings.InfrastructureNetwork) []InfrastructureNetworkModel {
+	results := make([]InfrastructureNetworkModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		ipPools := make([]InfrastructureNetworkIpPoolModel, 0)
+		if item.IPPools != nil {
+			for _, ipPool := range *item.IPPools {
+				ipPools = append(ipPools, InfrastructureNetworkIpPoolModel{
+					StartingAddress: pointer.From(ipPool.StartingAddress),
+					EndingAddress:   pointer.From(ipPool.EndingAddress),
+				})
+			}
+		}
+
+		results = append(results, InfrastructureNetworkModel{
+			DhcpEnabled: pointer.From(item.UseDhcp),
+			DnsServer:   pointer.From(item.DnsServers),
+			Gateway:     pointer.From(item.Gateway),
+			IpPool:      ipPools,
+			SubnetMask:  pointer.From(item.SubnetMask),
+		})
+	}
+
+	return results
+}
+
+func expandDeploymentSettingOptionalService(input []OptionalServiceModel) *deploymentsettings.OptionalServices {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &deploymentsettings.OptionalServices{
+		CustomLocation: pointer.To(input[0].CustomLocation),
+	}
+}
+
+func flattenDeploymentSettingOptionalService(input *deploymentsettings.OptionalServices) []OptionalServiceModel {
+	if input == nil {
+		return make([]OptionalServiceModel, 0)
+	}
+
+	return []OptionalServiceModel{
+		{
+			CustomLocation: pointer.From(input.CustomLocation),
+		},
+	}
+}
+
+func expandDeploymentSettingPhysicalNodes(input []PhysicalNodeModel) *[]deploymentsettings.PhysicalNodes {
+	results := make([]deploymentsettings.PhysicalNodes, 0)
+
+	for _, item := range input {
+		results = append(results, deploymentsettings.PhysicalNodes{
+			Name:        pointer.To(item.Name),
+			IPv4Address: pointer.To(item.Ipv4Address),
+		})
+	}
+
+	return &results
+}
+
+func flattenDeploymentSettingPhysicalNodes(input *[]deploymentsettings.PhysicalNodes) []PhysicalNodeModel {
+	results := make([]PhysicalNodeModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, PhysicalNodeModel{
+			Name:        pointer.From(item.Name),
+			Ipv4Address: pointer.From(item.IPv4Address),
+		})
+	}
+
+	return results
+}
+
+func expandDeploymentSettingStorage(input []StorageModel) *deploymentsettings.Storage {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &deploymentsettings.Storage{
+		ConfigurationMode: pointer.To(input[0].ConfigurationMode),
+	}
+}
+
+func flattenDeploymentSettingStorage(input *deploymentsettings.Storage) []StorageModel {
+	if input == nil {
+		return make([]StorageModel, 0)
+	}
+
+	return []StorageModel{
+		{
+			ConfigurationMode: pointer.From(input.ConfigurationMode),
+		},
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/deploymentsettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StackHCIDeploymentSettingResource struct{}
+
+// https://learn.microsoft.com/en-us/azure-stack/hci/deploy/deployment-azure-resource-manager-template
+// The deployment requires the nodes to have been registered with Arc and the Active Directory to have been prepared beforehand
+const (
+	arcMachineIdEnv    = "ARM_TEST_STACK_HCI_ARC_MACHINE_ID"
+	domainFqdnEnv      = "ARM_TEST_STACK_HCI_DOMAIN_FQDN"
+	adouPathEnv        = "ARM_TEST_STACK_HCI_ADOU_PATH"
+	secretsLocationEnv = "ARM_TEST_STACK_HCI_SECRETS_LOCATION"
+)
+
+func TestAccStackHCIDeploymentSetting_basic(t *testing.T) {
+	for _, env := range []string{arcMachineIdEnv, domainFqdnEnv, adouPathEnv, secretsLocationEnv} {
+		if os.Getenv(env) == "" {
+			t.Skipf("skipping since %q has not been specified", env)
+		}
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_deployment_setting", "test")
+	r := StackHCIDeploymentSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StackHCIDeploymentSettingResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := deploymentsettings.ParseDeploymentSettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureStackHCI.DeploymentSettings.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StackHCIDeploymentSettingResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-hci-ds-%[2]s"
+  location = %[1]q
+}
+
+resource "azurerm_stack_hci_cluster" "test" {
+  name                = "acctest-hci-%[2]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_stack_hci_deployment_setting" "test" {
+  stack_hci_cluster_id = azurerm_stack_hci_cluster.test.id
+  arc_resource_ids     = [%[3]q]
+  version              = "10.0.0.0"
+
+  scale_unit {
+    active_directory_organizational_unit_path = %[5]q
+    domain_fqdn                               = %[4]q
+    secrets_location                          = %[6]q
+    name_prefix                               = "hci%[2]s"
+
+    cluster {
+      azure_service_endpoint = "core.windows.net"
+      cloud_account_name     = "acctestsa%[2]s"
+      name                   = azurerm_stack_hci_cluster.test.name
+      witness_type           = "Cloud"
+      witness_path           = "Cloud"
+    }
+
+    host_network {
+      intent {
+        name         = "ManagementCompute"
+        adapter      = ["FABRIC", "FABRIC2"]
+        traffic_type = ["Management", "Compute"]
+      }
+
+      intent {
+        name         = "Storage"
+        adapter      = ["StorageA", "StorageB"]
+        traffic_type = ["Storage"]
+      }
+
+      storage_network {
+        name                 = "Storage1Network"
+        network_adapter_name = "StorageA"
+        vlan_id              = "711"
+      }
+
+      storage_network {
+        name                 = "Storage2Network"
+        network_adapter_name = "StorageB"
+        vlan_id              = "712"
+      }
+    }
+
+    infrastructure_network {
+      gateway     = "192.168.1.1"
+      subnet_mask = "255.255.255.0"
+      dns_server  = ["192.168.1.254"]
+
+      ip_pool {
+        starting_address = "192.168.1.55"
+        ending_address   = "192.168.1.65"
+      }
+    }
+
+    optional_service {
+      custom_location = "customlocation-%[2]s"
+    }
+
+    physical_node {
+      name         = "hcinode1"
+      ipv4_address = "192.168.1.13"
+    }
+
+    storage {
+      configuration_mode = "Express"
+    }
+  }
+}
+`, data.Locations.Primary, data.RandomString, os.Getenv(arcMachineIdEnv), os.Getenv(domainFqdnEnv), os.Getenv(adouPathEnv), os.Getenv(secretsLocationEnv))
+}
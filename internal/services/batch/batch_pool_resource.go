@@ -17,10 +17,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/pool"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-02-01/pool"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/validate"
@@ -826,6 +827,117 @@ func resourceBatchPool() *pluginsdk.Resource {
 					},
 				},
 			},
+			"security_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host_encryption_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"security_type": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(pool.PossibleValuesForSecurityTypes(), false),
+						},
+						"secure_boot_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"vtpm_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"upgrade_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(pool.PossibleValuesForUpgradeMode(), false),
+						},
+						"automatic_os_upgrade_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"automatic_os_upgrade_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+									"automatic_rollback_disabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+									"os_rolling_upgrade_deferral_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+									"rolling_upgrade_policy_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"rolling_upgrade_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"cross_zone_upgrade_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+									"max_batch_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(5, 100),
+									},
+									"max_unhealthy_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(5, 100),
+									},
+									"max_unhealthy_upgraded_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"pause_time_between_batches": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: azValidate.ISO8601Duration,
+									},
+									"prioritize_unhealthy_instances_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+									"rollback_failed_instances_on_policy_breach_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -981,6 +1093,8 @@ func resourceBatchPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error
 		parameters.Properties.TargetNodeCommunicationMode = pointer.To(pool.NodeCommunicationMode(v.(string)))
 	}
 
+	parameters.Properties.UpgradePolicy = expandBatchPoolUpgradePolicy(d.Get("upgrade_policy").([]interface{}))
+
 	_, err = client.Create(ctx, id, parameters, pool.CreateOperationOptions{})
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -1113,6 +1227,10 @@ func resourceBatchPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		parameters.Properties.TargetNodeCommunicationMode = pointer.To(pool.NodeCommunicationMode(d.Get("target_node_communication_mode").(string)))
 	}
 
+	if d.HasChange("upgrade_policy") {
+		parameters.Properties.UpgradePolicy = expandBatchPoolUpgradePolicy(d.Get("upgrade_policy").([]interface{}))
+	}
+
 	result, err := client.Update(ctx, *id, parameters, pool.UpdateOperationOptions{})
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
@@ -1299,6 +1417,10 @@ func resourceBatchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
 						}
 						d.Set("windows", windowsConfig)
 					}
+
+					if err := d.Set("security_profile", flattenBatchPoolSecurityProfile(config.SecurityProfile)); err != nil {
+						return fmt.Errorf("setting `security_profile`: %v", err)
+					}
 				}
 			}
 
@@ -1323,6 +1445,10 @@ func resourceBatchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			}
 			d.Set("target_node_communication_mode", targetNodeCommunicationMode)
 
+			if err := d.Set("upgrade_policy", flattenBatchPoolUpgradePolicy(props.UpgradePolicy)); err != nil {
+				return fmt.Errorf("setting `upgrade_policy`: %v", err)
+			}
+
 			if err := d.Set("network_configuration", flattenBatchPoolNetworkConfiguration(props.NetworkConfiguration)); err != nil {
 				return fmt.Errorf("setting `network_configuration`: %v", err)
 			}
@@ -82,6 +82,21 @@ func resourceBotChannelWebChat() *pluginsdk.Resource {
 							Optional: true,
 							Default:  true,
 						},
+
+						"enhanced_authentication_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"trusted_origins": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
 					},
 				},
 				ExactlyOneOf: func() []string {
@@ -328,6 +343,8 @@ func expandSites(input []interface{}) *[]botservice.WebChatSite {
 			IsBlockUserUploadEnabled:    utils.Bool(!site["user_upload_enabled"].(bool)),
 			IsEndpointParametersEnabled: utils.Bool(site["endpoint_parameters_enabled"].(bool)),
 			IsNoStorageEnabled:          utils.Bool(!site["storage_enabled"].(bool)),
+			IsSecureSiteEnabled:         utils.Bool(site["enhanced_authentication_enabled"].(bool)),
+			TrustedOrigins:              utils.ExpandStringSlice(site["trusted_origins"].(*pluginsdk.Set).List()),
 		}
 
 		if siteName := site["name"].(string); siteName != "" {
@@ -388,6 +405,13 @@ func flattenSites(input *[]botservice.WebChatSite) []interface{} {
 		}
 		result["storage_enabled"] = storageEnabled
 
+		var enhancedAuthenticationEnabled bool
+		if v := item.IsSecureSiteEnabled; v != nil {
+			enhancedAuthenticationEnabled = *v
+		}
+		result["enhanced_authentication_enabled"] = enhancedAuthenticationEnabled
+		result["trusted_origins"] = utils.FlattenStringSlice(item.TrustedOrigins)
+
 		results = append(results, result)
 	}
 
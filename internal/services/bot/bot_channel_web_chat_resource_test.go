@@ -157,10 +157,12 @@ resource "azurerm_bot_channel_web_chat" "test" {
   resource_group_name = azurerm_resource_group.test.name
 
   site {
-    name                        = "TestSite1"
-    user_upload_enabled         = false
-    endpoint_parameters_enabled = true
-    storage_enabled             = false
+    name                            = "TestSite1"
+    user_upload_enabled             = false
+    endpoint_parameters_enabled     = true
+    storage_enabled                 = false
+    enhanced_authentication_enabled = true
+    trusted_origins                 = ["https://example.com"]
   }
 
   site {
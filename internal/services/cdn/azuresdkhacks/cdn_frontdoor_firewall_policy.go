@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/frontdoor/mgmt/2020-11-01/frontdoor" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since the JavaScript Challenge action and the Group By variables for
+// rate limit rules are only available from API Version 2024-02-01 - the policy is sent using the SDK models
+// for API Version 2020-11-01 with these fields merged in, since the newer API Version is a superset of these
+
+const firewallPolicyAPIVersion = "2024-02-01"
+
+type CdnFrontDoorFirewallPoliciesWorkaroundClient struct {
+	sdkClient *frontdoor.PoliciesClient
+}
+
+func NewCdnFrontDoorFirewallPoliciesWorkaroundClient(client *frontdoor.PoliciesClient) CdnFrontDoorFirewallPoliciesWorkaroundClient {
+	return CdnFrontDoorFirewallPoliciesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+// FirewallPolicyExtensions contains the fields of the Firewall Policy which aren't available in the SDK models.
+type FirewallPolicyExtensions struct {
+	JavascriptChallengeExpirationInMinutes *int64
+	// CustomRuleGroupByVariables is keyed by the name of the Custom Rule
+	CustomRuleGroupByVariables map[string][]string
+}
+
+type firewallPolicyExtensionsModel struct {
+	Properties *struct {
+		PolicySettings *struct {
+			JavascriptChallengeExpirationInMinutes *int64 `json:"javascriptChallengeExpirationInMinutes,omitempty"`
+		} `json:"policySettings,omitempty"`
+		CustomRules *struct {
+			Rules *[]struct {
+				Name    *string `json:"name,omitempty"`
+				GroupBy *[]struct {
+					VariableName string `json:"variableName"`
+				} `json:"groupBy,omitempty"`
+			} `json:"rules,omitempty"`
+		} `json:"customRules,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+func (c *CdnFrontDoorFirewallPoliciesWorkaroundClient) Get(ctx context.Context, resourceGroupName string, policyName string) (result frontdoor.WebApplicationFirewallPolicy, extensions FirewallPolicyExtensions, err error) {
+	req, err := c.prepare(ctx, autorest.AsGet(), resourceGroupName, policyName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	var body json.RawMessage
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&body),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "Get", resp, "Failure responding to request")
+		return
+	}
+
+	if err = json.Unmarshal(body, &result); err != nil {
+		err = fmt.Errorf("unmarshaling the response: %+v", err)
+		return
+	}
+
+	var model firewallPolicyExtensionsModel
+	if err = json.Unmarshal(body, &model); err != nil {
+		err = fmt.Errorf("unmarshaling the response: %+v", err)
+		return
+	}
+
+	extensions.CustomRuleGroupByVariables = make(map[string][]string)
+	if props := model.Properties; props != nil {
+		if props.PolicySettings != nil {
+			extensions.JavascriptChallengeExpirationInMinutes = props.PolicySettings.JavascriptChallengeExpirationInMinutes
+		}
+
+		if props.CustomRules != nil && props.CustomRules.Rules != nil {
+			for _, rule := range *props.CustomRules.Rules {
+				if rule.Name == nil || rule.GroupBy == nil {
+					continue
+				}
+
+				variables := make([]string, 0)
+				for _, v := range *rule.GroupBy {
+					variables = append(variables, v.VariableName)
+				}
+				extensions.CustomRuleGroupByVariables[*rule.Name] = variables
+			}
+		}
+	}
+
+	return
+}
+
+func (c *CdnFrontDoorFirewallPoliciesWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, policyName string, parameters frontdoor.WebApplicationFirewallPolicy, extensions FirewallPolicyExtensions) error {
+	payload, err := mergeFirewallPolicyExtensions(parameters, extensions)
+	if err != nil {
+		return err
+	}
+
+	req, err := c.prepare(ctx, autorest.AsPut(), resourceGroupName, policyName, autorest.WithJSON(payload))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.sdkClient.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	return future.WaitForCompletionRef(ctx, c.sdkClient.Client)
+}
+
+func (c *CdnFrontDoorFirewallPoliciesWorkaroundClient) prepare(ctx context.Context, method autorest.PrepareDecorator, resourceGroupName string, policyName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"policyName":        autorest.Encode("path", policyName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": firewallPolicyAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		method,
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/FrontDoorWebApplicationFirewallPolicies/{policyName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func mergeFirewallPolicyExtensions(input frontdoor.WebApplicationFirewallPolicy, extensions FirewallPolicyExtensions) (map[string]interface{}, error) {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling the policy: %+v", err)
+	}
+
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil, fmt.Errorf("unmarshaling the policy: %+v", err)
+	}
+
+	props, ok := payload["properties"].(map[string]interface{})
+	if !ok {
+		return payload, nil
+	}
+
+	if extensions.JavascriptChallengeExpirationInMinutes != nil {
+		policySettings, ok := props["policySettings"].(map[string]interface{})
+		if !ok {
+			policySettings = make(map[string]interface{})
+			props["policySettings"] = policySettings
+		}
+		policySettings["javascriptChallengeExpirationInMinutes"] = *extensions.JavascriptChallengeExpirationInMinutes
+	}
+
+	if customRules, ok := props["customRules"].(map[string]interface{}); ok {
+		if rules, ok := customRules["rules"].([]interface{}); ok {
+			for _, item := range rules {
+				rule, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				name, _ := rule["name"].(string)
+				variables, ok := extensions.CustomRuleGroupByVariables[name]
+				if !ok || len(variables) == 0 {
+					continue
+				}
+
+				groupBy := make([]interface{}, 0)
+				for _, v := range variables {
+					groupBy = append(groupBy, map[string]interface{}{
+						"variableName": v,
+					})
+				}
+				rule["groupBy"] = groupBy
+			}
+		}
+	}
+
+	return payload, nil
+}
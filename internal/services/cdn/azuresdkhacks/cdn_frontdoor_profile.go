@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since Log Scrubbing and the migration of Front Door (classic) profiles
+// are only available from API Version 2023-05-01, which isn't available in the SDK used by this package
+
+const profileAPIVersion = "2023-05-01"
+
+type CdnFrontDoorProfileWorkaroundClient struct {
+	sdkClient *cdn.ProfilesClient
+}
+
+func NewCdnFrontDoorProfileWorkaroundClient(client *cdn.ProfilesClient) CdnFrontDoorProfileWorkaroundClient {
+	return CdnFrontDoorProfileWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type ProfileModel struct {
+	Properties *ProfileProperties `json:"properties,omitempty"`
+}
+
+type ProfileProperties struct {
+	LogScrubbing *ProfileLogScrubbing `json:"logScrubbing,omitempty"`
+	// ExtendedProperties - READ-ONLY; contains the migration properties of a migrated profile
+	ExtendedProperties map[string]*string `json:"extendedProperties,omitempty"`
+}
+
+type ProfileLogScrubbing struct {
+	// State - Possible values include: 'Enabled', 'Disabled'
+	State          string                   `json:"state,omitempty"`
+	ScrubbingRules *[]ProfileScrubbingRules `json:"scrubbingRules,omitempty"`
+}
+
+type ProfileScrubbingRules struct {
+	// MatchVariable - Possible values include: 'QueryStringArgNames', 'RequestIPAddress', 'RequestUri'
+	MatchVariable string `json:"matchVariable"`
+	// SelectorMatchOperator - Possible values include: 'EqualsAny'
+	SelectorMatchOperator string  `json:"selectorMatchOperator"`
+	Selector              *string `json:"selector,omitempty"`
+	// State - Possible values include: 'Enabled', 'Disabled'
+	State string `json:"state,omitempty"`
+}
+
+type ResourceReference struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type CanMigrateParameters struct {
+	ClassicResourceReference ResourceReference `json:"classicResourceReference"`
+}
+
+type CanMigrateResult struct {
+	autorest.Response `json:"-"`
+	Properties        *CanMigrateProperties `json:"properties,omitempty"`
+}
+
+type CanMigrateProperties struct {
+	CanMigrate *bool             `json:"canMigrate,omitempty"`
+	DefaultSku *string           `json:"defaultSku,omitempty"`
+	Errors     *[]MigrationError `json:"errors,omitempty"`
+}
+
+type MigrationError struct {
+	ErrorCode    *string `json:"errorCode,omitempty"`
+	ResourceName *string `json:"resourceName,omitempty"`
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+	NextSteps    *string `json:"nextSteps,omitempty"`
+}
+
+type MigrationParameters struct {
+	Sku                                     cdn.Sku                                   `json:"sku"`
+	ClassicResourceReference                ResourceReference                         `json:"classicResourceReference"`
+	ProfileName                             string                                    `json:"profileName"`
+	MigrationWebApplicationFirewallMappings *[]MigrationWebApplicationFirewallMapping `json:"migrationWebApplicationFirewallMappings,omitempty"`
+}
+
+type MigrationWebApplicationFirewallMapping struct {
+	MigratedFrom *ResourceReference `json:"migratedFrom,omitempty"`
+	MigratedTo   *ResourceReference `json:"migratedTo,omitempty"`
+}
+
+type MigrateResult struct {
+	autorest.Response `json:"-"`
+	ID                *string                  `json:"id,omitempty"`
+	Properties        *MigrateResultProperties `json:"properties,omitempty"`
+}
+
+type MigrateResultProperties struct {
+	MigratedProfileResourceID *ResourceReference `json:"migratedProfileResourceId,omitempty"`
+}
+
+// Get retrieves the properties of a Front Door Profile which are only available in API Version 2023-05-01.
+func (c *CdnFrontDoorProfileWorkaroundClient) Get(ctx context.Context, resourceGroupName string, profileName string) (result ProfileModel, err error) {
+	req, err := c.prepare(ctx, autorest.AsGet(), "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cdn/profiles/{profileName}", resourceGroupName, profileName, nil)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Get", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// UpdateLogScrubbing patches the Log Scrubbing configuration of a Front Door Profile and waits for it to complete.
+func (c *CdnFrontDoorProfileWorkaroundClient) UpdateLogScrubbing(ctx context.Context, resourceGroupName string, profileName string, logScrubbing ProfileLogScrubbing) error {
+	input := ProfileModel{
+		Properties: &ProfileProperties{
+			LogScrubbing: &logScrubbing,
+		},
+	}
+
+	req, err := c.prepare(ctx, autorest.AsPatch(), "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cdn/profiles/{profileName}", resourceGroupName, profileName, input)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "cdn.ProfilesClient", "UpdateLogScrubbing", nil, "Failure preparing request")
+	}
+
+	if _, err := c.sendLongRunning(ctx, req); err != nil {
+		return autorest.NewErrorWithError(err, "cdn.ProfilesClient", "UpdateLogScrubbing", nil, "Failure sending request")
+	}
+
+	return nil
+}
+
+// CanMigrate checks whether the Front Door (classic) profile can be migrated to a Front Door Standard/Premium profile.
+func (c *CdnFrontDoorProfileWorkaroundClient) CanMigrate(ctx context.Context, resourceGroupName string, input CanMigrateParameters) (result CanMigrateResult, err error) {
+	req, err := c.prepare(ctx, autorest.AsPost(), "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cdn/canMigrate", resourceGroupName, "", input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "CanMigrate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sendLongRunning(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "CanMigrate", nil, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "CanMigrate", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// Migrate migrates the Front Door (classic) profile into a new Front Door Standard/Premium profile which
+// remains in a pending state until the migration has been committed.
+func (c *CdnFrontDoorProfileWorkaroundClient) Migrate(ctx context.Context, resourceGroupName string, input MigrationParameters) (result MigrateResult, err error) {
+	req, err := c.prepare(ctx, autorest.AsPost(), "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cdn/migrate", resourceGroupName, "", input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Migrate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sendLongRunning(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Migrate", nil, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Migrate", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// MigrationCommit commits the migration of a Front Door (classic) profile, after which the classic profile is disabled.
+func (c *CdnFrontDoorProfileWorkaroundClient) MigrationCommit(ctx context.Context, resourceGroupName string, profileName string) error {
+	req, err := c.prepare(ctx, autorest.AsPost(), "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cdn/profiles/{profileName}/migrationCommit", resourceGroupName, profileName, nil)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "cdn.ProfilesClient", "MigrationCommit", nil, "Failure preparing request")
+	}
+
+	if _, err := c.sendLongRunning(ctx, req); err != nil {
+		return autorest.NewErrorWithError(err, "cdn.ProfilesClient", "MigrationCommit", nil, "Failure sending request")
+	}
+
+	return nil
+}
+
+func (c *CdnFrontDoorProfileWorkaroundClient) prepare(ctx context.Context, method autorest.PrepareDecorator, path string, resourceGroupName string, profileName string, body interface{}) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+	if profileName != "" {
+		pathParameters["profileName"] = autorest.Encode("path", profileName)
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": profileAPIVersion,
+	}
+
+	decorators := []autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		method,
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters(path, pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+	}
+	if body != nil {
+		decorators = append(decorators, autorest.WithJSON(body))
+	}
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (c *CdnFrontDoorProfileWorkaroundClient) sendLongRunning(ctx context.Context, req *http.Request) (*http.Response, error) {
+	resp, err := c.sdkClient.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		return nil, err
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, c.sdkClient.Client); err != nil {
+		return nil, err
+	}
+
+	sender := autorest.DecorateSender(c.sdkClient, autorest.DoRetryForStatusCodes(c.sdkClient.RetryAttempts, c.sdkClient.RetryDuration, autorest.StatusCodesForRetry...))
+	return future.GetResult(sender)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -23,6 +24,15 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+// NOTE: these are only available from API Version 2024-02-01, see the workaround client in azuresdkhacks
+const (
+	frontDoorFirewallActionTypeJSChallenge = "JSChallenge"
+
+	frontDoorFirewallGroupByVariableGeoLocation = "GeoLocation"
+	frontDoorFirewallGroupByVariableNone        = "None"
+	frontDoorFirewallGroupByVariableSocketAddr  = "SocketAddr"
+)
+
 func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCdnFrontDoorFirewallPolicyCreate,
@@ -89,6 +99,13 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"js_challenge_cookie_expiration_in_minutes": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(5, 1440),
+			},
+
 			"custom_block_response_status_code": {
 				Type:     pluginsdk.TypeInt,
 				Optional: true,
@@ -152,12 +169,27 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 							Default:  10,
 						},
 
+						"group_by_variables": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									frontDoorFirewallGroupByVariableGeoLocation,
+									frontDoorFirewallGroupByVariableNone,
+									frontDoorFirewallGroupByVariableSocketAddr,
+								}, false),
+							},
+						},
+
 						"action": {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								string(frontdoor.ActionTypeAllow),
 								string(frontdoor.ActionTypeBlock),
+								frontDoorFirewallActionTypeJSChallenge,
 								string(frontdoor.ActionTypeLog),
 								string(frontdoor.ActionTypeRedirect),
 							}, false),
@@ -502,6 +534,21 @@ func resourceCdnFrontDoorFirewallPolicyCreate(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("the 'managed_rule' field is only supported with the 'Premium_AzureFrontDoor' sku, got %q", sku)
 	}
 
+	if err := validateCdnFrontDoorFirewallCustomRules(sku, customRules); err != nil {
+		return err
+	}
+
+	extensions := azuresdkhacks.FirewallPolicyExtensions{
+		CustomRuleGroupByVariables: expandCdnFrontDoorFirewallCustomRuleGroupByVariables(customRules),
+	}
+
+	if v, ok := d.GetOk("js_challenge_cookie_expiration_in_minutes"); ok {
+		if sku != string(frontdoor.SkuNamePremiumAzureFrontDoor) {
+			return fmt.Errorf("the 'js_challenge_cookie_expiration_in_minutes' field is only supported with the 'Premium_AzureFrontDoor' sku, got %q", sku)
+		}
+		extensions.JavascriptChallengeExpirationInMinutes = utils.Int64(int64(v.(int)))
+	}
+
 	t := d.Get("tags").(map[string]interface{})
 
 	payload := frontdoor.WebApplicationFirewallPolicy{
@@ -536,15 +583,11 @@ func resourceCdnFrontDoorFirewallPolicyCreate(d *pluginsdk.ResourceData, meta in
 		payload.WebApplicationFirewallPolicyProperties.PolicySettings.CustomBlockResponseStatusCode = utils.Int32(int32(customBlockResponseStatusCode))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName, payload)
-	if err != nil {
+	workaroundClient := azuresdkhacks.NewCdnFrontDoorFirewallPoliciesWorkaroundClient(client)
+	if err := workaroundClient.CreateOrUpdate(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName, payload, extensions); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
-	}
-
 	d.SetId(id.ID())
 	return resourceCdnFrontDoorFirewallPolicyRead(d, meta)
 }
@@ -559,7 +602,8 @@ func resourceCdnFrontDoorFirewallPolicyUpdate(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	existing, err := client.Get(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName)
+	workaroundClient := azuresdkhacks.NewCdnFrontDoorFirewallPoliciesWorkaroundClient(client)
+	existing, extensions, err := workaroundClient.Get(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
@@ -602,8 +646,21 @@ func resourceCdnFrontDoorFirewallPolicyUpdate(d *pluginsdk.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("js_challenge_cookie_expiration_in_minutes") {
+		if existing.Sku.Name != frontdoor.SkuNamePremiumAzureFrontDoor {
+			return fmt.Errorf("the 'js_challenge_cookie_expiration_in_minutes' field is only supported when using the sku 'Premium_AzureFrontDoor', got %q", existing.Sku.Name)
+		}
+		extensions.JavascriptChallengeExpirationInMinutes = utils.Int64(int64(d.Get("js_challenge_cookie_expiration_in_minutes").(int)))
+	}
+
 	if d.HasChange("custom_rule") {
-		props.CustomRules = expandCdnFrontDoorFirewallCustomRules(d.Get("custom_rule").([]interface{}))
+		customRules := d.Get("custom_rule").([]interface{})
+		if err := validateCdnFrontDoorFirewallCustomRules(string(existing.Sku.Name), customRules); err != nil {
+			return err
+		}
+
+		props.CustomRules = expandCdnFrontDoorFirewallCustomRules(customRules)
+		extensions.CustomRuleGroupByVariables = expandCdnFrontDoorFirewallCustomRuleGroupByVariables(customRules)
 	}
 
 	if d.HasChange("managed_rule") {
@@ -627,13 +684,9 @@ func resourceCdnFrontDoorFirewallPolicyUpdate(d *pluginsdk.ResourceData, meta in
 	}
 
 	existing.WebApplicationFirewallPolicyProperties = &props
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName, existing)
-	if err != nil {
+	if err := workaroundClient.CreateOrUpdate(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName, existing, extensions); err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
-	}
 
 	return resourceCdnFrontDoorFirewallPolicyRead(d, meta)
 }
@@ -648,7 +701,8 @@ func resourceCdnFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName)
+	workaroundClient := azuresdkhacks.NewCdnFrontDoorFirewallPoliciesWorkaroundClient(client)
+	resp, extensions, err := workaroundClient.Get(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[INFO] Cdn Frontdoor Firewall Policy %q does not exist - removing from state", d.Id())
@@ -677,7 +731,7 @@ func resourceCdnFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta inte
 			d.Set("custom_block_response_body", policy.CustomBlockResponseBody)
 		}
 
-		if err := d.Set("custom_rule", flattenCdnFrontDoorFirewallCustomRules(properties.CustomRules)); err != nil {
+		if err := d.Set("custom_rule", flattenCdnFrontDoorFirewallCustomRules(properties.CustomRules, extensions.CustomRuleGroupByVariables)); err != nil {
 			return fmt.Errorf("flattening 'custom_rule': %+v", err)
 		}
 
@@ -690,6 +744,12 @@ func resourceCdnFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	jsChallengeExpirationInMinutes := 0
+	if extensions.JavascriptChallengeExpirationInMinutes != nil {
+		jsChallengeExpirationInMinutes = int(*extensions.JavascriptChallengeExpirationInMinutes)
+	}
+	d.Set("js_challenge_cookie_expiration_in_minutes", jsChallengeExpirationInMinutes)
+
 	if err := tags.FlattenAndSet(d, flattenFrontDoorTags(resp.Tags)); err != nil {
 		return err
 	}
@@ -949,7 +1009,37 @@ func expandCdnFrontDoorFirewallRuleOverride(input []interface{}, versionRaw stri
 	return &result, nil
 }
 
-func flattenCdnFrontDoorFirewallCustomRules(input *frontdoor.CustomRuleList) []interface{} {
+func expandCdnFrontDoorFirewallCustomRuleGroupByVariables(input []interface{}) map[string][]string {
+	output := make(map[string][]string)
+
+	for _, cr := range input {
+		custom := cr.(map[string]interface{})
+		if variables := utils.ExpandStringSlice(custom["group_by_variables"].([]interface{})); len(*variables) > 0 {
+			output[custom["name"].(string)] = *variables
+		}
+	}
+
+	return output
+}
+
+func validateCdnFrontDoorFirewallCustomRules(sku string, input []interface{}) error {
+	for _, cr := range input {
+		custom := cr.(map[string]interface{})
+		name := custom["name"].(string)
+
+		if custom["action"].(string) == frontDoorFirewallActionTypeJSChallenge && sku != string(frontdoor.SkuNamePremiumAzureFrontDoor) {
+			return fmt.Errorf("the 'custom_rule' %q: the %q action is only supported with the 'Premium_AzureFrontDoor' sku, got %q", name, frontDoorFirewallActionTypeJSChallenge, sku)
+		}
+
+		if len(custom["group_by_variables"].([]interface{})) > 0 && custom["type"].(string) != string(frontdoor.RuleTypeRateLimitRule) {
+			return fmt.Errorf("the 'custom_rule' %q: 'group_by_variables' can only be specified when 'type' is %q", name, string(frontdoor.RuleTypeRateLimitRule))
+		}
+	}
+
+	return nil
+}
+
+func flattenCdnFrontDoorFirewallCustomRules(input *frontdoor.CustomRuleList, groupByVariables map[string][]string) []interface{} {
 	if input == nil || input.Rules == nil {
 		return []interface{}{}
 	}
@@ -997,6 +1087,7 @@ func flattenCdnFrontDoorFirewallCustomRules(input *frontdoor.CustomRuleList) []i
 			"match_condition":                flattenCdnFrontDoorFirewallMatchConditions(v.MatchConditions),
 			"rate_limit_duration_in_minutes": rateLimitDurationInMinutes,
 			"rate_limit_threshold":           rateLimitThreshold,
+			"group_by_variables":             utils.FlattenStringSlice(utils.StringSlice(groupByVariables[name])),
 			"priority":                       priority,
 			"name":                           name,
 			"type":                           ruleType,
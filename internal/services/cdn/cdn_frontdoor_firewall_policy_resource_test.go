@@ -253,6 +253,29 @@ func TestAccCdnFrontDoorFirewallPolicy_DRSTwoPointOneActionError(t *testing.T) {
 	})
 }
 
+func TestAccCdnFrontDoorFirewallPolicy_jsChallengeAndGroupBy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_firewall_policy", "test")
+	r := CdnFrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.jsChallengeAndGroupBy(data, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_rule.1.group_by_variables.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.jsChallengeAndGroupBy(data, 60),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("js_challenge_cookie_expiration_in_minutes").HasValue("60"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (CdnFrontDoorFirewallPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FrontDoorFirewallPolicyID(state.ID)
 	if err != nil {
@@ -793,3 +816,51 @@ resource "azurerm_cdn_frontdoor_firewall_policy" "test" {
 }
 `, tmp, data.RandomInteger)
 }
+
+func (r CdnFrontDoorFirewallPolicyResource) jsChallengeAndGroupBy(data acceptance.TestData, expirationInMinutes int) string {
+	tmp := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_firewall_policy" "test" {
+  name                                      = "accTestWAF%d"
+  resource_group_name                       = azurerm_resource_group.test.name
+  sku_name                                  = azurerm_cdn_frontdoor_profile.test.sku_name
+  mode                                      = "Prevention"
+  js_challenge_cookie_expiration_in_minutes = %d
+
+  custom_rule {
+    name     = "Rule1"
+    enabled  = true
+    priority = 1
+    type     = "MatchRule"
+    action   = "JSChallenge"
+
+    match_condition {
+      match_variable     = "RequestUri"
+      operator           = "Contains"
+      negation_condition = false
+      match_values       = ["/login"]
+    }
+  }
+
+  custom_rule {
+    name                           = "Rule2"
+    enabled                        = true
+    priority                       = 2
+    rate_limit_duration_in_minutes = 1
+    rate_limit_threshold           = 100
+    group_by_variables             = ["SocketAddr", "GeoLocation"]
+    type                           = "RateLimitRule"
+    action                         = "Block"
+
+    match_condition {
+      match_variable     = "RequestUri"
+      operator           = "Contains"
+      negation_condition = false
+      match_values       = ["/api"]
+    }
+  }
+}
+`, tmp, data.RandomInteger, expirationInMinutes)
+}
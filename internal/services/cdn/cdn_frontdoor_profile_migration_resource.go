@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2020-05-01/frontdoors"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorProfileMigration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorProfileMigrationCreate,
+		Read:   resourceCdnFrontDoorProfileMigrationRead,
+		Delete: resourceCdnFrontDoorProfileMigrationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorProfileID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"frontdoor_classic_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: frontdoors.ValidateFrontDoorID,
+			},
+
+			"profile_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.SkuNamePremiumAzureFrontDoor),
+					string(cdn.SkuNameStandardAzureFrontDoor),
+				}, false),
+			},
+
+			"web_application_firewall_mapping": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"migrated_from_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.FrontDoorFirewallPolicyID,
+						},
+
+						"migrated_to_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.FrontDoorFirewallPolicyID,
+						},
+					},
+				},
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorProfileMigrationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorProfileClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	classicId, err := frontdoors.ParseFrontDoorID(d.Get("frontdoor_classic_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorProfileID(subscriptionId, d.Get("resource_group_name").(string), d.Get("profile_name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_profile_migration", id.ID())
+	}
+
+	workaroundClient := azuresdkhacks.NewCdnFrontDoorProfileWorkaroundClient(client)
+
+	canMigrate, err := workaroundClient.CanMigrate(ctx, id.ResourceGroup, azuresdkhacks.CanMigrateParameters{
+		ClassicResourceReference: azuresdkhacks.ResourceReference{
+			ID: utils.String(classicId.ID()),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("checking whether %s can be migrated: %+v", *classicId, err)
+	}
+
+	skuName := d.Get("sku_name").(string)
+	if props := canMigrate.Properties; props != nil {
+		if props.CanMigrate == nil || !*props.CanMigrate {
+			return fmt.Errorf("%s can not be migrated: %s", *classicId, flattenCdnFrontDoorMigrationErrors(props.Errors))
+		}
+
+		if skuName == "" && props.DefaultSku != nil {
+			skuName = *props.DefaultSku
+		}
+	}
+
+	if skuName == "" {
+		skuName = string(cdn.SkuNameStandardAzureFrontDoor)
+	}
+
+	input := azuresdkhacks.MigrationParameters{
+		Sku: cdn.Sku{
+			Name: cdn.SkuName(skuName),
+		},
+		ClassicResourceReference: azuresdkhacks.ResourceReference{
+			ID: utils.String(classicId.ID()),
+		},
+		ProfileName:                             id.ProfileName,
+		MigrationWebApplicationFirewallMappings: expandCdnFrontDoorMigrationWebApplicationFirewallMappings(d.Get("web_application_firewall_mapping").([]interface{})),
+	}
+
+	if _, err := workaroundClient.Migrate(ctx, id.ResourceGroup, input); err != nil {
+		return fmt.Errorf("migrating %s to %s: %+v", *classicId, id, err)
+	}
+
+	// the migrated profile exists from this point, so the ID is set to allow a failed commit to be tainted and retried
+	d.SetId(id.ID())
+
+	if err := workaroundClient.MigrationCommit(ctx, id.ResourceGroup, id.ProfileName); err != nil {
+		return fmt.Errorf("committing the migration of %s to %s: %+v", *classicId, id, err)
+	}
+
+	d.Set("sku_name", skuName)
+
+	return resourceCdnFrontDoorProfileMigrationRead(d, meta)
+}
+
+func resourceCdnFrontDoorProfileMigrationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorProfileClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing the migration from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("profile_name", id.ProfileName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("cdn_frontdoor_profile_id", id.ID())
+
+	if resp.Sku != nil {
+		d.Set("sku_name", string(resp.Sku.Name))
+	}
+
+	workaroundClient := azuresdkhacks.NewCdnFrontDoorProfileWorkaroundClient(client)
+	profile, err := workaroundClient.Get(ctx, id.ResourceGroup, id.ProfileName)
+	if err != nil {
+		return fmt.Errorf("retrieving the migration properties for %s: %+v", *id, err)
+	}
+
+	// the ID of the Front Door (classic) profile is only returned for migrated profiles, so this is only set when present
+	if props := profile.Properties; props != nil {
+		if v, ok := props.ExtendedProperties["MigratedFrom"]; ok && v != nil && *v != "" {
+			if classicId, err := frontdoors.ParseFrontDoorIDInsensitively(*v); err == nil {
+				d.Set("frontdoor_classic_id", classicId.ID())
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorProfileMigrationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	id, err := parse.FrontDoorProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// a committed migration can't be reverted and the migrated profile is expected to be managed by the
+	// `azurerm_cdn_frontdoor_profile` resource, so this only removes the migration from the state
+	log.Printf("[DEBUG] removing the migration to %s from state - the migrated profile will not be deleted", *id)
+
+	return nil
+}
+
+func expandCdnFrontDoorMigrationWebApplicationFirewallMappings(input []interface{}) *[]azuresdkhacks.MigrationWebApplicationFirewallMapping {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]azuresdkhacks.MigrationWebApplicationFirewallMapping, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, azuresdkhacks.MigrationWebApplicationFirewallMapping{
+			MigratedFrom: &azuresdkhacks.ResourceReference{
+				ID: utils.String(v["migrated_from_id"].(string)),
+			},
+			MigratedTo: &azuresdkhacks.ResourceReference{
+				ID: utils.String(v["migrated_to_id"].(string)),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenCdnFrontDoorMigrationErrors(input *[]azuresdkhacks.MigrationError) string {
+	if input == nil || len(*input) == 0 {
+		return "no reason was returned by the API"
+	}
+
+	messages := make([]string, 0)
+	for _, e := range *input {
+		message := fmt.Sprintf("%s: %s", utils.NormalizeNilableString(e.ResourceName), utils.NormalizeNilableString(e.ErrorMessage))
+		if e.NextSteps != nil && *e.NextSteps != "" {
+			message = fmt.Sprintf("%s (%s)", message, *e.NextSteps)
+		}
+		messages = append(messages, message)
+	}
+
+	return strings.Join(messages, ", ")
+}
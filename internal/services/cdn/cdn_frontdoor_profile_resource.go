@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -21,6 +22,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+const (
+	frontDoorLogScrubbingMatchVariableQueryStringArgNames = "QueryStringArgNames"
+	frontDoorLogScrubbingMatchVariableRequestIPAddress    = "RequestIPAddress"
+	frontDoorLogScrubbingMatchVariableRequestUri          = "RequestUri"
+)
+
 func resourceCdnFrontDoorProfile() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCdnFrontDoorProfileCreate,
@@ -67,6 +74,25 @@ func resourceCdnFrontDoorProfile() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"log_scrubbing_rule": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"match_variable": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								frontDoorLogScrubbingMatchVariableQueryStringArgNames,
+								frontDoorLogScrubbingMatchVariableRequestIPAddress,
+								frontDoorLogScrubbingMatchVariableRequestUri,
+							}, false),
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.Tags(),
 
 			"resource_guid": {
@@ -115,6 +141,13 @@ func resourceCdnFrontDoorProfileCreate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
 	}
 
+	if rules := d.Get("log_scrubbing_rule").(*pluginsdk.Set).List(); len(rules) > 0 {
+		workaroundClient := azuresdkhacks.NewCdnFrontDoorProfileWorkaroundClient(client)
+		if err := workaroundClient.UpdateLogScrubbing(ctx, id.ResourceGroup, id.ProfileName, expandCdnFrontDoorProfileLogScrubbing(rules)); err != nil {
+			return fmt.Errorf("updating the log scrubbing rules for %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceCdnFrontDoorProfileRead(d, meta)
 }
@@ -155,6 +188,20 @@ func resourceCdnFrontDoorProfileRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.Set("sku_name", skuName)
 
+	workaroundClient := azuresdkhacks.NewCdnFrontDoorProfileWorkaroundClient(client)
+	profile, err := workaroundClient.Get(ctx, id.ResourceGroup, id.ProfileName)
+	if err != nil {
+		return fmt.Errorf("retrieving the log scrubbing rules for %s: %+v", id, err)
+	}
+
+	var logScrubbingProps *azuresdkhacks.ProfileLogScrubbing
+	if profile.Properties != nil {
+		logScrubbingProps = profile.Properties.LogScrubbing
+	}
+	if err := d.Set("log_scrubbing_rule", flattenCdnFrontDoorProfileLogScrubbing(logScrubbingProps)); err != nil {
+		return fmt.Errorf("setting `log_scrubbing_rule`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -186,6 +233,13 @@ func resourceCdnFrontDoorProfileUpdate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
 	}
 
+	if d.HasChange("log_scrubbing_rule") {
+		workaroundClient := azuresdkhacks.NewCdnFrontDoorProfileWorkaroundClient(client)
+		if err := workaroundClient.UpdateLogScrubbing(ctx, id.ResourceGroup, id.ProfileName, expandCdnFrontDoorProfileLogScrubbing(d.Get("log_scrubbing_rule").(*pluginsdk.Set).List())); err != nil {
+			return fmt.Errorf("updating the log scrubbing rules for %s: %+v", *id, err)
+		}
+	}
+
 	return resourceCdnFrontDoorProfileRead(d, meta)
 }
 
@@ -210,3 +264,45 @@ func resourceCdnFrontDoorProfileDelete(d *pluginsdk.ResourceData, meta interface
 
 	return nil
 }
+
+func expandCdnFrontDoorProfileLogScrubbing(input []interface{}) azuresdkhacks.ProfileLogScrubbing {
+	// removing all of the rules disables log scrubbing, since the API requires at least one rule whilst enabled
+	rules := make([]azuresdkhacks.ProfileScrubbingRules, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		rules = append(rules, azuresdkhacks.ProfileScrubbingRules{
+			MatchVariable:         v["match_variable"].(string),
+			SelectorMatchOperator: "EqualsAny",
+			State:                 string(cdn.EnabledStateEnabled),
+		})
+	}
+
+	state := cdn.EnabledStateEnabled
+	if len(rules) == 0 {
+		state = cdn.EnabledStateDisabled
+	}
+
+	return azuresdkhacks.ProfileLogScrubbing{
+		State:          string(state),
+		ScrubbingRules: &rules,
+	}
+}
+
+func flattenCdnFrontDoorProfileLogScrubbing(input *azuresdkhacks.ProfileLogScrubbing) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.ScrubbingRules == nil || input.State != string(cdn.EnabledStateEnabled) {
+		return results
+	}
+
+	for _, rule := range *input.ScrubbingRules {
+		if rule.State != "" && rule.State != string(cdn.EnabledStateEnabled) {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"match_variable": rule.MatchVariable,
+		})
+	}
+
+	return results
+}
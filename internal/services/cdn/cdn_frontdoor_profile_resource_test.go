@@ -83,6 +83,29 @@ func TestAccCdnFrontDoorProfile_update(t *testing.T) {
 	})
 }
 
+func TestAccCdnFrontDoorProfile_logScrubbing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_profile", "test")
+	r := CdnFrontDoorProfileResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.logScrubbing(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("log_scrubbing_rule.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("log_scrubbing_rule.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r CdnFrontDoorProfileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FrontDoorProfileID(state.ID)
 	if err != nil {
@@ -175,6 +198,35 @@ resource "azurerm_cdn_frontdoor_profile" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r CdnFrontDoorProfileResource) logScrubbing(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_cdn_frontdoor_profile" "test" {
+  name                = "acctestprofile-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Standard_AzureFrontDoor"
+
+  log_scrubbing_rule {
+    match_variable = "QueryStringArgNames"
+  }
+
+  log_scrubbing_rule {
+    match_variable = "RequestIPAddress"
+  }
+
+  log_scrubbing_rule {
+    match_variable = "RequestUri"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (CdnFrontDoorProfileResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
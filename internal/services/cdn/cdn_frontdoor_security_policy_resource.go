@@ -4,6 +4,7 @@
 package cdn
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -24,11 +25,13 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCdnFrontdoorSecurityPolicyCreate,
 		Read:   resourceCdnFrontdoorSecurityPolicyRead,
+		Update: resourceCdnFrontdoorSecurityPolicyUpdate,
 		Delete: resourceCdnFrontdoorSecurityPolicyDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -55,7 +58,6 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 			"security_policies": {
 				Type:     pluginsdk.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 
 				Elem: &pluginsdk.Resource{
@@ -64,7 +66,6 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 						"firewall": {
 							Type:     pluginsdk.TypeList,
 							Required: true,
-							ForceNew: true,
 							MaxItems: 1,
 
 							Elem: &pluginsdk.Resource{
@@ -80,8 +81,6 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 									"association": {
 										Type:     pluginsdk.TypeList,
 										Required: true,
-										ForceNew: true,
-										MaxItems: 1,
 
 										Elem: &pluginsdk.Resource{
 											Schema: map[string]*pluginsdk.Schema{
@@ -90,7 +89,6 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 												"domain": {
 													Type:     pluginsdk.TypeList,
 													Required: true,
-													ForceNew: true,
 													MaxItems: 500,
 
 													Elem: &pluginsdk.Resource{
@@ -98,7 +96,6 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 															"cdn_frontdoor_domain_id": {
 																Type:         pluginsdk.TypeString,
 																Required:     true,
-																ForceNew:     true,
 																ValidateFunc: validate.FrontDoorSecurityPolicyDomainID,
 															},
 
@@ -110,18 +107,13 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 													},
 												},
 
-												// NOTE: Per the service team the only acceptable value as of GA is "/*"
 												"patterns_to_match": {
 													Type:     pluginsdk.TypeList,
 													Required: true,
-													ForceNew: true,
-													MaxItems: 1,
 
 													Elem: &pluginsdk.Schema{
-														Type: pluginsdk.TypeString,
-														ValidateFunc: validation.StringInSlice([]string{
-															"/*",
-														}, false),
+														Type:         pluginsdk.TypeString,
+														ValidateFunc: validate.FrontDoorSecurityPolicyPatternToMatch,
 													},
 												},
 											},
@@ -162,18 +154,11 @@ func resourceCdnFrontdoorSecurityPolicyCreate(d *pluginsdk.ResourceData, meta in
 		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_security_policy", id.ID())
 	}
 
-	profileClient := meta.(*clients.Client).Cdn.FrontDoorProfileClient
-	resp, err := profileClient.Get(ctx, profile.ResourceGroup, profile.ProfileName)
+	isStandardSku, err := cdnFrontDoorProfileIsStandardSku(ctx, meta.(*clients.Client).Cdn.FrontDoorProfileClient, *profile)
 	if err != nil {
-		return fmt.Errorf("unable to retrieve the 'sku_name' from the CDN FrontDoor Profile(Name: %q)': %+v", profile.ProfileName, err)
-	}
-
-	if resp.Sku == nil {
-		return fmt.Errorf("the CDN FrontDoor Profile(Name: %q) 'sku' was nil", profile.ProfileName)
+		return err
 	}
 
-	isStandardSku := strings.HasPrefix(strings.ToLower(string(resp.Sku.Name)), "standard")
-
 	params, err := cdnfrontdoorsecurityparams.ExpandCdnFrontdoorFirewallPolicyParameters(d.Get("security_policies").([]interface{}), isStandardSku)
 	if err != nil {
 		return fmt.Errorf("expanding 'security_policies': %+v", err)
@@ -270,6 +255,46 @@ func resourceCdnFrontdoorSecurityPolicyRead(d *pluginsdk.ResourceData, meta inte
 	return nil
 }
 
+func resourceCdnFrontdoorSecurityPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecurityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	profile := parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName)
+	isStandardSku, err := cdnFrontDoorProfileIsStandardSku(ctx, meta.(*clients.Client).Cdn.FrontDoorProfileClient, profile)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: the associations are patched in place so that domains can be added and removed without recreating the policy
+	params, err := cdnfrontdoorsecurityparams.ExpandCdnFrontdoorFirewallPolicyParameters(d.Get("security_policies").([]interface{}), isStandardSku)
+	if err != nil {
+		return fmt.Errorf("expanding 'security_policies': %+v", err)
+	}
+
+	props := cdn.SecurityPolicyUpdateParameters{
+		SecurityPolicyUpdateProperties: &cdn.SecurityPolicyUpdateProperties{
+			Parameters: params,
+		},
+	}
+
+	future, err := client.Patch(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName, props)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontdoorSecurityPolicyRead(d, meta)
+}
+
 func resourceCdnFrontdoorSecurityPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -291,3 +316,16 @@ func resourceCdnFrontdoorSecurityPolicyDelete(d *pluginsdk.ResourceData, meta in
 
 	return nil
 }
+
+func cdnFrontDoorProfileIsStandardSku(ctx context.Context, client *cdn.ProfilesClient, profile parse.FrontDoorProfileId) (bool, error) {
+	resp, err := client.Get(ctx, profile.ResourceGroup, profile.ProfileName)
+	if err != nil {
+		return false, fmt.Errorf("unable to retrieve the 'sku_name' from the CDN FrontDoor Profile(Name: %q)': %+v", profile.ProfileName, err)
+	}
+
+	if resp.Sku == nil {
+		return false, fmt.Errorf("the CDN FrontDoor Profile(Name: %q) 'sku' was nil", profile.ProfileName)
+	}
+
+	return strings.HasPrefix(strings.ToLower(string(resp.Sku.Name)), "standard"), nil
+}
@@ -5,6 +5,7 @@ package cdnfrontdoorsecurityparams
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
@@ -41,17 +42,27 @@ func ExpandCdnFrontdoorFirewallPolicyParameters(input []interface{}, isStandardS
 
 	configAssociations := v["association"].([]interface{})
 
+	// NOTE: a domain can only be part of a single association, and the domain limit applies across all of the associations
+	seenDomains := make(map[string]struct{})
 	for _, item := range configAssociations {
 		v := item.(map[string]interface{})
 		domains := expandSecurityPoliciesActivatedResourceReference(v["domain"].([]interface{}))
 
+		for _, domain := range *domains {
+			key := strings.ToLower(*domain.ID)
+			if _, ok := seenDomains[key]; ok {
+				return &results, fmt.Errorf("the domain %q is associated with the firewall policy more than once, a domain can only be part of a single 'association'", *domain.ID)
+			}
+			seenDomains[key] = struct{}{}
+		}
+
 		if isStandardSku {
-			if len(*domains) > 100 {
-				return &results, fmt.Errorf("the 'Standard_AzureFrontDoor' sku is only allowed to have 100 or less domains associated with the firewall policy, got %d", len(*domains))
+			if len(seenDomains) > 100 {
+				return &results, fmt.Errorf("the 'Standard_AzureFrontDoor' sku is only allowed to have 100 or less domains associated with the firewall policy, got %d", len(seenDomains))
 			}
 		} else {
-			if len(*domains) > 500 {
-				return &results, fmt.Errorf("the 'Premium_AzureFrontDoor' sku is only allowed to have 500 or less domains associated with the firewall policy, got %d", len(*domains))
+			if len(seenDomains) > 500 {
+				return &results, fmt.Errorf("the 'Premium_AzureFrontDoor' sku is only allowed to have 500 or less domains associated with the firewall policy, got %d", len(seenDomains))
 			}
 		}
 
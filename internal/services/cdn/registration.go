@@ -62,6 +62,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_cdn_frontdoor_origin":                    resourceCdnFrontDoorOrigin(),
 		"azurerm_cdn_frontdoor_origin_group":              resourceCdnFrontDoorOriginGroup(),
 		"azurerm_cdn_frontdoor_profile":                   resourceCdnFrontDoorProfile(),
+		"azurerm_cdn_frontdoor_profile_migration":         resourceCdnFrontDoorProfileMigration(),
 		"azurerm_cdn_frontdoor_route":                     resourceCdnFrontDoorRoute(),
 		"azurerm_cdn_frontdoor_rule":                      resourceCdnFrontDoorRule(),
 		"azurerm_cdn_frontdoor_rule_set":                  resourceCdnFrontDoorRuleSet(),
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+func FrontDoorSecurityPolicyPatternToMatch(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if !strings.HasPrefix(v, "/") {
+		return nil, []error{fmt.Errorf(`%q must begin with a forward slash, got %q`, k, v)}
+	}
+
+	if strings.Contains(strings.TrimSuffix(v, "*"), "*") {
+		return nil, []error{fmt.Errorf(`%q may only contain a wildcard (*) as the last character, got %q`, k, v)}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestFrontDoorSecurityPolicyPatternToMatch(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "*",
+			Valid: false,
+		},
+		{
+			Input: "api/*",
+			Valid: false,
+		},
+		{
+			Input: "/*/login",
+			Valid: false,
+		},
+		{
+			Input: "/*",
+			Valid: true,
+		},
+		{
+			Input: "/api/*",
+			Valid: true,
+		},
+		{
+			Input: "/login",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := FrontDoorSecurityPolicyPatternToMatch(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-05-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-10-01-preview/raipolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type cognitiveAccountRaiPolicyModel struct {
+	Name               string                        `tfschema:"name"`
+	CognitiveAccountId string                        `tfschema:"cognitive_account_id"`
+	BasePolicyName     string                        `tfschema:"base_policy_name"`
+	ContentFilter      []RaiPolicyContentFilterModel `tfschema:"content_filter"`
+	Mode               string                        `tfschema:"mode"`
+	Tags               map[string]string             `tfschema:"tags"`
+}
+
+type RaiPolicyContentFilterModel struct {
+	Name              string `tfschema:"name"`
+	FilterEnabled     bool   `tfschema:"filter_enabled"`
+	BlockEnabled      bool   `tfschema:"block_enabled"`
+	SeverityThreshold string `tfschema:"severity_threshold"`
+	Source            string `tfschema:"source"`
+}
+
+type CognitiveAccountRaiPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = CognitiveAccountRaiPolicyResource{}
+
+func (r CognitiveAccountRaiPolicyResource) ResourceType() string {
+	return "azurerm_cognitive_account_rai_policy"
+}
+
+func (r CognitiveAccountRaiPolicyResource) ModelObject() interface{} {
+	return &cognitiveAccountRaiPolicyModel{}
+}
+
+func (r CognitiveAccountRaiPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return raipolicies.ValidateRaiPolicyID
+}
+
+func (r CognitiveAccountRaiPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cognitive_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cognitiveservicesaccounts.ValidateAccountID,
+		},
+
+		"base_policy_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"content_filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"filter_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"block_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"severity_threshold": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForAllowedContentLevel(), false),
+					},
+
+					"source": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyContentSource(), false),
+					},
+				},
+			},
+		},
+
+		"mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(raipolicies.RaiPolicyModeDefault),
+			ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyMode(), false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveAccountRaiPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+			accountId, err := cognitiveservicesaccounts.ParseAccountID(model.CognitiveAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			id := raipolicies.NewRaiPolicyID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := raipolicies.RaiPolicy{
+				Properties: &raipolicies.RaiPolicyProperties{
+					BasePolicyName: pointer.To(model.BasePolicyName),
+					ContentFilters: expandRaiPolicyContentFilters(model.ContentFilter),
+					Mode:           pointer.To(raipolicies.RaiPolicyMode(model.Mode)),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := cognitiveAccountRaiPolicyModel{
+				Name:               id.RaiPolicyName,
+				CognitiveAccountId: cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.BasePolicyName = pointer.From(props.BasePolicyName)
+					state.ContentFilter = flattenRaiPolicyContentFilters(props.ContentFilters)
+					state.Mode = string(pointer.From(props.Mode))
+				}
+				state.Tags = pointer.From(model.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model cognitiveAccountRaiPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId := cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model` or `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("content_filter") {
+				payload.Properties.ContentFilters = expandRaiPolicyContentFilters(model.ContentFilter)
+			}
+
+			if metadata.ResourceData.HasChange("mode") {
+				payload.Properties.Mode = pointer.To(raipolicies.RaiPolicyMode(model.Mode))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandRaiPolicyContentFilters(input []RaiPolicyContentFilterModel) *[]raipolicies.RaiPolicyContentFilter {
+	output := make([]raipolicies.RaiPolicyContentFilter, 0)
+	for _, v := range input {
+		output = append(output, raipolicies.RaiPolicyContentFilter{
+			Name:                pointer.To(v.Name),
+			Enabled:             pointer.To(v.FilterEnabled),
+			Blocking:            pointer.To(v.BlockEnabled),
+			AllowedContentLevel: pointer.To(raipolicies.AllowedContentLevel(v.SeverityThreshold)),
+			Source:              pointer.To(raipolicies.RaiPolicyContentSource(v.Source)),
+		})
+	}
+
+	return &output
+}
+
+func flattenRaiPolicyContentFilters(input *[]raipolicies.RaiPolicyContentFilter) []RaiPolicyContentFilterModel {
+	output := make([]RaiPolicyContentFilterModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, RaiPolicyContentFilterModel{
+			Name:              pointer.From(v.Name),
+			FilterEnabled:     pointer.From(v.Enabled),
+			BlockEnabled:      pointer.From(v.Blocking),
+			SeverityThreshold: string(pointer.From(v.AllowedContentLevel)),
+			Source:            string(pointer.From(v.Source)),
+		})
+	}
+
+	return output
+}
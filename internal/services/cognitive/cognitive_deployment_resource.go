@@ -11,7 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-05-01/cognitiveservicesaccounts"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-05-01/deployments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-10-01-preview/deployments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,12 +21,13 @@ import (
 )
 
 type cognitiveDeploymentModel struct {
-	Name                 string                         `tfschema:"name"`
-	CognitiveAccountId   string                         `tfschema:"cognitive_account_id"`
-	Model                []DeploymentModelModel         `tfschema:"model"`
-	RaiPolicyName        string                         `tfschema:"rai_policy_name"`
-	ScaleSettings        []DeploymentScaleSettingsModel `tfschema:"scale"`
-	VersionUpgradeOption string                         `tfschema:"version_upgrade_option"`
+	Name                     string                         `tfschema:"name"`
+	CognitiveAccountId       string                         `tfschema:"cognitive_account_id"`
+	DynamicThrottlingEnabled bool                           `tfschema:"dynamic_throttling_enabled"`
+	Model                    []DeploymentModelModel         `tfschema:"model"`
+	RaiPolicyName            string                         `tfschema:"rai_policy_name"`
+	ScaleSettings            []DeploymentScaleSettingsModel `tfschema:"scale"`
+	VersionUpgradeOption     string                         `tfschema:"version_upgrade_option"`
 }
 
 type DeploymentModelModel struct {
@@ -75,6 +76,11 @@ func (r CognitiveDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: cognitiveservicesaccounts.ValidateAccountID,
 		},
 
+		"dynamic_throttling_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
 		"model": {
 			Type:     pluginsdk.TypeList,
 			Required: true,
@@ -252,6 +258,10 @@ func (r CognitiveDeploymentResource) Create() sdk.ResourceFunc {
 
 			properties.Properties.Model = expandDeploymentModelModel(model.Model)
 
+			if model.DynamicThrottlingEnabled {
+				properties.Properties.DynamicThrottlingEnabled = pointer.To(model.DynamicThrottlingEnabled)
+			}
+
 			if model.RaiPolicyName != "" {
 				properties.Properties.RaiPolicyName = &model.RaiPolicyName
 			}
@@ -310,6 +320,10 @@ func (r CognitiveDeploymentResource) Update() sdk.ResourceFunc {
 				properties.Properties.RaiPolicyName = pointer.To(model.RaiPolicyName)
 			}
 
+			if metadata.ResourceData.HasChange("dynamic_throttling_enabled") {
+				properties.Properties.DynamicThrottlingEnabled = pointer.To(model.DynamicThrottlingEnabled)
+			}
+
 			if metadata.ResourceData.HasChange("model.0.version") {
 				properties.Properties.Model.Version = pointer.To(model.Model[0].Version)
 			}
@@ -359,6 +373,7 @@ func (r CognitiveDeploymentResource) Read() sdk.ResourceFunc {
 			if properties := model.Properties; properties != nil {
 
 				state.Model = flattenDeploymentModelModel(properties.Model)
+				state.DynamicThrottlingEnabled = pointer.From(properties.DynamicThrottlingEnabled)
 
 				if v := properties.RaiPolicyName; v != nil {
 					state.RaiPolicyName = *v
@@ -9,14 +9,16 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/communicationservices"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/domains"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/emailservices"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/senderusernames"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
 	ServiceClient *communicationservices.CommunicationServicesClient
 
-	EmailServicesClient *emailservices.EmailServicesClient
-	DomainClient        *domains.DomainsClient
+	EmailServicesClient   *emailservices.EmailServicesClient
+	DomainClient          *domains.DomainsClient
+	SenderUsernamesClient *senderusernames.SenderUsernamesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -38,9 +40,16 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(domainsClient.Client, o.Authorizers.ResourceManager)
 
+	senderUsernamesClient, err := senderusernames.NewSenderUsernamesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Sender Usernames client: %+v", err)
+	}
+	o.Configure(senderUsernamesClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
-		ServiceClient:       servicesClient,
-		EmailServicesClient: emailServicesClient,
-		DomainClient:        domainsClient,
+		ServiceClient:         servicesClient,
+		EmailServicesClient:   emailServicesClient,
+		DomainClient:          domainsClient,
+		SenderUsernamesClient: senderUsernamesClient,
 	}, nil
 }
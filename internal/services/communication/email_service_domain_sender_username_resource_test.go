@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/senderusernames"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EmailServiceDomainSenderUsernameTestResource struct{}
+
+func TestAccEmailServiceDomainSenderUsername_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain_sender_username", "test")
+	r := EmailServiceDomainSenderUsernameTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEmailServiceDomainSenderUsername_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain_sender_username", "test")
+	r := EmailServiceDomainSenderUsernameTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEmailServiceDomainSenderUsername_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain_sender_username", "test")
+	r := EmailServiceDomainSenderUsernameTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "Terraform Acceptance Test"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "Terraform Acceptance Test Updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r EmailServiceDomainSenderUsernameTestResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := senderusernames.ParseSenderUsernameID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Communication.SenderUsernamesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EmailServiceDomainSenderUsernameTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain_sender_username" "test" {
+  name                    = "acctest-su-%d"
+  email_service_domain_id = azurerm_email_communication_service_domain.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EmailServiceDomainSenderUsernameTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain_sender_username" "import" {
+  name                    = azurerm_email_communication_service_domain_sender_username.test.name
+  email_service_domain_id = azurerm_email_communication_service_domain_sender_username.test.email_service_domain_id
+}
+`, r.basic(data))
+}
+
+func (r EmailServiceDomainSenderUsernameTestResource) complete(data acceptance.TestData, displayName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain_sender_username" "test" {
+  name                    = "acctest-su-%d"
+  email_service_domain_id = azurerm_email_communication_service_domain.test.id
+  display_name            = "%s"
+}
+`, r.template(data), data.RandomInteger, displayName)
+}
+
+func (r EmailServiceDomainSenderUsernameTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-communicationservice-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_email_communication_service" "test" {
+  name                = "acctest-CommunicationService-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_location       = "United States"
+}
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name             = "example.com"
+  email_service_id = azurerm_email_communication_service.test.id
+
+  domain_management = "CustomerManaged"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
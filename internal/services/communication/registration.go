@@ -36,6 +36,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		EmailCommunicationServiceDomainResource{},
+		EmailCommunicationServiceDomainSenderUsernameResource{},
 		EmailCommunicationServiceResource{},
 		CommunicationServiceResource{},
 	}
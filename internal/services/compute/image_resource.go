@@ -53,6 +53,8 @@ func resourceImage() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupName(),
 
+			"edge_zone": commonschema.EdgeZoneOptionalForceNew(),
+
 			"zone_resilient": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -246,9 +248,10 @@ func resourceImageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	payload := images.Image{
-		Location:   location.Normalize(d.Get("location").(string)),
-		Properties: &props,
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		ExtendedLocation: expandEdgeZone(d.Get("edge_zone").(string)),
+		Location:         location.Normalize(d.Get("location").(string)),
+		Properties:       &props,
+		Tags:             tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
@@ -283,6 +286,7 @@ func resourceImageRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	if model := resp.Model; model != nil {
 		d.Set("location", location.Normalize(model.Location))
+		d.Set("edge_zone", flattenEdgeZone(model.ExtendedLocation))
 
 		if props := model.Properties; props != nil {
 			hyperVGeneration := ""
@@ -417,6 +417,10 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			virtualMachineQuotaCustomizeDiff,
+		),
 	}
 }
 
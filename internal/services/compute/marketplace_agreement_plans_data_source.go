@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachineimages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceMarketplaceAgreementPlans() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceMarketplaceAgreementPlansRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": commonschema.Location(),
+
+			"publisher": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"offer": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"plans": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"accepted": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"license_text_link": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"privacy_policy_link": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMarketplaceAgreementPlansRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	imagesClient := meta.(*clients.Client).Compute.VirtualMachineImagesClient
+	agreementsClient := meta.(*clients.Client).Compute.MarketplaceAgreementsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := virtualmachineimages.NewOfferID(subscriptionId, location.Normalize(d.Get("location").(string)), d.Get("publisher").(string), d.Get("offer").(string))
+
+	skus, err := imagesClient.ListSkus(ctx, id)
+	if err != nil {
+		if response.WasNotFound(skus.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("listing plans for %s: %+v", id, err)
+	}
+
+	plans := make([]interface{}, 0)
+	if model := skus.Model; model != nil {
+		for _, sku := range *model {
+			termsId := agreements.NewOfferPlanID(id.SubscriptionId, id.PublisherName, id.OfferName, sku.Name)
+			terms, err := agreementsClient.MarketplaceAgreementsGet(ctx, termsId)
+			if err != nil {
+				// not every plan of an offer is sold through the Marketplace, in which case there are no terms to accept
+				if response.WasNotFound(terms.HttpResponse) || response.WasBadRequest(terms.HttpResponse) {
+					continue
+				}
+				return fmt.Errorf("retrieving the Marketplace Terms for %s: %+v", termsId, err)
+			}
+
+			plan := map[string]interface{}{
+				"name":                sku.Name,
+				"accepted":            false,
+				"license_text_link":   "",
+				"privacy_policy_link": "",
+			}
+			if terms.Model != nil && terms.Model.Properties != nil {
+				props := terms.Model.Properties
+				plan["accepted"] = pointer.From(props.Accepted)
+				plan["license_text_link"] = pointer.From(props.LicenseTextLink)
+				plan["privacy_policy_link"] = pointer.From(props.PrivacyPolicyLink)
+			}
+			plans = append(plans, plan)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("location", location.Normalize(id.LocationName))
+	d.Set("publisher", id.PublisherName)
+	d.Set("offer", id.OfferName)
+
+	if err := d.Set("plans", plans); err != nil {
+		return fmt.Errorf("setting `plans`: %+v", err)
+	}
+
+	return nil
+}
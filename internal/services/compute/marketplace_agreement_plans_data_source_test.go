@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MarketplaceAgreementPlansDataSource struct{}
+
+func TestAccDataSourceMarketplaceAgreementPlans_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_marketplace_agreement_plans", "test")
+	r := MarketplaceAgreementPlansDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("plans.#").IsNotEmpty(),
+				check.That(data.ResourceName).Key("plans.0.name").Exists(),
+				check.That(data.ResourceName).Key("plans.0.license_text_link").Exists(),
+				check.That(data.ResourceName).Key("plans.0.privacy_policy_link").Exists(),
+			),
+		},
+	})
+}
+
+func (MarketplaceAgreementPlansDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_marketplace_agreement_plans" "test" {
+  location  = "%s"
+  publisher = "barracudanetworks"
+  offer     = "waf"
+}
+`, data.Locations.Primary)
+}
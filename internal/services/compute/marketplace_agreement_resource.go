@@ -4,6 +4,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -22,6 +23,7 @@ func resourceMarketplaceAgreement() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMarketplaceAgreementCreate,
 		Read:   resourceMarketplaceAgreementRead,
+		Update: resourceMarketplaceAgreementUpdate,
 		Delete: resourceMarketplaceAgreementDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := agreements.ParsePlanID(id)
@@ -31,9 +33,20 @@ func resourceMarketplaceAgreement() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				// when the publisher revises the terms the existing acceptance is revoked, re-accept them in-place when opted in
+				if d.Id() != "" && d.Get("accept_updated_terms").(bool) && !d.Get("accepted").(bool) {
+					return d.SetNew("accepted", true)
+				}
+				return nil
+			},
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"offer": {
 				Type:         pluginsdk.TypeString,
@@ -56,6 +69,17 @@ func resourceMarketplaceAgreement() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"accept_updated_terms": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"accepted": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"license_text_link": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -99,6 +123,50 @@ func resourceMarketplaceAgreementCreate(d *pluginsdk.ResourceData, meta interfac
 		return tf.ImportAsExistsError("azurerm_marketplace_agreement", id.ID())
 	}
 
+	if err := acceptMarketplaceAgreementTerms(ctx, client, id); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMarketplaceAgreementRead(d, meta)
+}
+
+func resourceMarketplaceAgreementUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.MarketplaceAgreementsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := agreements.ParsePlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.Get("accept_updated_terms").(bool) {
+		agreementId := agreements.NewOfferPlanID(id.SubscriptionId, id.PublisherId, id.OfferId, id.PlanId)
+		term, err := client.MarketplaceAgreementsGet(ctx, agreementId)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %s", id, err)
+		}
+
+		accepted := false
+		if model := term.Model; model != nil && model.Properties != nil && model.Properties.Accepted != nil {
+			accepted = *model.Properties.Accepted
+		}
+
+		if !accepted {
+			log.Printf("[WARN] The Marketplace Terms for %s have been revised by the publisher and are no longer accepted - re-accepting the updated terms since `accept_updated_terms` is enabled", id)
+			if err := acceptMarketplaceAgreementTerms(ctx, client, *id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceMarketplaceAgreementRead(d, meta)
+}
+
+func acceptMarketplaceAgreementTerms(ctx context.Context, client *agreements.AgreementsClient, id agreements.PlanId) error {
+	agreementId := agreements.NewOfferPlanID(id.SubscriptionId, id.PublisherId, id.OfferId, id.PlanId)
 	resp, err := client.MarketplaceAgreementsGet(ctx, agreementId)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %s", id, err)
@@ -121,9 +189,7 @@ func resourceMarketplaceAgreementCreate(d *pluginsdk.ResourceData, meta interfac
 	}
 	log.Printf("[DEBUG] Accepted the Marketplace Terms for %s", id)
 
-	d.SetId(id.ID())
-
-	return resourceMarketplaceAgreementRead(d, meta)
+	return nil
 }
 
 func resourceMarketplaceAgreementRead(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -151,13 +217,17 @@ func resourceMarketplaceAgreementRead(d *pluginsdk.ResourceData, meta interface{
 	d.Set("publisher", id.PublisherId)
 	d.Set("offer", id.OfferId)
 	d.Set("plan", id.PlanId)
+	d.Set("accept_updated_terms", d.Get("accept_updated_terms").(bool))
 
 	if model := term.Model; model != nil {
 		if props := model.Properties; props != nil {
-			if accepted := props.Accepted != nil && *props.Accepted; !accepted {
+			accepted := props.Accepted != nil && *props.Accepted
+			if !accepted && !d.Get("accept_updated_terms").(bool) {
 				// if props.Accepted is not true, the agreement does not exist
 				d.SetId("")
+				return nil
 			}
+			d.Set("accepted", accepted)
 			d.Set("license_text_link", props.LicenseTextLink)
 			d.Set("privacy_policy_link", props.PrivacyPolicyLink)
 		}
@@ -45,6 +45,44 @@ func TestAccMarketplaceAgreement_basic(t *testing.T) {
 	})
 }
 
+func TestAccMarketplaceAgreement_acceptUpdatedTerms(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_agreement", "test")
+	r := MarketplaceAgreementResource{}
+	offer := "waf"
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.empty(),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientWithoutResource(r.cancelExistingAgreement(offer)),
+			),
+		},
+		{
+			Config: r.acceptUpdatedTerms(offer),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("accepted").HasValue("true"),
+			),
+		},
+		data.ImportStep("accept_updated_terms"),
+		{
+			// cancelling the agreement outside of Terraform mimics the publisher revising the terms
+			Config: r.acceptUpdatedTerms(offer),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientWithoutResource(r.cancelExistingAgreement(offer)),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.acceptUpdatedTerms(offer),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("accepted").HasValue("true"),
+			),
+		},
+	})
+}
+
 func TestAccMarketplaceAgreement_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_marketplace_agreement", "test")
 	r := MarketplaceAgreementResource{}
@@ -111,6 +149,21 @@ resource "azurerm_marketplace_agreement" "test" {
 `, offer)
 }
 
+func (MarketplaceAgreementResource) acceptUpdatedTerms(offer string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_marketplace_agreement" "test" {
+  publisher            = "barracudanetworks"
+  offer                = "%s"
+  plan                 = "hourly"
+  accept_updated_terms = true
+}
+`, offer)
+}
+
 func (r MarketplaceAgreementResource) requiresImport(offer string) string {
 	return fmt.Sprintf(`
 %s
@@ -64,6 +64,8 @@ func resourceSnapshot() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupName(),
 
+			"edge_zone": commonschema.EdgeZoneOptionalForceNew(),
+
 			"create_option": {
 				Type:     pluginsdk.TypeString,
 				Required: true,
@@ -174,7 +176,8 @@ func resourceSnapshotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	properties := snapshots.Snapshot{
-		Location: location,
+		ExtendedLocation: expandEdgeZone(d.Get("edge_zone").(string)),
+		Location:         location,
 		Properties: &snapshots.SnapshotProperties{
 			CreationData: snapshots.CreationData{
 				CreateOption: snapshots.DiskCreateOption(createOption),
@@ -251,6 +254,7 @@ func resourceSnapshotRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	if model := resp.Model; model != nil {
 		d.Set("location", azure.NormalizeLocation(model.Location))
+		d.Set("edge_zone", flattenEdgeZone(model.ExtendedLocation))
 
 		if props := model.Properties; props != nil {
 			data := props.CreationData
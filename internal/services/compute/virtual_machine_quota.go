@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/quota"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// virtualMachineQuotaCustomizeDiff validates there's enough quota available for a new or resized Virtual Machine,
+// when this has been opted into via the `quota_validation` feature
+func virtualMachineQuotaCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client)
+	if !client.Features.QuotaValidation.Enabled {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("size") && !d.HasChange("priority") {
+		return nil
+	}
+	if !d.NewValueKnown("size") || !d.NewValueKnown("location") {
+		return nil
+	}
+
+	oldSize, newSize := d.GetChange("size")
+	oldPriority, newPriority := d.GetChange("priority")
+
+	change := quota.Change{
+		Location: d.Get("location").(string),
+		After: quota.Allocation{
+			VMSize:          newSize.(string),
+			VirtualMachines: 1,
+			Spot:            strings.EqualFold(newPriority.(string), "Spot"),
+		},
+	}
+	if d.Id() != "" {
+		change.Before = quota.Allocation{
+			VMSize:          oldSize.(string),
+			VirtualMachines: 1,
+			Spot:            strings.EqualFold(oldPriority.(string), "Spot"),
+		}
+	}
+
+	return quota.ValidateHeadroom(ctx, client, change)
+}
+
+// virtualMachineScaleSetQuotaCustomizeDiff validates there's enough quota available for a new, resized or scaled
+// Virtual Machine Scale Set, when this has been opted into via the `quota_validation` feature
+func virtualMachineScaleSetQuotaCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client)
+	if !client.Features.QuotaValidation.Enabled {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges("sku", "instances", "priority", "network_interface") {
+		return nil
+	}
+	if !d.NewValueKnown("sku") || !d.NewValueKnown("instances") || !d.NewValueKnown("location") {
+		return nil
+	}
+
+	oldSku, newSku := d.GetChange("sku")
+	oldInstances, newInstances := d.GetChange("instances")
+	oldPriority, newPriority := d.GetChange("priority")
+	oldNetworkInterfaces, newNetworkInterfaces := d.GetChange("network_interface")
+
+	change := quota.Change{
+		Location: d.Get("location").(string),
+		After: quota.Allocation{
+			VMSize:            newSku.(string),
+			VirtualMachines:   int64(newInstances.(int)),
+			Spot:              strings.EqualFold(newPriority.(string), "Spot"),
+			PublicIPAddresses: int64(newInstances.(int) * virtualMachineScaleSetPublicIPAddressesPerInstance(newNetworkInterfaces.([]interface{}))),
+		},
+	}
+	if d.Id() != "" {
+		change.Before = quota.Allocation{
+			VMSize:            oldSku.(string),
+			VirtualMachines:   int64(oldInstances.(int)),
+			Spot:              strings.EqualFold(oldPriority.(string), "Spot"),
+			PublicIPAddresses: int64(oldInstances.(int) * virtualMachineScaleSetPublicIPAddressesPerInstance(oldNetworkInterfaces.([]interface{}))),
+		}
+	}
+
+	return quota.ValidateHeadroom(ctx, client, change)
+}
+
+// virtualMachineScaleSetPublicIPAddressesPerInstance returns the number of Public IP Addresses created for each instance
+func virtualMachineScaleSetPublicIPAddressesPerInstance(networkInterfaces []interface{}) int {
+	count := 0
+	for _, networkInterface := range networkInterfaces {
+		networkInterfaceRaw, ok := networkInterface.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, ipConfiguration := range networkInterfaceRaw["ip_configuration"].([]interface{}) {
+			ipConfigurationRaw, ok := ipConfiguration.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			count += len(ipConfigurationRaw["public_ip_address"].([]interface{}))
+		}
+	}
+	return count
+}
@@ -434,6 +434,10 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			virtualMachineQuotaCustomizeDiff,
+		),
 	}
 }
 
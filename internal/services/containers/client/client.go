@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2023-05-01/containerinstance"
 	containerregistry_v2019_06_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview"
 	containerregistry_v2021_08_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview"
+	registries_v2023_06_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-07-01/cacherules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2019-08-01/containerservices"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/agentpools"
@@ -18,7 +19,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetupdatestrategies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/updateruns"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-11-01/extensions"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-11-01/fluxconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2023-05-01/fluxconfiguration"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -31,15 +32,17 @@ type Client struct {
 	ContainerRegistryClient_v2021_08_01_preview *containerregistry_v2021_08_01_preview.Client
 	// v2019_06_01_preview is needed for container registry agent pools and tasks
 	ContainerRegistryClient_v2019_06_01_preview *containerregistry_v2019_06_01_preview.Client
-	FleetUpdateRunsClient                       *updateruns.UpdateRunsClient
-	FleetUpdateStrategiesClient                 *fleetupdatestrategies.FleetUpdateStrategiesClient
-	KubernetesClustersClient                    *managedclusters.ManagedClustersClient
-	KubernetesExtensionsClient                  *extensions.ExtensionsClient
-	KubernetesFluxConfigurationClient           *fluxconfiguration.FluxConfigurationClient
-	MaintenanceConfigurationsClient             *maintenanceconfigurations.MaintenanceConfigurationsClient
-	ServicesClient                              *containerservices.ContainerServicesClient
-	SnapshotClient                              *snapshots.SnapshotsClient
-	Environment                                 environments.Environment
+	// v2023_06_01_preview is needed for the soft delete policy of container registries
+	ContainerRegistryRegistriesClient_v2023_06_01_preview *registries_v2023_06_01_preview.RegistriesClient
+	FleetUpdateRunsClient                                 *updateruns.UpdateRunsClient
+	FleetUpdateStrategiesClient                           *fleetupdatestrategies.FleetUpdateStrategiesClient
+	KubernetesClustersClient                              *managedclusters.ManagedClustersClient
+	KubernetesExtensionsClient                            *extensions.ExtensionsClient
+	KubernetesFluxConfigurationClient                     *fluxconfiguration.FluxConfigurationClient
+	MaintenanceConfigurationsClient                       *maintenanceconfigurations.MaintenanceConfigurationsClient
+	ServicesClient                                        *containerservices.ContainerServicesClient
+	SnapshotClient                                        *snapshots.SnapshotsClient
+	Environment                                           environments.Environment
 }
 
 func NewContainersClient(o *common.ClientOptions) (*Client, error) {
@@ -63,6 +66,12 @@ func NewContainersClient(o *common.ClientOptions) (*Client, error) {
 		return nil, err
 	}
 
+	containerRegistryRegistriesClient_v2023_06_01_preview, err := registries_v2023_06_01_preview.NewRegistriesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Container Registry Registries client: %+v", err)
+	}
+	o.Configure(containerRegistryRegistriesClient_v2023_06_01_preview.Client, o.Authorizers.ResourceManager)
+
 	cacheRulesClient, err := cacherules.NewCacheRulesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Cache Rules client: %+v", err)
@@ -125,19 +134,20 @@ func NewContainersClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(snapshotClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
-		AgentPoolsClient:                            agentPoolsClient,
-		ContainerInstanceClient:                     containerInstanceClient,
-		CacheRulesClient:                            cacheRulesClient,
-		ContainerRegistryClient_v2021_08_01_preview: containerRegistryClient_v2021_08_01_preview,
-		ContainerRegistryClient_v2019_06_01_preview: containerRegistryClient_v2019_06_01_preview,
-		FleetUpdateRunsClient:                       fleetUpdateRunsClient,
-		FleetUpdateStrategiesClient:                 fleetUpdateStrategiesClient,
-		KubernetesClustersClient:                    kubernetesClustersClient,
-		KubernetesExtensionsClient:                  kubernetesExtensionsClient,
-		KubernetesFluxConfigurationClient:           fluxConfigurationClient,
-		MaintenanceConfigurationsClient:             maintenanceConfigurationsClient,
-		ServicesClient:                              servicesClient,
-		SnapshotClient:                              snapshotClient,
-		Environment:                                 o.Environment,
+		AgentPoolsClient:                                      agentPoolsClient,
+		ContainerInstanceClient:                               containerInstanceClient,
+		CacheRulesClient:                                      cacheRulesClient,
+		ContainerRegistryClient_v2021_08_01_preview:           containerRegistryClient_v2021_08_01_preview,
+		ContainerRegistryClient_v2019_06_01_preview:           containerRegistryClient_v2019_06_01_preview,
+		ContainerRegistryRegistriesClient_v2023_06_01_preview: containerRegistryRegistriesClient_v2023_06_01_preview,
+		FleetUpdateRunsClient:                                 fleetUpdateRunsClient,
+		FleetUpdateStrategiesClient:                           fleetUpdateStrategiesClient,
+		KubernetesClustersClient:                              kubernetesClustersClient,
+		KubernetesExtensionsClient:                            kubernetesExtensionsClient,
+		KubernetesFluxConfigurationClient:                     fluxConfigurationClient,
+		MaintenanceConfigurationsClient:                       maintenanceConfigurationsClient,
+		ServicesClient:                                        servicesClient,
+		SnapshotClient:                                        snapshotClient,
+		Environment:                                           o.Environment,
 	}, nil
 }
@@ -515,6 +515,12 @@ func resourceContainerGroup() *pluginsdk.Resource {
 				if d.Get("ip_address_type").(string) != "None" {
 					return fmt.Errorf("`ip_address_type` has to be `None` when `priority` is set to `Spot`")
 				}
+				if d.Get("sku").(string) == string(containerinstance.ContainerGroupSkuConfidential) {
+					return fmt.Errorf("`sku` cannot be `Confidential` when `priority` is set to `Spot`")
+				}
+				if v, ok := d.GetOk("subnet_ids"); ok && v.(*pluginsdk.Set).Len() > 0 {
+					return fmt.Errorf("`subnet_ids` cannot be specified when `priority` is set to `Spot`")
+				}
 			}
 			return nil
 		},
@@ -6,6 +6,7 @@ package containers_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2023-05-01/containerinstance"
@@ -771,6 +772,18 @@ func TestAccContainerGroup_priority(t *testing.T) {
 	})
 }
 
+func TestAccContainerGroup_prioritySpotConfidential(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
+	r := ContainerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.prioritySpotConfidential(data),
+			ExpectError: regexp.MustCompile("`sku` cannot be `Confidential` when `priority` is set to `Spot`"),
+		},
+	})
+}
+
 func TestAccContainerGroup_updateWithStorageAccount(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
 	r := ContainerGroupResource{}
@@ -2692,6 +2705,40 @@ resource "azurerm_container_group" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, priority)
 }
 
+func (ContainerGroupResource) prioritySpotConfidential(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_group" "test" {
+  name                = "acctestcontainergroup-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  ip_address_type     = "None"
+  os_type             = "Linux"
+  sku                 = "Confidential"
+  priority            = "Spot"
+
+  container {
+    name   = "hw"
+    image  = "mcr.microsoft.com/quantum/linux-selfcontained:latest"
+    cpu    = "0.5"
+    memory = "0.5"
+    ports {
+      port     = 80
+      protocol = "TCP"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (ContainerGroupResource) storageAccount(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -21,11 +21,13 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/operation"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/replications"
+	registries_v2023_06_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/registries"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/nameavailability"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -158,11 +160,35 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				return fmt.Errorf("`data_endpoint_enabled` can only be applied when using the Premium Sku")
 			}
 
-			return nil
+			// soft delete isn't supported for geo-replicated registries
+			if d.Get("soft_delete_retention_in_days").(int) > 0 && len(geoReplications) > 0 {
+				return fmt.Errorf("`soft_delete_retention_in_days` cannot be used when `georeplications` are specified")
+			}
+
+			return nameavailability.CustomizeDiff("Container Registry", containerRegistryNameAvailability)(ctx, d, v)
 		}),
 	}
 }
 
+func containerRegistryNameAvailability(ctx context.Context, client *clients.Client, _ *pluginsdk.ResourceDiff, name string) (*nameavailability.Result, error) {
+	input := operation.RegistryNameCheckRequest{
+		Name: name,
+		Type: "Microsoft.ContainerRegistry/registries",
+	}
+	resp, err := client.Containers.ContainerRegistryClient_v2021_08_01_preview.Operation.RegistriesCheckNameAvailability(ctx, commonids.NewSubscriptionID(client.Account.SubscriptionId), input)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Model == nil || resp.Model.NameAvailable == nil {
+		return nil, fmt.Errorf("`model` was nil")
+	}
+
+	return &nameavailability.Result{
+		Available: *resp.Model.NameAvailable,
+		Message:   pointer.From(resp.Model.Message),
+	}, nil
+}
+
 func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
 	operationClient := meta.(*clients.Client).Containers.ContainerRegistryClient_v2021_08_01_preview.Operation
@@ -291,6 +317,12 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if v := d.Get("soft_delete_retention_in_days").(int); v > 0 {
+		if err := applyContainerRegistrySoftDeletePolicy(ctx, meta, id, v); err != nil {
+			return fmt.Errorf("applying the soft delete policy for %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceContainerRegistryRead(d, meta)
@@ -474,6 +506,12 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
+	if d.HasChange("soft_delete_retention_in_days") {
+		if err := applyContainerRegistrySoftDeletePolicy(ctx, meta, *id, d.Get("soft_delete_retention_in_days").(int)); err != nil {
+			return fmt.Errorf("applying the soft delete policy for %s: %+v", id, err)
+		}
+	}
+
 	// downgrade to Basic or Standard SKU
 	if skuChange && (isBasicSku || isStandardSku) {
 		if err := applyContainerRegistrySku(d, meta, sku, *id); err != nil {
@@ -505,6 +543,36 @@ func applyContainerRegistrySku(d *pluginsdk.ResourceData, meta interface{}, sku
 	return nil
 }
 
+// applyContainerRegistrySoftDeletePolicy enables the soft delete policy with the specified retention, or disables it
+// when `retentionDays` is 0 - this uses a newer API version since the soft delete policy isn't available in 2021-08-01-preview
+func applyContainerRegistrySoftDeletePolicy(ctx context.Context, meta interface{}, registryId registries.RegistryId, retentionDays int) error {
+	client := meta.(*clients.Client).Containers.ContainerRegistryRegistriesClient_v2023_06_01_preview
+	id := registries_v2023_06_01_preview.NewRegistryID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName)
+
+	policy := &registries_v2023_06_01_preview.SoftDeletePolicy{
+		Status: pointer.To(registries_v2023_06_01_preview.PolicyStatusDisabled),
+	}
+	if retentionDays > 0 {
+		policy = &registries_v2023_06_01_preview.SoftDeletePolicy{
+			RetentionDays: pointer.To(int64(retentionDays)),
+			Status:        pointer.To(registries_v2023_06_01_preview.PolicyStatusEnabled),
+		}
+	}
+
+	payload := registries_v2023_06_01_preview.RegistryUpdateParameters{
+		Properties: &registries_v2023_06_01_preview.RegistryPropertiesUpdateParameters{
+			Policies: &registries_v2023_06_01_preview.Policies{
+				SoftDeletePolicy: policy,
+			},
+		},
+	}
+	if err := client.UpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
 func applyGeoReplicationLocations(ctx context.Context, meta interface{}, registryId registries.RegistryId, oldGeoReplications []replications.Replication, newGeoReplications []replications.Replication) error {
 	replicationClient := meta.(*clients.Client).Containers.ContainerRegistryClient_v2021_08_01_preview.Replications
 	log.Printf("[INFO] preparing to apply geo-replications for Container Registry.")
@@ -777,6 +845,22 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 
 	d.Set("georeplications", geoReplications)
 
+	// the soft delete policy is only available in a newer API version
+	softDeleteClient := meta.(*clients.Client).Containers.ContainerRegistryRegistriesClient_v2023_06_01_preview
+	softDeleteId := registries_v2023_06_01_preview.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName)
+	softDeleteResp, err := softDeleteClient.Get(ctx, softDeleteId)
+	if err != nil {
+		return fmt.Errorf("retrieving the soft delete policy for %s: %+v", *id, err)
+	}
+
+	softDeleteRetentionInDays := 0
+	if model := softDeleteResp.Model; model != nil && model.Properties != nil && model.Properties.Policies != nil {
+		if policy := model.Properties.Policies.SoftDeletePolicy; policy != nil && pointer.From(policy.Status) == registries_v2023_06_01_preview.PolicyStatusEnabled {
+			softDeleteRetentionInDays = int(pointer.From(policy.RetentionDays))
+		}
+	}
+	d.Set("soft_delete_retention_in_days", softDeleteRetentionInDays)
+
 	return nil
 }
 
@@ -1237,6 +1321,12 @@ func resourceContainerRegistrySchema() map[string]*pluginsdk.Schema {
 			Default:  true,
 		},
 
+		"soft_delete_retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 90),
+		},
+
 		"zone_redundancy_enabled": {
 			Type:     pluginsdk.TypeBool,
 			ForceNew: true,
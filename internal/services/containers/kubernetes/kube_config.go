@@ -37,6 +37,21 @@ type userItemAAD struct {
 
 type userAAD struct {
 	AuthProvider authProvider `yaml:"auth-provider"`
+	Exec         *ExecConfig  `yaml:"exec,omitempty"`
+}
+
+// ExecConfig is the client-go credential plugin configuration returned by AKS for AAD enabled clusters,
+// which invokes `kubelogin` to obtain a token
+type ExecConfig struct {
+	APIVersion string       `yaml:"apiVersion"`
+	Command    string       `yaml:"command"`
+	Args       []string     `yaml:"args,omitempty"`
+	Env        []execEnvVar `yaml:"env,omitempty"`
+}
+
+type execEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
 }
 
 type authProvider struct {
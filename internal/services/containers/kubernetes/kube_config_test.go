@@ -195,3 +195,37 @@ func LoadConfig(fileName string) string {
 
 	return string(bytes)
 }
+
+func TestParseKubeConfigAADExec(t *testing.T) {
+	config, err := ParseKubeConfigAAD(LoadConfig("user_with_exec.yml"))
+	if err != nil {
+		t.Fatalf("parsing config: %+v", err)
+	}
+
+	expected := &ExecConfig{
+		APIVersion: "client.authentication.k8s.io/v1beta1",
+		Command:    "kubelogin",
+		Args: []string{
+			"get-token",
+			"--environment",
+			"AzurePublicCloud",
+			"--server-id",
+			"6dae42f8-4368-4678-94ff-3960e28e3630",
+			"--client-id",
+			"80faf920-1908-4b52-b5ef-a8e7bedfc67a",
+			"--tenant-id",
+			"00000000-0000-0000-0000-000000000000",
+			"--login",
+			"devicecode",
+		},
+		Env: []execEnvVar{
+			{
+				Name:  "AAD_LOGIN_METHOD",
+				Value: "devicecode",
+			},
+		},
+	}
+	if actual := config.Users[0].User.Exec; !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected exec config '%+v' but got '%+v'", expected, actual)
+	}
+}
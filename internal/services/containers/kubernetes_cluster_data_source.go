@@ -93,6 +93,11 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 							Computed: true,
 						},
 
+						"custom_ca_trust_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
 						"vm_size": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -494,6 +499,48 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"kube_config_exec": {
+				Type:      pluginsdk.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"cluster_ca_certificate": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"api_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"command": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"args": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+						"env": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
 			"kube_config_raw": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -726,6 +773,42 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"web_app_routing": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"dns_zone_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+						"web_app_routing_identity": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"client_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"object_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"user_assigned_identity_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.TagsDataSource(),
 		},
 	}
@@ -875,6 +958,10 @@ func dataSourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}
 				return fmt.Errorf("setting `service_mesh_profile`: %+v", err)
 			}
 
+			if err := d.Set("web_app_routing", flattenKubernetesClusterDataSourceWebAppRouting(props.IngressProfile)); err != nil {
+				return fmt.Errorf("setting `web_app_routing`: %+v", err)
+			}
+
 			kubeletIdentity, err := flattenKubernetesClusterDataSourceIdentityProfile(props.IdentityProfile)
 			if err != nil {
 				return err
@@ -974,6 +1061,9 @@ func dataSourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}
 		if err := d.Set("kube_config", kubeConfig); err != nil {
 			return fmt.Errorf("setting `kube_config`: %+v", err)
 		}
+		if err := d.Set("kube_config_exec", flattenKubernetesClusterCredentialsExec(userCredentialsResp.Model, "clusterUser")); err != nil {
+			return fmt.Errorf("setting `kube_config_exec`: %+v", err)
+		}
 
 		d.Set("tags", tags.Flatten(model.Tags))
 	}
@@ -1085,6 +1175,67 @@ func flattenKubernetesClusterCredentials(model *managedclusters.CredentialResult
 	return nil, []interface{}{}
 }
 
+// flattenKubernetesClusterCredentialsExec returns the client-go credential plugin configuration for AAD enabled clusters,
+// which is returned by the API when the kubeconfig is requested in the `exec` format
+func flattenKubernetesClusterCredentialsExec(model *managedclusters.CredentialResults, configName string) []interface{} {
+	if model == nil || model.Kubeconfigs == nil {
+		return []interface{}{}
+	}
+
+	for _, c := range *model.Kubeconfigs {
+		if c.Name == nil || *c.Name != configName || c.Value == nil {
+			continue
+		}
+
+		rawConfig := *c.Value
+		if base64IsEncoded(rawConfig) {
+			rawConfig = base64Decode(rawConfig)
+		}
+
+		kubeConfigAAD, err := kubernetes.ParseKubeConfigAAD(rawConfig)
+		if err != nil || len(kubeConfigAAD.Users) == 0 || kubeConfigAAD.Users[0].User.Exec == nil {
+			return []interface{}{}
+		}
+
+		exec := kubeConfigAAD.Users[0].User.Exec
+		env := make(map[string]interface{})
+		for _, v := range exec.Env {
+			env[v.Name] = v.Value
+		}
+
+		return []interface{}{
+			map[string]interface{}{
+				"host":                   kubeConfigAAD.Clusters[0].Cluster.Server,
+				"cluster_ca_certificate": kubeConfigAAD.Clusters[0].Cluster.ClusterAuthorityData,
+				"api_version":            exec.APIVersion,
+				"command":                exec.Command,
+				"args":                   exec.Args,
+				"env":                    env,
+			},
+		}
+	}
+
+	return []interface{}{}
+}
+
+func flattenKubernetesClusterDataSourceWebAppRouting(input *managedclusters.ManagedClusterIngressProfile) []interface{} {
+	if input == nil || input.WebAppRouting == nil || !pointer.From(input.WebAppRouting.Enabled) {
+		return []interface{}{}
+	}
+
+	webAppRoutingIdentity := []interface{}{}
+	if v := input.WebAppRouting.Identity; v != nil {
+		webAppRoutingIdentity = flattenKubernetesClusterAddOnIdentityProfile(v)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"dns_zone_ids":             utils.FlattenStringSlice(input.WebAppRouting.DnsZoneResourceIds),
+			"web_app_routing_identity": webAppRoutingIdentity,
+		},
+	}
+}
+
 func flattenKubernetesClusterDataSourceAddOns(profile map[string]managedclusters.ManagedClusterAddonProfile) map[string]interface{} {
 	aciConnectors := make([]interface{}, 0)
 	aciConnector := kubernetesAddonProfileLocate(profile, aciConnectorKey)
@@ -1254,6 +1405,11 @@ func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]managedcluster
 			enableAutoScaling = *profile.EnableAutoScaling
 		}
 
+		customCaTrustEnabled := false
+		if profile.EnableCustomCATrust != nil {
+			customCaTrustEnabled = *profile.EnableCustomCATrust
+		}
+
 		name := profile.Name
 
 		nodePublicIPPrefixID := profile.NodePublicIPPrefixID
@@ -1299,6 +1455,7 @@ func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]managedcluster
 		out := map[string]interface{}{
 			"count":                    count,
 			"auto_scaling_enabled":     enableAutoScaling,
+			"custom_ca_trust_enabled":  customCaTrustEnabled,
 			"node_public_ip_enabled":   enableNodePublicIP,
 			"max_count":                maxCount,
 			"max_pods":                 maxPods,
@@ -590,6 +590,21 @@ func TestAccDataSourceKubernetesCluster_serviceMesh(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceKubernetesCluster_webAppRoutingWithMultipleDnsZone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.webAppRoutingWithMultipleDnsZone(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("web_app_routing.0.dns_zone_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("web_app_routing.0.web_app_routing_identity.0.object_id").IsSet(),
+			),
+		},
+	})
+}
+
 func TestAccDataSourceKubernetesCluster_serviceMeshCertificateAuthority(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster", "test")
 	r := KubernetesClusterDataSource{}
@@ -1012,6 +1027,16 @@ data "azurerm_kubernetes_cluster" "test" {
 `, KubernetesClusterResource{}.serviceMeshProfile(data, true, true))
 }
 
+func (KubernetesClusterDataSource) webAppRoutingWithMultipleDnsZone(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+data "azurerm_kubernetes_cluster" "test" {
+  name                = azurerm_kubernetes_cluster.test.name
+  resource_group_name = azurerm_kubernetes_cluster.test.resource_group_name
+}
+`, KubernetesClusterResource{}.webAppRoutingWithMultipleDnsZone(data))
+}
+
 func (s KubernetesClusterDataSource) serviceMeshCertificateAuthority(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -13,8 +13,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/agentpools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/responsecache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -50,6 +52,11 @@ func dataSourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"custom_ca_trust_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"eviction_policy": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -183,7 +190,9 @@ func dataSourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta int
 	clusterId := commonids.NewKubernetesClusterID(subscriptionId, d.Get("resource_group_name").(string), d.Get("kubernetes_cluster_name").(string))
 
 	// if the parent cluster doesn't exist then the node pool won't
-	cluster, err := clustersClient.Get(ctx, clusterId)
+	cluster, err := responsecache.Get(meta.(*clients.Client).ResponseCache, clusterId.ID(), func() (managedclusters.GetOperationResponse, error) {
+		return clustersClient.Get(ctx, clusterId)
+	})
 	if err != nil {
 		if response.WasNotFound(cluster.HttpResponse) {
 			return fmt.Errorf("%s was not found", clusterId)
@@ -212,6 +221,7 @@ func dataSourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta int
 		d.Set("zones", zones.FlattenUntyped(props.AvailabilityZones))
 
 		d.Set("auto_scaling_enabled", props.EnableAutoScaling)
+		d.Set("custom_ca_trust_enabled", props.EnableCustomCATrust)
 		d.Set("node_public_ip_enabled", props.EnableNodePublicIP)
 
 		if !features.FourPointOhBeta() {
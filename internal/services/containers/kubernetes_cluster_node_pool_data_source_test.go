@@ -24,6 +24,7 @@ func TestAccKubernetesClusterNodePoolDataSource_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("node_count").HasValue("1"),
 				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
 				check.That(data.ResourceName).Key("tags.environment").HasValue("Staging"),
+				check.That(data.ResourceName).Key("custom_ca_trust_enabled").HasValue("false"),
 			),
 		},
 	})
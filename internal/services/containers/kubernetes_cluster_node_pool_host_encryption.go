@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// kubernetesClusterNodePoolHostEncryptionCustomizeDiff validates that the Virtual Machine size of a Node Pool supports
+// encryption at host when this is enabled, since otherwise this is only surfaced once the Node Pool is being provisioned.
+//
+// This matters in particular for Confidential Computing sizes (e.g. the DCasv5/ECasv5 families), only some of which
+// support encryption at host. This is a best-effort check - failures to look up the Virtual Machine size are logged
+// rather than returned, since these shouldn't block a plan.
+func kubernetesClusterNodePoolHostEncryptionCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	hostEncryptionField := "enable_host_encryption"
+	if features.FourPointOh() {
+		hostEncryptionField = "host_encryption_enabled"
+	}
+
+	if !d.Get(hostEncryptionField).(bool) {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges("vm_size", hostEncryptionField) {
+		return nil
+	}
+	if !d.NewValueKnown("kubernetes_cluster_id") || !d.NewValueKnown("vm_size") {
+		return nil
+	}
+
+	client := meta.(*clients.Client)
+
+	// the Node Pool is provisioned in the same Location as the Kubernetes Cluster
+	clusterId, err := commonids.ParseKubernetesClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return nil
+	}
+	cluster, err := client.Containers.KubernetesClustersClient.Get(ctx, *clusterId)
+	if err != nil {
+		log.Printf("[WARN] skipping the host encryption validation, retrieving %s: %+v", *clusterId, err)
+		return nil
+	}
+	if cluster.Model == nil {
+		return nil
+	}
+
+	vmSize := d.Get("vm_size").(string)
+	supported, err := vmSizeSupportsEncryptionAtHost(ctx, client.Compute.SkusClient, clusterId.SubscriptionId, location.Normalize(cluster.Model.Location), vmSize)
+	if err != nil {
+		log.Printf("[WARN] skipping the host encryption validation: %+v", err)
+		return nil
+	}
+	if supported != nil && !*supported {
+		return fmt.Errorf("`%s` cannot be enabled since the Virtual Machine size %q doesn't support encryption at host", hostEncryptionField, vmSize)
+	}
+
+	return nil
+}
+
+// vmSizeSupportsEncryptionAtHost returns whether the Virtual Machine size supports encryption at host, or nil when
+// this can't be determined
+func vmSizeSupportsEncryptionAtHost(ctx context.Context, client *skus.SkusClient, subscriptionId, loc, vmSize string) (*bool, error) {
+	opts := skus.DefaultResourceSkusListOperationOptions()
+	opts.Filter = pointer.To(fmt.Sprintf("location eq '%s'", loc))
+	resp, err := client.ResourceSkusListComplete(ctx, commonids.NewSubscriptionID(subscriptionId), opts)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Virtual Machine sizes in %q: %+v", loc, err)
+	}
+
+	for _, sku := range resp.Items {
+		if !strings.EqualFold(pointer.From(sku.ResourceType), "virtualMachines") || !strings.EqualFold(pointer.From(sku.Name), vmSize) {
+			continue
+		}
+		if sku.Capabilities == nil {
+			return nil, nil
+		}
+
+		for _, capability := range *sku.Capabilities {
+			if strings.EqualFold(pointer.From(capability.Name), "EncryptionAtHostSupported") {
+				return pointer.To(strings.EqualFold(pointer.From(capability.Value), "True")), nil
+			}
+		}
+		return nil, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/agentpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/quota"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// kubernetesClusterNodePoolQuotaCustomizeDiff validates there's enough quota available for a new, resized or scaled
+// Node Pool, when this has been opted into via the `quota_validation` feature
+func kubernetesClusterNodePoolQuotaCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client)
+	if !client.Features.QuotaValidation.Enabled {
+		return nil
+	}
+
+	autoScalingField := "enable_auto_scaling"
+	nodePublicIPField := "enable_node_public_ip"
+	if features.FourPointOh() {
+		autoScalingField = "auto_scaling_enabled"
+		nodePublicIPField = "node_public_ip_enabled"
+	}
+
+	if d.Id() != "" && !d.HasChanges("vm_size", "node_count", "min_count", autoScalingField, nodePublicIPField) {
+		return nil
+	}
+	if !d.NewValueKnown("kubernetes_cluster_id") || !d.NewValueKnown("vm_size") || !d.NewValueKnown("node_count") {
+		return nil
+	}
+
+	// the Node Pool is provisioned in the same Location as the Kubernetes Cluster
+	clusterId, err := commonids.ParseKubernetesClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return nil
+	}
+	cluster, err := client.Containers.KubernetesClustersClient.Get(ctx, *clusterId)
+	if err != nil {
+		log.Printf("[WARN] skipping the quota validation, retrieving %s: %+v", *clusterId, err)
+		return nil
+	}
+	if cluster.Model == nil {
+		return nil
+	}
+
+	oldVMSize, newVMSize := d.GetChange("vm_size")
+	oldNodeCount, newNodeCount := d.GetChange("node_count")
+	oldMinCount, newMinCount := d.GetChange("min_count")
+	oldAutoScaling, newAutoScaling := d.GetChange(autoScalingField)
+	oldNodePublicIP, newNodePublicIP := d.GetChange(nodePublicIPField)
+	spot := strings.EqualFold(d.Get("priority").(string), string(agentpools.ScaleSetPrioritySpot))
+
+	change := quota.Change{
+		Location: cluster.Model.Location,
+		After:    kubernetesClusterNodePoolQuotaAllocation(newVMSize.(string), newNodeCount.(int), newMinCount.(int), newAutoScaling.(bool), newNodePublicIP.(bool), spot),
+	}
+	if d.Id() != "" {
+		change.Before = kubernetesClusterNodePoolQuotaAllocation(oldVMSize.(string), oldNodeCount.(int), oldMinCount.(int), oldAutoScaling.(bool), oldNodePublicIP.(bool), spot)
+	}
+
+	return quota.ValidateHeadroom(ctx, client, change)
+}
+
+func kubernetesClusterNodePoolQuotaAllocation(vmSize string, nodeCount int, minCount int, autoScaling bool, nodePublicIP bool, spot bool) quota.Allocation {
+	// when auto-scaling a Node Pool starts with (at least) the minimum number of nodes
+	if autoScaling && nodeCount < minCount {
+		nodeCount = minCount
+	}
+
+	allocation := quota.Allocation{
+		VMSize:          vmSize,
+		VirtualMachines: int64(nodeCount),
+		Spot:            spot,
+	}
+	if nodePublicIP {
+		allocation.PublicIPAddresses = int64(nodeCount)
+	}
+	return allocation
+}
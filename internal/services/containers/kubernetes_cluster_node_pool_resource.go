@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/responsecache"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
@@ -83,6 +84,9 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("upgrade_settings.0.drain_timeout_in_minutes", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old != 0 && new == 0
 			}),
+			kubernetesClusterNodePoolQuotaCustomizeDiff,
+			kubernetesClusterNodePoolHostEncryptionCustomizeDiff,
+			validateKubernetesClusterNodePoolWorkloadRuntime,
 		),
 	}
 }
@@ -285,6 +289,7 @@ func resourceKubernetesClusterNodePoolSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: validation.StringInSlice([]string{
 				string(agentpools.OSSKUAzureLinux),
 				string(agentpools.OSSKUUbuntu),
+				string(agentpools.OSSKUWindowsAnnual),
 				string(agentpools.OSSKUWindowsTwoZeroOneNine),
 				string(agentpools.OSSKUWindowsTwoZeroTwoTwo),
 			}, false),
@@ -420,6 +425,7 @@ func resourceKubernetesClusterNodePoolSchema() map[string]*pluginsdk.Schema {
 			string(agentpools.OSSKUCBLMariner),
 			string(agentpools.OSSKUMariner),
 			string(agentpools.OSSKUUbuntu),
+			string(agentpools.OSSKUWindowsAnnual),
 			string(agentpools.OSSKUWindowsTwoZeroOneNine),
 			string(agentpools.OSSKUWindowsTwoZeroTwoTwo),
 		}, false)
@@ -569,10 +575,16 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 	}
 
 	if osSku := d.Get("os_sku").(string); osSku != "" {
+		if isWindowsOSSKU(osSku) != (osType == string(agentpools.OSTypeWindows)) {
+			return fmt.Errorf("`os_sku` %q cannot be used when `os_type` is set to %q", osSku, osType)
+		}
 		profile.OsSKU = pointer.To(agentpools.OSSKU(osSku))
 	}
 
 	if scaleDownMode := d.Get("scale_down_mode").(string); scaleDownMode != "" {
+		if scaleDownMode == string(agentpools.ScaleDownModeDeallocate) && priority == string(agentpools.ScaleSetPrioritySpot) {
+			return fmt.Errorf("`scale_down_mode` cannot be set to `Deallocate` when `priority` is set to `Spot`")
+		}
 		profile.ScaleDownMode = pointer.To(agentpools.ScaleDownMode(scaleDownMode))
 	}
 
@@ -721,6 +733,8 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 	}
 
 	err = poolsClient.CreateOrUpdateThenPoll(ctx, id, parameters)
+	// the Cluster includes its Node Pools, so any cached response is stale once a Node Pool has been (even partially) changed
+	meta.(*clients.Client).ResponseCache.Invalidate(clusterId.ID())
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -860,7 +874,12 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	if d.HasChange("os_sku") {
-		props.OsSKU = pointer.To(agentpools.OSSKU(d.Get("os_sku").(string)))
+		osSku := d.Get("os_sku").(string)
+		osType := d.Get("os_type").(string)
+		if isWindowsOSSKU(osSku) != (osType == string(agentpools.OSTypeWindows)) {
+			return fmt.Errorf("`os_sku` %q cannot be used when `os_type` is set to %q", osSku, osType)
+		}
+		props.OsSKU = pointer.To(agentpools.OSSKU(osSku))
 	}
 
 	if d.HasChange("upgrade_settings") {
@@ -870,6 +889,9 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 
 	if d.HasChange("scale_down_mode") {
 		mode := agentpools.ScaleDownMode(d.Get("scale_down_mode").(string))
+		if mode == agentpools.ScaleDownModeDeallocate && d.Get("priority").(string) == string(agentpools.ScaleSetPrioritySpot) {
+			return fmt.Errorf("`scale_down_mode` cannot be set to `Deallocate` when `priority` is set to `Spot`")
+		}
 		props.ScaleDownMode = &mode
 	}
 	if d.HasChange("workload_runtime") {
@@ -919,6 +941,7 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 	log.Printf("[DEBUG] Updating existing %s..", *id)
 	existing.Model.Properties = props
 	err = client.CreateOrUpdateThenPoll(ctx, *id, *existing.Model)
+	meta.(*clients.Client).ResponseCache.Invalidate(commonids.NewKubernetesClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID())
 	if err != nil {
 		return fmt.Errorf("updating Node Pool %s: %+v", *id, err)
 	}
@@ -940,8 +963,11 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 	}
 
 	// if the parent cluster doesn't exist then the node pool won't
+	// NOTE: each node pool reads the same cluster, so this uses the response cache (when enabled)
 	clusterId := commonids.NewKubernetesClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName)
-	cluster, err := clustersClient.Get(ctx, clusterId)
+	cluster, err := responsecache.Get(meta.(*clients.Client).ResponseCache, clusterId.ID(), func() (managedclusters.GetOperationResponse, error) {
+		return clustersClient.Get(ctx, clusterId)
+	})
 	if err != nil {
 		if response.WasNotFound(cluster.HttpResponse) {
 			log.Printf("[DEBUG] %s was not found - removing from state!", clusterId)
@@ -1146,6 +1172,7 @@ func resourceKubernetesClusterNodePoolDelete(d *pluginsdk.ResourceData, meta int
 	}
 
 	err = client.DeleteThenPoll(ctx, *id, agentpools.DefaultDeleteOperationOptions())
+	meta.(*clients.Client).ResponseCache.Invalidate(commonids.NewKubernetesClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID())
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
@@ -1641,6 +1668,15 @@ func flattenAgentPoolWindowsProfile(input *agentpools.AgentPoolWindowsProfile) [
 	}
 }
 
+// isWindowsOSSKU returns whether the specified OS SKU can only be used for Windows Node Pools
+func isWindowsOSSKU(input string) bool {
+	switch agentpools.OSSKU(input) {
+	case agentpools.OSSKUWindowsAnnual, agentpools.OSSKUWindowsTwoZeroOneNine, agentpools.OSSKUWindowsTwoZeroTwoTwo:
+		return true
+	}
+	return false
+}
+
 func expandAgentPoolNetworkProfile(input []interface{}) *agentpools.AgentPoolNetworkProfile {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -6,6 +6,7 @@ package containers_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -387,12 +388,7 @@ func TestAccKubernetesCluster_tags(t *testing.T) {
 			),
 		},
 		data.ImportStep(),
-		{
-			Config: r.tagsUpdatedConfig(data),
-			Check: acceptance.ComposeTestCheckFunc(
-				check.That(data.ResourceName).ExistsInAzure(r),
-			),
-		},
+		data.TagsOnlyUpdateStep(r.tagsUpdatedConfig, r),
 		data.ImportStep(),
 	})
 }
@@ -1056,6 +1052,18 @@ func TestAccKubernetesCluster_azureMonitorKubernetesMetrics(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCluster_nodeOsUpgradeChannelInvalidCombination(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.upgradeChannels(data, "node-image", "SecurityPatch"),
+			ExpectError: regexp.MustCompile("must be set to `NodeImage` when"),
+		},
+	})
+}
+
 func TestAccKubernetesCluster_nodeOsUpgradeChannel(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
 	r := KubernetesClusterResource{}
@@ -3635,6 +3643,44 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, nodeOsUpgradeChannel)
 }
 
+func (KubernetesClusterResource) upgradeChannels(data acceptance.TestData, autoUpgradeChannel, nodeOsUpgradeChannel string) string {
+	autoUpgradeChannelField := "automatic_upgrade_channel"
+	nodeOsUpgradeChannelField := "node_os_upgrade_channel"
+	if !features.FourPointOhBeta() {
+		autoUpgradeChannelField = "automatic_channel_upgrade"
+		nodeOsUpgradeChannelField = "node_os_channel_upgrade"
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+  %s = "%s"
+  %s = "%s"
+  default_node_pool {
+    name       = "default"
+    vm_size    = "Standard_DS2_v2"
+    node_count = 1
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, autoUpgradeChannelField, autoUpgradeChannel, nodeOsUpgradeChannelField, nodeOsUpgradeChannel)
+}
+
 func (KubernetesClusterResource) customCATrustCertificates(data acceptance.TestData, certsList []string) string {
 
 	certsString := ""
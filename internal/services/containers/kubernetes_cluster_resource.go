@@ -124,6 +124,8 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("custom_ca_trust_certificates_base64", func(ctx context.Context, old, new, meta interface{}) bool {
 				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
 			}),
+			validateKubernetesClusterUpgradeChannels,
+			validateKubernetesClusterDefaultNodePoolWorkloadRuntime,
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -556,6 +558,54 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"kube_config_exec": {
+				Type:      pluginsdk.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"cluster_ca_certificate": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"api_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"command": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"args": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+						"env": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"kube_config_format": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(managedclusters.PossibleValuesForFormat(), false),
+			},
+
 			"kube_config_raw": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -1958,16 +2008,6 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		nodeOsChannelUpgrade = d.Get("node_os_upgrade_channel").(string)
 	}
 
-	// this check needs to be separate and gated since node_os_channel_upgrade is a preview feature
-	if nodeOsChannelUpgrade != "" && autoChannelUpgrade != "" {
-		if autoChannelUpgrade == string(managedclusters.UpgradeChannelNodeNegativeimage) && nodeOsChannelUpgrade != string(managedclusters.NodeOSUpgradeChannelNodeImage) {
-			if !features.FourPointOhBeta() {
-				return fmt.Errorf("`node_os_channel_upgrade` cannot be set to a value other than `NodeImage` if `automatic_channel_upgrade` is set to `node-image`")
-			}
-			return fmt.Errorf("`node_os_upgrade_channel` cannot be set to a value other than `NodeImage` if `automatic_upgrade_channel` is set to `node-image`")
-		}
-	}
-
 	if autoChannelUpgrade != "" {
 		autoUpgradeProfile.UpgradeChannel = pointer.To(managedclusters.UpgradeChannel(autoChannelUpgrade))
 	} else {
@@ -2081,6 +2121,9 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
+	// any Node Pools read during this run need to see the new Cluster rather than a cached response
+	meta.(*clients.Client).ResponseCache.Invalidate(id.ID())
+
 	if maintenanceConfigRaw, ok := d.GetOk("maintenance_window"); ok {
 		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
 		parameters := maintenanceconfigurations.MaintenanceConfiguration{
@@ -2132,6 +2175,23 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	// the Cluster may have been partially updated even when this fails, so any cached response is removed regardless
+	defer meta.(*clients.Client).ResponseCache.Invalidate(id.ID())
+
+	// a full PUT of the Managed Cluster can take a significant amount of time and triggers a reconcile of the
+	// cluster, so when only the tags have changed we PATCH them instead
+	if d.HasChange("tags") && !d.HasChangeExcept("tags") {
+		log.Printf("[DEBUG] Updating the Tags for %s..", *id)
+		payload := managedclusters.TagsObject{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+		if err := clusterClient.UpdateTagsThenPoll(ctx, *id, payload); err != nil {
+			return fmt.Errorf("updating tags for %s: %+v", *id, err)
+		}
+
+		return resourceKubernetesClusterRead(d, meta)
+	}
+
 	d.Partial(true)
 
 	// we need to conditionally update the cluster
@@ -2497,12 +2557,6 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	if d.HasChange(nodeOsUpgradeChannel) {
 		updateCluster = true
-		if d.Get(autoUpgradeChannel).(string) == string(managedclusters.UpgradeChannelNodeNegativeimage) && d.Get(nodeOsUpgradeChannel).(string) != string(managedclusters.NodeOSUpgradeChannelNodeImage) {
-			if !features.FourPointOhBeta() {
-				return fmt.Errorf("`node_os_channel_upgrade` must be set to `NodeImage` if `automatic_channel_upgrade` is set to `node-image`")
-			}
-			return fmt.Errorf("`node_os_upgrade_channel` must be set to `NodeImage` if `automatic_upgrade_channel` is set to `node-image`")
-		}
 		if existing.Model.Properties.AutoUpgradeProfile == nil {
 			existing.Model.Properties.AutoUpgradeProfile = &managedclusters.ManagedClusterAutoUpgradeProfile{}
 		}
@@ -2561,10 +2615,14 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		workloadAutoscalerProfileRaw := d.Get("workload_autoscaler_profile").([]interface{})
 		workloadAutoscalerProfile := expandKubernetesClusterWorkloadAutoscalerProfile(workloadAutoscalerProfileRaw, d)
 		if workloadAutoscalerProfile == nil {
+			// removing the block disables both autoscalers, otherwise they'd be left running on the cluster
 			existing.Model.Properties.WorkloadAutoScalerProfile = &managedclusters.ManagedClusterWorkloadAutoScalerProfile{
 				Keda: &managedclusters.ManagedClusterWorkloadAutoScalerProfileKeda{
 					Enabled: false,
 				},
+				VerticalPodAutoscaler: &managedclusters.ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler{
+					Enabled: false,
+				},
 			}
 		} else {
 			existing.Model.Properties.WorkloadAutoScalerProfile = workloadAutoscalerProfile
@@ -2869,7 +2927,11 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	credentials, err := client.ListClusterUserCredentials(ctx, *id, managedclusters.ListClusterUserCredentialsOperationOptions{})
+	credentialsOptions := managedclusters.ListClusterUserCredentialsOperationOptions{}
+	if v := d.Get("kube_config_format").(string); v != "" {
+		credentialsOptions.Format = pointer.To(managedclusters.Format(v))
+	}
+	credentials, err := client.ListClusterUserCredentials(ctx, *id, credentialsOptions)
 	if err != nil {
 		return fmt.Errorf("retrieving User Credentials for %s: %+v", id, err)
 	}
@@ -3069,7 +3131,7 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `windows_profile`: %+v", err)
 			}
 
-			workloadAutoscalerProfile := flattenKubernetesClusterWorkloadAutoscalerProfile(props.WorkloadAutoScalerProfile)
+			workloadAutoscalerProfile := flattenKubernetesClusterWorkloadAutoscalerProfile(props.WorkloadAutoScalerProfile, d)
 			if err := d.Set("workload_autoscaler_profile", workloadAutoscalerProfile); err != nil {
 				return fmt.Errorf("setting `workload_autoscaler_profile`: %+v", err)
 			}
@@ -3155,6 +3217,9 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		if err := d.Set("kube_config", kubeConfig); err != nil {
 			return fmt.Errorf("setting `kube_config`: %+v", err)
 		}
+		if err := d.Set("kube_config_exec", flattenKubernetesClusterCredentialsExec(credentials.Model, "clusterUser")); err != nil {
+			return fmt.Errorf("setting `kube_config_exec`: %+v", err)
+		}
 
 		maintenanceConfigurationsClient := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
 		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, "default")
@@ -3193,6 +3258,8 @@ func resourceKubernetesClusterDelete(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	defer meta.(*clients.Client).ResponseCache.Invalidate(id.ID())
+
 	if _, ok := d.GetOk("maintenance_window"); ok {
 		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
 		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, "default")
@@ -3497,7 +3564,7 @@ func flattenKubernetesClusterWindowsProfile(profile *managedclusters.ManagedClus
 	}
 }
 
-func flattenKubernetesClusterWorkloadAutoscalerProfile(profile *managedclusters.ManagedClusterWorkloadAutoScalerProfile) []interface{} {
+func flattenKubernetesClusterWorkloadAutoscalerProfile(profile *managedclusters.ManagedClusterWorkloadAutoScalerProfile, d *pluginsdk.ResourceData) []interface{} {
 	// The API always returns an empty WorkloadAutoScalerProfile object even if none of these values have ever been set
 	if profile == nil || (profile.Keda == nil && profile.VerticalPodAutoscaler == nil) {
 		return []interface{}{}
@@ -3513,6 +3580,11 @@ func flattenKubernetesClusterWorkloadAutoscalerProfile(profile *managedclusters.
 		vpaEnabled = v.Enabled
 	}
 
+	// once the block has been removed both autoscalers are returned as disabled, which shouldn't surface as a diff
+	if _, ok := d.GetOk("workload_autoscaler_profile"); !ok && !kedaEnabled && !vpaEnabled {
+		return []interface{}{}
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"keda_enabled":                    kedaEnabled,
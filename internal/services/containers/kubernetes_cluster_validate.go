@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/agentpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -267,6 +268,64 @@ details can be found at https://aka.ms/version-skew-policy.
 `, desiredNodePoolVersion, nodePoolName, clusterName, resourceGroup, clusterVersionDetails, versionsList)
 }
 
+// validateKubernetesClusterUpgradeChannels checks the combination of the automatic upgrade channel and the node OS
+// upgrade channel at plan time, since the API only rejects an invalid combination once the cluster is being provisioned
+func validateKubernetesClusterUpgradeChannels(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	autoUpgradeChannel := "automatic_upgrade_channel"
+	nodeOsUpgradeChannel := "node_os_upgrade_channel"
+	if !features.FourPointOhBeta() {
+		autoUpgradeChannel = "automatic_channel_upgrade"
+		nodeOsUpgradeChannel = "node_os_channel_upgrade"
+	}
+
+	autoChannel := d.Get(autoUpgradeChannel).(string)
+	nodeOsChannel := d.Get(nodeOsUpgradeChannel).(string)
+	if nodeOsChannel == "" || autoChannel == "" {
+		return nil
+	}
+
+	// the `node-image` channel upgrades the node images itself, so the node OS channel can't use a different mechanism
+	if autoChannel == string(managedclusters.UpgradeChannelNodeNegativeimage) && nodeOsChannel != string(managedclusters.NodeOSUpgradeChannelNodeImage) {
+		return fmt.Errorf("`%s` must be set to `%s` when `%s` is set to `%s`", nodeOsUpgradeChannel, managedclusters.NodeOSUpgradeChannelNodeImage, autoUpgradeChannel, managedclusters.UpgradeChannelNodeNegativeimage)
+	}
+
+	return nil
+}
+
+// validateKubernetesClusterDefaultNodePoolWorkloadRuntime checks the OS requirements of Pod Sandboxing for the Default Node Pool at plan time
+func validateKubernetesClusterDefaultNodePoolWorkloadRuntime(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("default_node_pool.0.os_sku") {
+		return nil
+	}
+	return validateNodePoolWorkloadRuntime(d.Get("default_node_pool.0.workload_runtime").(string), string(agentpools.OSTypeLinux), d.Get("default_node_pool.0.os_sku").(string))
+}
+
+// validateKubernetesClusterNodePoolWorkloadRuntime checks the OS requirements of Pod Sandboxing for a Node Pool at plan time
+func validateKubernetesClusterNodePoolWorkloadRuntime(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("os_sku") {
+		return nil
+	}
+	return validateNodePoolWorkloadRuntime(d.Get("workload_runtime").(string), d.Get("os_type").(string), d.Get("os_sku").(string))
+}
+
+// validateNodePoolWorkloadRuntime checks that Pod Sandboxing (which runs each Pod in a Kata Container) is only used on
+// Azure Linux nodes, which is the only OS that supports the nested virtualization this requires
+func validateNodePoolWorkloadRuntime(workloadRuntime, osType, osSku string) error {
+	if workloadRuntime != string(agentpools.WorkloadRuntimeKataMshvVMIsolation) {
+		return nil
+	}
+
+	if osType != "" && osType != string(agentpools.OSTypeLinux) {
+		return fmt.Errorf("`workload_runtime` can only be set to `%s` when `os_type` is set to `%s`", agentpools.WorkloadRuntimeKataMshvVMIsolation, agentpools.OSTypeLinux)
+	}
+
+	switch agentpools.OSSKU(osSku) {
+	case agentpools.OSSKUAzureLinux, agentpools.OSSKUCBLMariner, agentpools.OSSKUMariner:
+		return nil
+	}
+	return fmt.Errorf("`workload_runtime` can only be set to `%s` when `os_sku` is set to `%s`", agentpools.WorkloadRuntimeKataMshvVMIsolation, agentpools.OSSKUAzureLinux)
+}
+
 func validateNodePoolSupportsVersion(ctx context.Context, client *client.Client, currentNodePoolVersion string, defaultNodePoolId agentpools.AgentPoolId, desiredNodePoolVersion string) error {
 	// confirm the version being used is >= the version of the control plane
 	clusterId := commonids.NewKubernetesClusterID(defaultNodePoolId.SubscriptionId, defaultNodePoolId.ResourceGroupName, defaultNodePoolId.ManagedClusterName)
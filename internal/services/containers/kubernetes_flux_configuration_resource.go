@@ -12,7 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-11-01/fluxconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2023-05-01/fluxconfiguration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -88,14 +88,27 @@ type GitRepositoryDefinitionModel struct {
 }
 
 type KustomizationDefinitionModel struct {
-	Name                   string   `tfschema:"name"`
-	Path                   string   `tfschema:"path"`
-	TimeoutInSeconds       int64    `tfschema:"timeout_in_seconds"`
-	SyncIntervalInSeconds  int64    `tfschema:"sync_interval_in_seconds"`
-	RetryIntervalInSeconds int64    `tfschema:"retry_interval_in_seconds"`
-	Force                  bool     `tfschema:"recreating_enabled"`
-	Prune                  bool     `tfschema:"garbage_collection_enabled"`
-	DependsOn              []string `tfschema:"depends_on"`
+	Name                   string                     `tfschema:"name"`
+	Path                   string                     `tfschema:"path"`
+	TimeoutInSeconds       int64                      `tfschema:"timeout_in_seconds"`
+	SyncIntervalInSeconds  int64                      `tfschema:"sync_interval_in_seconds"`
+	RetryIntervalInSeconds int64                      `tfschema:"retry_interval_in_seconds"`
+	Force                  bool                       `tfschema:"recreating_enabled"`
+	Prune                  bool                       `tfschema:"garbage_collection_enabled"`
+	DependsOn              []string                   `tfschema:"depends_on"`
+	PostBuild              []PostBuildDefinitionModel `tfschema:"post_build"`
+	Wait                   bool                       `tfschema:"wait"`
+}
+
+type PostBuildDefinitionModel struct {
+	Substitute     map[string]string               `tfschema:"substitute"`
+	SubstituteFrom []SubstituteFromDefinitionModel `tfschema:"substitute_from"`
+}
+
+type SubstituteFromDefinitionModel struct {
+	Kind     string `tfschema:"kind"`
+	Name     string `tfschema:"name"`
+	Optional bool   `tfschema:"optional"`
 }
 
 type ManagedIdentityDefinitionModel struct {
@@ -220,6 +233,58 @@ func (r KubernetesFluxConfigurationResource) Arguments() map[string]*pluginsdk.S
 							Type: pluginsdk.TypeString,
 						},
 					},
+
+					"post_build": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"substitute": {
+									Type:     pluginsdk.TypeMap,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+
+								"substitute_from": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"kind": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+												ValidateFunc: validation.StringInSlice([]string{
+													"ConfigMap",
+													"Secret",
+												}, false),
+											},
+
+											"name": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"optional": {
+												Type:     pluginsdk.TypeBool,
+												Optional: true,
+												Default:  false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+
+					"wait": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
 				},
 			},
 		},
@@ -847,12 +912,17 @@ func expandKustomizationDefinitionModel(inputList []KustomizationDefinitionModel
 			RetryIntervalInSeconds: &input.RetryIntervalInSeconds,
 			SyncIntervalInSeconds:  &input.SyncIntervalInSeconds,
 			TimeoutInSeconds:       &input.TimeoutInSeconds,
+			Wait:                   &input.Wait,
 		}
 
 		if input.Path != "" {
 			output.Path = utils.String(input.Path)
 		}
 
+		if len(input.PostBuild) > 0 {
+			output.PostBuild = expandPostBuildDefinitionModel(input.PostBuild)
+		}
+
 		outputList[input.Name] = output
 	}
 
@@ -1063,6 +1133,8 @@ func flattenKustomizationDefinitionModel(inputList *map[string]fluxconfiguration
 			RetryIntervalInSeconds: pointer.From(input.RetryIntervalInSeconds),
 			SyncIntervalInSeconds:  pointer.From(input.SyncIntervalInSeconds),
 			TimeoutInSeconds:       pointer.From(input.TimeoutInSeconds),
+			PostBuild:              flattenPostBuildDefinitionModel(input.PostBuild),
+			Wait:                   pointer.From(input.Wait),
 		}
 
 		outputList = append(outputList, output)
@@ -1071,6 +1143,59 @@ func flattenKustomizationDefinitionModel(inputList *map[string]fluxconfiguration
 	return outputList
 }
 
+func expandPostBuildDefinitionModel(inputList []PostBuildDefinitionModel) *fluxconfiguration.PostBuildDefinition {
+	if len(inputList) == 0 {
+		return nil
+	}
+
+	input := inputList[0]
+	output := fluxconfiguration.PostBuildDefinition{
+		Substitute: pointer.To(input.Substitute),
+	}
+
+	substituteFrom := make([]fluxconfiguration.SubstituteFromDefinition, 0)
+	for _, v := range input.SubstituteFrom {
+		substituteFrom = append(substituteFrom, fluxconfiguration.SubstituteFromDefinition{
+			Kind:     pointer.To(v.Kind),
+			Name:     pointer.To(v.Name),
+			Optional: pointer.To(v.Optional),
+		})
+	}
+	output.SubstituteFrom = &substituteFrom
+
+	return &output
+}
+
+func flattenPostBuildDefinitionModel(input *fluxconfiguration.PostBuildDefinition) []PostBuildDefinitionModel {
+	outputList := make([]PostBuildDefinitionModel, 0)
+	if input == nil {
+		return outputList
+	}
+
+	output := PostBuildDefinitionModel{
+		Substitute: pointer.From(input.Substitute),
+	}
+
+	substituteFrom := make([]SubstituteFromDefinitionModel, 0)
+	if input.SubstituteFrom != nil {
+		for _, v := range *input.SubstituteFrom {
+			substituteFrom = append(substituteFrom, SubstituteFromDefinitionModel{
+				Kind:     pointer.From(v.Kind),
+				Name:     pointer.From(v.Name),
+				Optional: pointer.From(v.Optional),
+			})
+		}
+	}
+	output.SubstituteFrom = substituteFrom
+
+	// an empty `postBuild` block is treated as not being configured
+	if len(output.Substitute) == 0 && len(output.SubstituteFrom) == 0 {
+		return outputList
+	}
+
+	return append(outputList, output)
+}
+
 func flattenServicePrincipalDefinitionModel(input *fluxconfiguration.ServicePrincipalDefinition, servicePrincipal []ServicePrincipalDefinitionModel) []ServicePrincipalDefinitionModel {
 	outputList := make([]ServicePrincipalDefinitionModel, 0)
 	if input == nil {
@@ -11,7 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2021-10-01/exports"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2023-07-01-preview/exports"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -107,6 +107,34 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 				},
 			},
 		},
+
+		"file_format": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(exports.FormatTypeCsv),
+			ValidateFunc: validation.StringInSlice(exports.PossibleValuesForFormatType(), false),
+		},
+
+		// the API applies its own defaults when these aren't specified
+		"compression_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(exports.PossibleValuesForCompressionModeType(), false),
+		},
+
+		"data_overwrite_behavior": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(exports.PossibleValuesForDataOverwriteBehaviorType(), false),
+		},
+
+		"partition_data_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 	}
 
 	for k, v := range fields {
@@ -195,6 +223,15 @@ func (br costManagementExportBaseResource) readFunc(scopeFieldName string) sdk.R
 					if err := metadata.ResourceData.Set("export_data_options", flattenExportDefinition(&props.Definition)); err != nil {
 						return fmt.Errorf("setting `export_data_options`: %+v", err)
 					}
+
+					fileFormat := string(exports.FormatTypeCsv)
+					if v := props.Format; v != nil {
+						fileFormat = string(*v)
+					}
+					metadata.ResourceData.Set("file_format", fileFormat)
+					metadata.ResourceData.Set("compression_mode", string(pointer.From(props.CompressionMode)))
+					metadata.ResourceData.Set("data_overwrite_behavior", string(pointer.From(props.DataOverwriteBehavior)))
+					metadata.ResourceData.Set("partition_data_enabled", pointer.From(props.PartitionData))
 				}
 			}
 
@@ -267,7 +304,7 @@ func createOrUpdateCostManagementExport(ctx context.Context, client *exports.Exp
 		return fmt.Errorf("expanding `export_data_storage_location`: %+v", err)
 	}
 
-	format := exports.FormatTypeCsv
+	format := exports.FormatType(metadata.ResourceData.Get("file_format").(string))
 	recurrenceType := exports.RecurrenceType(metadata.ResourceData.Get("recurrence_type").(string))
 	props := exports.Export{
 		ETag: etag,
@@ -280,12 +317,21 @@ func createOrUpdateCostManagementExport(ctx context.Context, client *exports.Exp
 				},
 				Status: &status,
 			},
-			DeliveryInfo: *deliveryInfo,
-			Format:       &format,
-			Definition:   *expandExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
+			DeliveryInfo:  *deliveryInfo,
+			Format:        &format,
+			Definition:    *expandExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
+			PartitionData: pointer.To(metadata.ResourceData.Get("partition_data_enabled").(bool)),
 		},
 	}
 
+	if v := metadata.ResourceData.Get("compression_mode").(string); v != "" {
+		props.Properties.CompressionMode = pointer.To(exports.CompressionModeType(v))
+	}
+
+	if v := metadata.ResourceData.Get("data_overwrite_behavior").(string); v != "" {
+		props.Properties.DataOverwriteBehavior = pointer.To(exports.DataOverwriteBehaviorType(v))
+	}
+
 	_, err = client.CreateOrUpdate(ctx, id, props)
 
 	return err
@@ -9,7 +9,7 @@ import (
 	"testing"
 	"time"
 
-	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2021-10-01/exports"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2023-07-01-preview/exports"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -180,6 +180,11 @@ resource "azurerm_resource_group_cost_management_export" "test" {
     type       = "Usage"
     time_frame = "WeekToDate"
   }
+
+  file_format             = "Csv"
+  compression_mode        = "gzip"
+  data_overwrite_behavior = "OverwritePreviousReport"
+  partition_data_enabled  = true
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, data.RandomInteger, start, end)
 }
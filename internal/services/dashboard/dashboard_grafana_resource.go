@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -40,6 +41,7 @@ type DashboardGrafanaModel struct {
 	GrafanaVersion                    string                                            `tfschema:"grafana_version"`
 	GrafanaMajorVersion               string                                            `tfschema:"grafana_major_version"`
 	OutboundIPs                       []string                                          `tfschema:"outbound_ip"`
+	PluginIds                         []string                                          `tfschema:"plugin_ids"`
 }
 
 type AzureMonitorWorkspaceIntegrationModel struct {
@@ -181,6 +183,15 @@ func (r DashboardGrafanaResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"identity": commonschema.SystemOrUserAssignedIdentityOptionalForceNew(),
 
+		"plugin_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
 		"public_network_access_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -307,6 +318,7 @@ func (r DashboardGrafanaResource) Create() sdk.ResourceFunc {
 					DeterministicOutboundIP:           &deterministicOutboundIP,
 					GrafanaIntegrations:               expandGrafanaIntegrationsModel(model.AzureMonitorWorkspaceIntegrations),
 					GrafanaMajorVersion:               &model.GrafanaMajorVersion,
+					GrafanaPlugins:                    expandGrafanaPluginsModel(model.PluginIds),
 					PublicNetworkAccess:               &publicNetworkAccess,
 					ZoneRedundancy:                    &zoneRedundancy,
 				},
@@ -378,6 +390,13 @@ func (r DashboardGrafanaResource) Update() sdk.ResourceFunc {
 				properties.Properties.GrafanaIntegrations = expandGrafanaIntegrationsModel(model.AzureMonitorWorkspaceIntegrations)
 			}
 
+			if metadata.ResourceData.HasChange("plugin_ids") {
+				properties.Properties.GrafanaPlugins = pointer.To(make(map[string]grafanaresource.GrafanaPlugin))
+				if plugins := expandGrafanaPluginsModel(model.PluginIds); plugins != nil {
+					properties.Properties.GrafanaPlugins = plugins
+				}
+			}
+
 			if metadata.ResourceData.HasChange("public_network_access_enabled") {
 				publicNetworkAccess := grafanaresource.PublicNetworkAccessDisabled
 				if model.PublicNetworkAccessEnabled {
@@ -486,6 +505,8 @@ func (r DashboardGrafanaResource) Read() sdk.ResourceFunc {
 					state.OutboundIPs = *properties.OutboundIPs
 				}
 
+				state.PluginIds = flattenGrafanaPluginsModel(properties.GrafanaPlugins)
+
 				if properties.PublicNetworkAccess != nil {
 					if *properties.PublicNetworkAccess == grafanaresource.PublicNetworkAccessEnabled {
 						state.PublicNetworkAccessEnabled = true
@@ -583,6 +604,19 @@ func expandAzureMonitorWorkspaceIntegrationModelArray(inputList []AzureMonitorWo
 	return &outputList
 }
 
+func expandGrafanaPluginsModel(input []string) *map[string]grafanaresource.GrafanaPlugin {
+	if len(input) == 0 {
+		return nil
+	}
+
+	plugins := make(map[string]grafanaresource.GrafanaPlugin)
+	for _, v := range input {
+		plugins[v] = grafanaresource.GrafanaPlugin{}
+	}
+
+	return &plugins
+}
+
 func expandLegacySystemAndUserAssignedMap(input []interface{}) *identity.LegacySystemAndUserAssignedMap {
 	identityValue, err := identity.ExpandSystemOrUserAssignedMap(input)
 	if err != nil {
@@ -659,6 +693,20 @@ func flattenAzureMonitorWorkspaceIntegrationModelArray(inputList *[]grafanaresou
 	return outputList
 }
 
+func flattenGrafanaPluginsModel(input *map[string]grafanaresource.GrafanaPlugin) []string {
+	outputList := make([]string, 0)
+	if input == nil {
+		return outputList
+	}
+
+	for k := range *input {
+		outputList = append(outputList, k)
+	}
+	sort.Strings(outputList)
+
+	return outputList
+}
+
 func flattenLegacySystemAndUserAssignedMap(input *identity.LegacySystemAndUserAssignedMap) *[]interface{} {
 	if input == nil {
 		return &[]interface{}{}
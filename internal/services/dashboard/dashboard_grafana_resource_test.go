@@ -194,6 +194,7 @@ resource "azurerm_dashboard_grafana" "test" {
   deterministic_outbound_ip_enabled = true
   public_network_access_enabled     = false
   grafana_major_version             = "10"
+  plugin_ids                        = ["grafana-piechart-panel"]
   smtp {
     enabled          = true
     host             = "localhost:25"
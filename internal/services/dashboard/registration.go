@@ -52,5 +52,6 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		DashboardGrafanaResource{},
+		DashboardGrafanaManagedPrivateEndpointResource{},
 	}
 }
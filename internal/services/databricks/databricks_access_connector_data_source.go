@@ -42,7 +42,7 @@ func (DatabricksAccessConnectorDataSource) Arguments() map[string]*pluginsdk.Sch
 func (DatabricksAccessConnectorDataSource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"location": commonschema.LocationComputed(),
-		"identity": commonschema.SystemOrUserAssignedIdentityComputed(),
+		"identity": commonschema.SystemAssignedUserAssignedIdentityComputed(),
 		"tags":     commonschema.TagsDataSource(),
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-10-01-preview/accessconnector"
@@ -141,6 +142,14 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				RequiredWith: []string{"default_storage_firewall_enabled"},
 			},
 
+			"access_connector_user_assigned_identity_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+				RequiredWith: []string{"access_connector_id"},
+			},
+
 			"network_security_group_rules_required": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -627,20 +636,14 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		}
 
 		if accessConnector.Model.Identity != nil {
-
-			accIdentityId := ""
-			for raw := range accessConnector.Model.Identity.IdentityIds {
-				id, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
-				if err != nil {
-					return fmt.Errorf("parsing %q as a User Assigned Identity ID: %+v", raw, err)
-				}
-				accIdentityId = id.ID()
-				break
+			identityType, userAssignedIdentityId, err := expandWorkspaceAccessConnectorIdentity(accessConnector.Model.Identity, d.Get("access_connector_user_assigned_identity_id").(string))
+			if err != nil {
+				return fmt.Errorf("determining the identity of Access Connector %s to use: %+v", accessConnectorId.AccessConnectorName, err)
 			}
 
 			accessConnectorProperties.Id = *accessConnector.Model.Id
-			accessConnectorProperties.IdentityType = workspaces.IdentityType(accessConnector.Model.Identity.Type)
-			accessConnectorProperties.UserAssignedIdentityId = &accIdentityId
+			accessConnectorProperties.IdentityType = identityType
+			accessConnectorProperties.UserAssignedIdentityId = &userAssignedIdentityId
 		}
 
 		workspace.Properties.AccessConnector = &accessConnectorProperties
@@ -754,6 +757,16 @@ func resourceDatabricksWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("default_storage_firewall_enabled", *defaultStorageFirewall != workspaces.DefaultStorageFirewallDisabled)
 			if model.Properties.AccessConnector != nil {
 				d.Set("access_connector_id", model.Properties.AccessConnector.Id)
+
+				userAssignedIdentityId := ""
+				if model.Properties.AccessConnector.IdentityType == workspaces.IdentityTypeUserAssigned && model.Properties.AccessConnector.UserAssignedIdentityId != nil {
+					id, err := commonids.ParseUserAssignedIdentityIDInsensitively(*model.Properties.AccessConnector.UserAssignedIdentityId)
+					if err != nil {
+						return err
+					}
+					userAssignedIdentityId = id.ID()
+				}
+				d.Set("access_connector_user_assigned_identity_id", userAssignedIdentityId)
 			}
 		}
 
@@ -977,6 +990,45 @@ func flattenWorkspaceCustomParameters(input *workspaces.WorkspaceCustomParameter
 	return []interface{}{parameters}, backendAddressPoolId
 }
 
+func expandWorkspaceAccessConnectorIdentity(input *identity.LegacySystemAndUserAssignedMap, userAssignedIdentityId string) (workspaces.IdentityType, string, error) {
+	if userAssignedIdentityId != "" {
+		requested, err := commonids.ParseUserAssignedIdentityIDInsensitively(userAssignedIdentityId)
+		if err != nil {
+			return "", "", err
+		}
+
+		for raw := range input.IdentityIds {
+			id, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
+			if err != nil {
+				return "", "", fmt.Errorf("parsing %q as a User Assigned Identity ID: %+v", raw, err)
+			}
+			if strings.EqualFold(id.ID(), requested.ID()) {
+				return workspaces.IdentityTypeUserAssigned, id.ID(), nil
+			}
+		}
+
+		return "", "", fmt.Errorf("the User Assigned Identity %q specified in `access_connector_user_assigned_identity_id` is not assigned to the Access Connector", userAssignedIdentityId)
+	}
+
+	if input.Type == identity.TypeSystemAssigned || input.Type == identity.TypeSystemAssignedUserAssigned {
+		return workspaces.IdentityTypeSystemAssigned, "", nil
+	}
+
+	if len(input.IdentityIds) > 1 {
+		return "", "", fmt.Errorf("`access_connector_user_assigned_identity_id` must be specified when the Access Connector has more than one User Assigned Identity")
+	}
+
+	for raw := range input.IdentityIds {
+		id, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
+		if err != nil {
+			return "", "", fmt.Errorf("parsing %q as a User Assigned Identity ID: %+v", raw, err)
+		}
+		return workspaces.IdentityTypeUserAssigned, id.ID(), nil
+	}
+
+	return workspaces.IdentityType(input.Type), "", nil
+}
+
 func expandWorkspaceCustomParameters(input []interface{}, customerManagedKeyEnabled, infrastructureEncryptionEnabled bool, backendAddressPoolName, loadBalancerId string) (workspaceCustomParameters *workspaces.WorkspaceCustomParameters, publicSubnetAssociation, privateSubnetAssociation *string) {
 	if len(input) == 0 || input[0] == nil {
 		// This will be hit when there are no custom params set but we still
@@ -4,12 +4,15 @@
 package datafactory
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -27,6 +30,26 @@ const (
 	TypeBasicDatasetCompressionTypeZipDeflate string = "ZipDeflate"
 )
 
+// ensureDataFactoryIsInLiveMode returns an error when the `live_mode_only` feature is enabled and the Data Factory
+// is configured with a git repository, since changes made by Terraform only apply to live mode and are overwritten
+// the next time the collaboration branch is published
+func ensureDataFactoryIsInLiveMode(ctx context.Context, client *clients.Client, id factories.FactoryId) error {
+	if !client.Features.DataFactory.LiveModeOnly {
+		return nil
+	}
+
+	resp, err := client.DataFactory.Factories.Get(ctx, id, factories.DefaultGetOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.RepoConfiguration != nil {
+		return fmt.Errorf("%s is configured with a git repository, changes made in live mode will be overwritten the next time the collaboration branch is published. Either remove the git repository configuration from the Data Factory, or disable `live_mode_only` within the `data_factory` block of the provider `features` block", id)
+	}
+
+	return nil
+}
+
 func expandDataFactoryLinkedServiceIntegrationRuntime(integrationRuntimeName string) *datafactory.IntegrationRuntimeReference {
 	typeString := "IntegrationRuntimeReference"
 
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/credentials"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -147,6 +148,10 @@ func (r DataFactoryCredentialServicePrincipalResource) Create() sdk.ResourceFunc
 				return err
 			}
 
+			if err := ensureDataFactoryIsInLiveMode(ctx, metadata.Client, factories.NewFactoryID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName)); err != nil {
+				return err
+			}
+
 			id := credentials.NewCredentialID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, data.Name)
 			existing, err := client.CredentialOperationsGet(ctx, id, credentials.DefaultCredentialOperationsGetOperationOptions())
 			if err != nil && !response.WasNotFound(existing.HttpResponse) {
@@ -245,6 +250,10 @@ func (r DataFactoryCredentialServicePrincipalResource) Update() sdk.ResourceFunc
 				return err
 			}
 
+			if err := ensureDataFactoryIsInLiveMode(ctx, metadata.Client, factories.NewFactoryID(id.SubscriptionId, id.ResourceGroupName, id.FactoryName)); err != nil {
+				return err
+			}
+
 			var data DataFactoryCredentialServicePrincipalResourceSchema
 			if err := metadata.Decode(&data); err != nil {
 				return fmt.Errorf("decoding: %+v", err)
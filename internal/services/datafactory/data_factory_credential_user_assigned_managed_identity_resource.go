@@ -151,6 +151,10 @@ func (r DataFactoryCredentialUserAssignedManagedIdentityResource) Create() sdk.R
 				return err
 			}
 
+			if err := ensureDataFactoryIsInLiveMode(ctx, metadata.Client, factories.NewFactoryID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName)); err != nil {
+				return err
+			}
+
 			id := credentials.NewCredentialID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, data.Name)
 			existing, err := client.CredentialOperationsGet(ctx, id, credentials.DefaultCredentialOperationsGetOperationOptions())
 			if err != nil && !response.WasNotFound(existing.HttpResponse) {
@@ -201,6 +205,10 @@ func (r DataFactoryCredentialUserAssignedManagedIdentityResource) Update() sdk.R
 				return err
 			}
 
+			if err := ensureDataFactoryIsInLiveMode(ctx, metadata.Client, factories.NewFactoryID(id.SubscriptionId, id.ResourceGroupName, id.FactoryName)); err != nil {
+				return err
+			}
+
 			var data DataFactoryCredentialUserAssignedManagedIdentityResourceSchema
 			if err := metadata.Decode(&data); err != nil {
 				return fmt.Errorf("decoding: %+v", err)
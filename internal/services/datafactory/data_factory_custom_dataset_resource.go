@@ -147,6 +147,10 @@ func resourceDataFactoryCustomDatasetCreateUpdate(d *pluginsdk.ResourceData, met
 		return err
 	}
 
+	if err := ensureDataFactoryIsInLiveMode(ctx, meta.(*clients.Client), *dataFactoryId); err != nil {
+		return err
+	}
+
 	id := parse.NewDataSetID(subscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, d.Get("name").(string))
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
@@ -108,6 +108,10 @@ func resourceDataFactoryDataFlowCreateUpdate(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
+	if err := ensureDataFactoryIsInLiveMode(ctx, meta.(*clients.Client), *dataFactoryId); err != nil {
+		return err
+	}
+
 	id := parse.NewDataFlowID(subscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, d.Get("name").(string))
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
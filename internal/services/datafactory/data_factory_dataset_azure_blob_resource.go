@@ -175,6 +175,10 @@ func resourceDataFactoryDatasetAzureBlobCreateUpdate(d *pluginsdk.ResourceData,
 		return err
 	}
 
+	if err := ensureDataFactoryIsInLiveMode(ctx, meta.(*clients.Client), *dataFactoryId); err != nil {
+		return err
+	}
+
 	id := parse.NewDataSetID(subscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, d.Get("name").(string))
 
 	if d.IsNewResource() {
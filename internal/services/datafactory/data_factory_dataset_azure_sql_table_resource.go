@@ -178,6 +178,10 @@ func (r DataFactoryDatasetAzureSQLTableResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if err := ensureDataFactoryIsInLiveMode(ctx, metadata.Client, *dataFactoryId); err != nil {
+				return err
+			}
+
 			id := parse.NewDataSetID(subscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, data.Name)
 
 			existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
@@ -265,6 +269,10 @@ func (r DataFactoryDatasetAzureSQLTableResource) Update() sdk.ResourceFunc {
 			if err != nil {
 				return err
 			}
+
+			if err := ensureDataFactoryIsInLiveMode(ctx, metadata.Client, factories.NewFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName)); err != nil {
+				return err
+			}
 			var data DataFactoryDatasetAzureSQLTableResourceSchema
 			if err := metadata.Decode(&data); err != nil {
 				return fmt.Errorf("decoding: %+v", err)
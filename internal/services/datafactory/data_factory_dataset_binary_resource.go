@@ -249,6 +249,10 @@ func resourceDataFactoryDatasetBinaryCreateUpdate(d *pluginsdk.ResourceData, met
 		return err
 	}
 
+	if err := ensureDataFactoryIsInLiveMode(ctx, meta.(*clients.Client), *dataFactoryId); err != nil {
+		return err
+	}
+
 	id := parse.NewDataSetID(subscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, d.Get("name").(string))
 
 	if d.IsNewResource() {
@@ -157,6 +157,10 @@ func resourceDataFactoryDatasetCosmosDbSQLAPICreateUpdate(d *pluginsdk.ResourceD
 		return err
 	}
 
+	if err := ensureDataFactoryIsInLiveMode(ctx, meta.(*clients.Client), *dataFactoryId); err != nil {
+		return err
+	}
+
 	id := parse.NewDataSetID(subscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, d.Get("name").(string))
 
 	if d.IsNewResource() {
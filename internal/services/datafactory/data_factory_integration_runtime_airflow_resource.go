@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datafactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
+)
+
+// the Data Factory SDK doesn't (yet) contain a model for the Airflow Integration Runtime, so the
+// type properties are defined here and sent through the generic Integration Runtime model
+const dataFactoryIntegrationRuntimeTypeAirflow = "Airflow"
+
+type dataFactoryAirflowIntegrationRuntimeTypeProperties struct {
+	ComputeProperties *dataFactoryAirflowComputeProperties `json:"computeProperties,omitempty"`
+	AirflowProperties *dataFactoryAirflowProperties        `json:"airflowProperties,omitempty"`
+}
+
+type dataFactoryAirflowComputeProperties struct {
+	Location    *string `json:"location,omitempty"`
+	ComputeSize *string `json:"computeSize,omitempty"`
+	ExtraNodes  *int64  `json:"extraNodes,omitempty"`
+}
+
+type dataFactoryAirflowProperties struct {
+	AirflowVersion       *string            `json:"airflowVersion,omitempty"`
+	EnableAADIntegration *bool              `json:"enableAADIntegration,omitempty"`
+	EnvironmentVariables *map[string]string `json:"environmentVariables,omitempty"`
+	Password             *string            `json:"password,omitempty"`
+	// NOTE: the misspelling matches the property name used by the API
+	Requirements *[]string `json:"airflowRequiremments,omitempty"`
+	UserName     *string   `json:"userName,omitempty"`
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate,
+		Read:   resourceDataFactoryIntegrationRuntimeAirflowRead,
+		Update: resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate,
+		Delete: resourceDataFactoryIntegrationRuntimeAirflowDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IntegrationRuntimeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^([a-zA-Z0-9](-|-?[a-zA-Z0-9]+)+[a-zA-Z0-9])$`),
+					`Invalid name for Airflow Integration Runtime: minimum 3 characters, must start and end with a number or a letter, may only consist of letters, numbers and dashes and no consecutive dashes.`,
+				),
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: factories.ValidateFactoryID,
+			},
+
+			"location": commonschema.Location(),
+
+			"airflow_version": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+\.\d+\.\d+$`), "`airflow_version` must be in the format `major.minor.patch`, e.g. `2.6.3`"),
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"environment_size": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "Small",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Small",
+					"Large",
+				}, false),
+			},
+
+			"extra_nodes": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"requirements": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"environment_variables": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"web_access": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"azure_active_directory_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"username": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"password": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	subscriptionId := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient.SubscriptionID
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := factories.ParseFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := ensureDataFactoryIsInLiveMode(ctx, meta.(*clients.Client), *dataFactoryId); err != nil {
+		return err
+	}
+
+	id := parse.NewIntegrationRuntimeID(subscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_data_factory_integration_runtime_airflow", id.ID())
+		}
+	}
+
+	airflowProperties, err := expandDataFactoryIntegrationRuntimeAirflowProperties(d)
+	if err != nil {
+		return err
+	}
+
+	typeProperties := dataFactoryAirflowIntegrationRuntimeTypeProperties{
+		ComputeProperties: &dataFactoryAirflowComputeProperties{
+			Location:    pointer.To(location.Normalize(d.Get("location").(string))),
+			ComputeSize: pointer.To(d.Get("environment_size").(string)),
+			ExtraNodes:  pointer.To(int64(d.Get("extra_nodes").(int))),
+		},
+		AirflowProperties: airflowProperties,
+	}
+
+	integrationRuntime := datafactory.IntegrationRuntimeResource{
+		Name: &id.Name,
+		Properties: datafactory.IntegrationRuntime{
+			Description: pointer.To(d.Get("description").(string)),
+			// `type` is overridden here since the generic model always sends `IntegrationRuntime`
+			AdditionalProperties: map[string]interface{}{
+				"type":           dataFactoryIntegrationRuntimeTypeAirflow,
+				"typeProperties": typeProperties,
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, id.Name, integrationRuntime, ""); err != nil {
+		return fmt.Errorf("creating/updating Data Factory Airflow %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryIntegrationRuntimeAirflowRead(d, meta)
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dataFactoryId := factories.NewFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", dataFactoryId.ID())
+
+	integrationRuntime, convertSuccess := resp.Properties.AsIntegrationRuntime()
+	if !convertSuccess || integrationRuntime == nil || string(integrationRuntime.Type) != dataFactoryIntegrationRuntimeTypeAirflow {
+		return fmt.Errorf("converting Integration Runtime to Airflow %s", *id)
+	}
+
+	d.Set("description", pointer.From(integrationRuntime.Description))
+
+	typeProperties, err := parseDataFactoryIntegrationRuntimeAirflowTypeProperties(integrationRuntime.AdditionalProperties["typeProperties"])
+	if err != nil {
+		return fmt.Errorf("parsing type properties for Data Factory Airflow %s: %+v", *id, err)
+	}
+
+	if computeProps := typeProperties.ComputeProperties; computeProps != nil {
+		d.Set("location", location.NormalizeNilable(computeProps.Location))
+		d.Set("environment_size", pointer.From(computeProps.ComputeSize))
+		d.Set("extra_nodes", int(pointer.From(computeProps.ExtraNodes)))
+	}
+
+	if airflowProps := typeProperties.AirflowProperties; airflowProps != nil {
+		d.Set("airflow_version", pointer.From(airflowProps.AirflowVersion))
+		d.Set("requirements", utils.FlattenStringSlice(airflowProps.Requirements))
+		d.Set("environment_variables", pointer.From(airflowProps.EnvironmentVariables))
+
+		if err := d.Set("web_access", flattenDataFactoryIntegrationRuntimeAirflowWebAccess(d, airflowProps)); err != nil {
+			return fmt.Errorf("setting `web_access`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Delete(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandDataFactoryIntegrationRuntimeAirflowProperties(d *pluginsdk.ResourceData) (*dataFactoryAirflowProperties, error) {
+	environmentVariables := make(map[string]string)
+	for k, v := range d.Get("environment_variables").(map[string]interface{}) {
+		environmentVariables[k] = v.(string)
+	}
+
+	props := &dataFactoryAirflowProperties{
+		AirflowVersion:       pointer.To(d.Get("airflow_version").(string)),
+		EnableAADIntegration: pointer.To(true),
+		EnvironmentVariables: &environmentVariables,
+		Requirements:         utils.ExpandStringSlice(d.Get("requirements").([]interface{})),
+	}
+
+	webAccess := d.Get("web_access").([]interface{})
+	if len(webAccess) == 0 || webAccess[0] == nil {
+		return props, nil
+	}
+
+	raw := webAccess[0].(map[string]interface{})
+	aadEnabled := raw["azure_active_directory_enabled"].(bool)
+	username := raw["username"].(string)
+	password := raw["password"].(string)
+
+	props.EnableAADIntegration = pointer.To(aadEnabled)
+	if !aadEnabled {
+		if username == "" || password == "" {
+			return nil, fmt.Errorf("`username` and `password` are required within `web_access` when `azure_active_directory_enabled` is `false`")
+		}
+		props.UserName = pointer.To(username)
+		props.Password = pointer.To(password)
+	} else if username != "" || password != "" {
+		return nil, fmt.Errorf("`username` and `password` can only be specified within `web_access` when `azure_active_directory_enabled` is `false`")
+	}
+
+	return props, nil
+}
+
+func parseDataFactoryIntegrationRuntimeAirflowTypeProperties(input interface{}) (*dataFactoryAirflowIntegrationRuntimeTypeProperties, error) {
+	result := dataFactoryAirflowIntegrationRuntimeTypeProperties{}
+	if input == nil {
+		return &result, nil
+	}
+
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func flattenDataFactoryIntegrationRuntimeAirflowWebAccess(d *pluginsdk.ResourceData, input *dataFactoryAirflowProperties) []interface{} {
+	aadEnabled := pointer.From(input.EnableAADIntegration)
+
+	// AAD integration is the default, so unless basic authentication is in use the block only needs to be set when it's been configured
+	if aadEnabled && len(d.Get("web_access").([]interface{})) == 0 {
+		return []interface{}{}
+	}
+
+	// the password isn't returned by the API, so pull it from the config
+	password := ""
+	if v, ok := d.GetOk("web_access.0.password"); ok {
+		password = v.(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"azure_active_directory_enabled": aadEnabled,
+			"username":                       pointer.From(input.UserName),
+			"password":                       password,
+		},
+	}
+}
@@ -255,6 +255,10 @@ func resourceDataFactoryTriggerScheduleCreate(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
+	if err := ensureDataFactoryIsInLiveMode(ctx, meta.(*clients.Client), *dataFactoryId); err != nil {
+		return err
+	}
+
 	id := parse.NewTriggerID(subscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, d.Get("name").(string))
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
@@ -347,6 +351,10 @@ func resourceDataFactoryTriggerScheduleUpdate(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
+	if err := ensureDataFactoryIsInLiveMode(ctx, meta.(*clients.Client), factories.NewFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName)); err != nil {
+		return err
+	}
+
 	_, err = client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -71,6 +71,11 @@ func resourceDataProtectionBackupVault() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"cross_region_restore_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
 			"identity": commonschema.SystemAssignedIdentityOptional(),
 
 			"retention_duration_in_days": {
@@ -94,6 +99,16 @@ func resourceDataProtectionBackupVault() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("soft_delete", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(string) == string(backupvaults.SoftDeleteStateAlwaysOn) && new.(string) != string(backupvaults.SoftDeleteStateAlwaysOn)
 			}),
+			// once Cross Region Restore has been enabled it can't be disabled
+			pluginsdk.ForceNewIfChange("cross_region_restore_enabled", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				if d.Get("cross_region_restore_enabled").(bool) && d.Get("redundancy").(string) != string(backupvaults.StorageSettingTypesGeoRedundant) {
+					return fmt.Errorf("`cross_region_restore_enabled` can only be set when `redundancy` is `%s`", string(backupvaults.StorageSettingTypesGeoRedundant))
+				}
+				return nil
+			},
 		),
 	}
 
@@ -179,6 +194,18 @@ func resourceDataProtectionBackupVaultCreateUpdate(d *pluginsdk.ResourceData, me
 		parameters.Properties.SecuritySettings.SoftDeleteSettings.RetentionDurationInDays = pointer.To(v.(float64))
 	}
 
+	if !d.IsNewResource() || d.Get("cross_region_restore_enabled").(bool) {
+		crossRegionRestoreState := backupvaults.CrossRegionRestoreStateDisabled
+		if d.Get("cross_region_restore_enabled").(bool) {
+			crossRegionRestoreState = backupvaults.CrossRegionRestoreStateEnabled
+		}
+		parameters.Properties.FeatureSettings = &backupvaults.FeatureSettings{
+			CrossRegionRestoreSettings: &backupvaults.CrossRegionRestoreSettings{
+				State: pointer.To(crossRegionRestoreState),
+			},
+		}
+	}
+
 	err = client.CreateOrUpdateThenPoll(ctx, id, parameters, backupvaults.DefaultCreateOrUpdateOperationOptions())
 	if err != nil {
 		return fmt.Errorf("creating DataProtection BackupVault (%q): %+v", id, err)
@@ -217,6 +244,12 @@ func resourceDataProtectionBackupVaultRead(d *pluginsdk.ResourceData, meta inter
 			d.Set("datastore_type", string(pointer.From((props.StorageSettings)[0].DatastoreType)))
 			d.Set("redundancy", string(pointer.From((props.StorageSettings)[0].Type)))
 		}
+		crossRegionRestoreEnabled := false
+		if featureSettings := props.FeatureSettings; featureSettings != nil && featureSettings.CrossRegionRestoreSettings != nil {
+			crossRegionRestoreEnabled = pointer.From(featureSettings.CrossRegionRestoreSettings.State) == backupvaults.CrossRegionRestoreStateEnabled
+		}
+		d.Set("cross_region_restore_enabled", crossRegionRestoreEnabled)
+
 		if securitySetting := model.Properties.SecuritySettings; securitySetting != nil {
 			if softDelete := securitySetting.SoftDeleteSettings; softDelete != nil {
 				d.Set("soft_delete", string(pointer.From(softDelete.State)))
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/invitation"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/share"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceDataShareInvitation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataShareInvitationCreate,
+		Read:   resourceDataShareInvitationRead,
+		Delete: resourceDataShareInvitationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := invitation.ParseInvitationID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ShareName(),
+			},
+
+			"share_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: share.ValidateShareID,
+			},
+
+			"target_email": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"target_email", "target_active_directory_id"},
+			},
+
+			"target_active_directory_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				ExactlyOneOf: []string{"target_email", "target_active_directory_id"},
+				RequiredWith: []string{"target_object_id"},
+			},
+
+			"target_object_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				RequiredWith: []string{"target_active_directory_id"},
+			},
+
+			"invitation_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"invitation_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"sent_at": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"responded_at": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareInvitationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.InvitationClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	shareId, err := share.ParseShareID(d.Get("share_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := invitation.NewInvitationID(shareId.SubscriptionId, shareId.ResourceGroupName, shareId.AccountName, shareId.ShareName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_data_share_invitation", id.ID())
+	}
+
+	parameters := invitation.Invitation{
+		Properties: &invitation.InvitationProperties{},
+	}
+
+	if v := d.Get("target_email").(string); v != "" {
+		parameters.Properties.TargetEmail = pointer.To(v)
+	}
+
+	if v := d.Get("target_active_directory_id").(string); v != "" {
+		parameters.Properties.TargetActiveDirectoryId = pointer.To(v)
+		parameters.Properties.TargetObjectId = pointer.To(d.Get("target_object_id").(string))
+	}
+
+	if _, err := client.Create(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataShareInvitationRead(d, meta)
+}
+
+func resourceDataShareInvitationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.InvitationClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := invitation.ParseInvitationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.InvitationName)
+	d.Set("share_id", share.NewShareID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.ShareName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("target_email", pointer.From(props.TargetEmail))
+			d.Set("target_active_directory_id", pointer.From(props.TargetActiveDirectoryId))
+			d.Set("target_object_id", pointer.From(props.TargetObjectId))
+			d.Set("invitation_id", pointer.From(props.InvitationId))
+			d.Set("invitation_status", string(pointer.From(props.InvitationStatus)))
+			d.Set("sent_at", pointer.From(props.SentAt))
+			d.Set("responded_at", pointer.From(props.RespondedAt))
+		}
+	}
+
+	return nil
+}
+
+func resourceDataShareInvitationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.InvitationClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := invitation.ParseInvitationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/invitation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DataShareInvitationResource struct{}
+
+func TestAccDataShareInvitation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_invitation", "test")
+	r := DataShareInvitationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("invitation_id").IsUUID(),
+				check.That(data.ResourceName).Key("invitation_status").HasValue("Pending"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareInvitation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_invitation", "test")
+	r := DataShareInvitationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataShareInvitation_email(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_invitation", "test")
+	r := DataShareInvitationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.email(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t DataShareInvitationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := invitation.ParseInvitationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.InvitationClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (DataShareInvitationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-datashare-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_share_account" "test" {
+  name                = "acctest-dsa-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_data_share" "test" {
+  name       = "acctest_ds_%[1]d"
+  account_id = azurerm_data_share_account.test.id
+  kind       = "CopyBased"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DataShareInvitationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_invitation" "test" {
+  name                       = "acctest-dsi-%d"
+  share_id                   = azurerm_data_share.test.id
+  target_active_directory_id = data.azurerm_client_config.current.tenant_id
+  target_object_id           = data.azurerm_client_config.current.object_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DataShareInvitationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_invitation" "import" {
+  name                       = azurerm_data_share_invitation.test.name
+  share_id                   = azurerm_data_share_invitation.test.share_id
+  target_active_directory_id = azurerm_data_share_invitation.test.target_active_directory_id
+  target_object_id           = azurerm_data_share_invitation.test.target_object_id
+}
+`, r.basic(data))
+}
+
+func (r DataShareInvitationResource) email(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_invitation" "test" {
+  name         = "acctest-dsi-%d"
+  share_id     = azurerm_data_share.test.id
+  target_email = "acctest@example.com"
+}
+`, r.template(data), data.RandomInteger)
+}
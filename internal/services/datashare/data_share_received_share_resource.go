@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/account"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/sharesubscription"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/trigger"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceDataShareReceivedShare() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataShareReceivedShareCreate,
+		Read:   resourceDataShareReceivedShareRead,
+		Update: resourceDataShareReceivedShareUpdate,
+		Delete: resourceDataShareReceivedShareDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := sharesubscription.ParseShareSubscriptionID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ShareName(),
+			},
+
+			"account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: account.ValidateAccountID,
+			},
+
+			"invitation_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"source_share_location": commonschema.Location(),
+
+			"snapshot_schedule": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.SnapshotScheduleName(),
+						},
+
+						"recurrence": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(trigger.RecurrenceIntervalDay),
+								string(trigger.RecurrenceIntervalHour),
+							}, false),
+						},
+
+						"start_time": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppress.RFC3339Time,
+						},
+
+						"synchronization_mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(trigger.SynchronizationModeIncremental),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(trigger.SynchronizationModeFullSync),
+								string(trigger.SynchronizationModeIncremental),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"share_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"share_kind": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provider_email": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provider_tenant_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareReceivedShareCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := account.ParseAccountID(d.Get("account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := sharesubscription.NewShareSubscriptionID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_data_share_received_share", id.ID())
+	}
+
+	parameters := sharesubscription.ShareSubscription{
+		Properties: sharesubscription.ShareSubscriptionProperties{
+			InvitationId:        d.Get("invitation_id").(string),
+			SourceShareLocation: location.Normalize(d.Get("source_share_location").(string)),
+		},
+	}
+
+	if _, err := client.Create(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if snapshotSchedule := expandDataShareReceivedShareSnapshotSchedule(d.Get("snapshot_schedule").([]interface{})); snapshotSchedule != nil {
+		if err := createDataShareReceivedShareSnapshotSchedule(d, meta, id, *snapshotSchedule); err != nil {
+			return err
+		}
+	}
+
+	return resourceDataShareReceivedShareRead(d, meta)
+}
+
+func resourceDataShareReceivedShareRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionClient
+	triggerClient := meta.(*clients.Client).DataShare.TriggerClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := sharesubscription.ParseShareSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ShareSubscriptionName)
+	d.Set("account_id", account.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+		d.Set("invitation_id", props.InvitationId)
+		d.Set("source_share_location", location.Normalize(props.SourceShareLocation))
+		d.Set("share_name", pointer.From(props.ShareName))
+		d.Set("share_kind", string(pointer.From(props.ShareKind)))
+		d.Set("provider_email", pointer.From(props.ProviderEmail))
+		d.Set("provider_tenant_name", pointer.From(props.ProviderTenantName))
+		d.Set("status", string(pointer.From(props.ShareSubscriptionStatus)))
+	}
+
+	triggers := make([]trigger.ScheduledTrigger, 0)
+	snapshotSchedules, err := triggerClient.ListByShareSubscriptionComplete(ctx, trigger.NewShareSubscriptionID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.ShareSubscriptionName))
+	if err != nil {
+		return fmt.Errorf("listing snapshot schedules for %s: %+v", *id, err)
+	}
+	for _, item := range snapshotSchedules.Items {
+		if t, ok := item.(trigger.ScheduledTrigger); ok {
+			triggers = append(triggers, t)
+		}
+	}
+	if err := d.Set("snapshot_schedule", flattenDataShareReceivedShareSnapshotSchedule(triggers)); err != nil {
+		return fmt.Errorf("setting `snapshot_schedule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceDataShareReceivedShareUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	triggerClient := meta.(*clients.Client).DataShare.TriggerClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := sharesubscription.ParseShareSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("snapshot_schedule") {
+		// only one trigger is allowed for a received share, so the existing one has to be removed first
+		o, _ := d.GetChange("snapshot_schedule")
+		if origins := o.([]interface{}); len(origins) > 0 {
+			origin := origins[0].(map[string]interface{})
+			if originName, ok := origin["name"].(string); ok && originName != "" {
+				triggerId := trigger.NewTriggerID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.ShareSubscriptionName, originName)
+				if err := triggerClient.DeleteThenPoll(ctx, triggerId); err != nil {
+					return fmt.Errorf("deleting snapshot schedule %s: %+v", triggerId, err)
+				}
+			}
+		}
+
+		if snapshotSchedule := expandDataShareReceivedShareSnapshotSchedule(d.Get("snapshot_schedule").([]interface{})); snapshotSchedule != nil {
+			if err := createDataShareReceivedShareSnapshotSchedule(d, meta, *id, *snapshotSchedule); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceDataShareReceivedShareRead(d, meta)
+}
+
+func resourceDataShareReceivedShareDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := sharesubscription.ParseShareSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func createDataShareReceivedShareSnapshotSchedule(d *pluginsdk.ResourceData, meta interface{}, id sharesubscription.ShareSubscriptionId, input trigger.ScheduledTrigger) error {
+	triggerClient := meta.(*clients.Client).DataShare.TriggerClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	triggerId := trigger.NewTriggerID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.ShareSubscriptionName, d.Get("snapshot_schedule.0.name").(string))
+	if err := triggerClient.CreateThenPoll(ctx, triggerId, input); err != nil {
+		return fmt.Errorf("creating snapshot schedule %s: %+v", triggerId, err)
+	}
+
+	return nil
+}
+
+func expandDataShareReceivedShareSnapshotSchedule(input []interface{}) *trigger.ScheduledTrigger {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	snapshotSchedule := input[0].(map[string]interface{})
+
+	startTime, _ := time.Parse(time.RFC3339, snapshotSchedule["start_time"].(string))
+
+	return &trigger.ScheduledTrigger{
+		Properties: trigger.ScheduledTriggerProperties{
+			RecurrenceInterval:  trigger.RecurrenceInterval(snapshotSchedule["recurrence"].(string)),
+			SynchronizationMode: pointer.To(trigger.SynchronizationMode(snapshotSchedule["synchronization_mode"].(string))),
+			SynchronizationTime: date.Time{Time: startTime}.String(),
+		},
+	}
+}
+
+func flattenDataShareReceivedShareSnapshotSchedule(input []trigger.ScheduledTrigger) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, item := range input {
+		props := item.Properties
+
+		synchronizationMode := string(trigger.SynchronizationModeIncremental)
+		if props.SynchronizationMode != nil {
+			synchronizationMode = string(*props.SynchronizationMode)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":                 pointer.From(item.Name),
+			"recurrence":           string(props.RecurrenceInterval),
+			"start_time":           props.SynchronizationTime,
+			"synchronization_mode": synchronizationMode,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/sharesubscription"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DataShareReceivedShareResource struct{}
+
+func TestAccDataShareReceivedShare_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_received_share", "test")
+	r := DataShareReceivedShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareReceivedShare_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_received_share", "test")
+	r := DataShareReceivedShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataShareReceivedShare_snapshotSchedule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_received_share", "test")
+	r := DataShareReceivedShareResource{}
+	startTime := time.Now().Add(time.Hour * 7).Format(time.RFC3339)
+	startTime2 := time.Now().Add(time.Hour * 8).Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.snapshotSchedule(data, "snapshot", "Day", startTime),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.snapshotSchedule(data, "snapshot2", "Hour", startTime2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t DataShareReceivedShareResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sharesubscription.ParseShareSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.ShareSubscriptionClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (DataShareReceivedShareResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-datashare-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_share_account" "provider" {
+  name                = "acctest-dsap-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_data_share" "test" {
+  name       = "acctest_ds_%[1]d"
+  account_id = azurerm_data_share_account.provider.id
+  kind       = "CopyBased"
+
+  snapshot_schedule {
+    name       = "acctest-ss-%[1]d"
+    recurrence = "Day"
+    start_time = "%[3]s"
+  }
+}
+
+resource "azurerm_data_share_invitation" "test" {
+  name                       = "acctest-dsi-%[1]d"
+  share_id                   = azurerm_data_share.test.id
+  target_active_directory_id = data.azurerm_client_config.current.tenant_id
+  target_object_id           = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_data_share_account" "test" {
+  name                = "acctest-dsa-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, time.Now().UTC().Add(time.Hour*24).Truncate(time.Hour*24).Format(time.RFC3339))
+}
+
+func (r DataShareReceivedShareResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_received_share" "test" {
+  name                  = "acctest_dsr_%d"
+  account_id            = azurerm_data_share_account.test.id
+  invitation_id         = azurerm_data_share_invitation.test.invitation_id
+  source_share_location = azurerm_resource_group.test.location
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DataShareReceivedShareResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_received_share" "import" {
+  name                  = azurerm_data_share_received_share.test.name
+  account_id            = azurerm_data_share_received_share.test.account_id
+  invitation_id         = azurerm_data_share_received_share.test.invitation_id
+  source_share_location = azurerm_data_share_received_share.test.source_share_location
+}
+`, r.basic(data))
+}
+
+func (r DataShareReceivedShareResource) snapshotSchedule(data acceptance.TestData, name, recurrence, startTime string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_data_share_received_share" "test" {
+  name                  = "acctest_dsr_%[2]d"
+  account_id            = azurerm_data_share_account.test.id
+  invitation_id         = azurerm_data_share_invitation.test.invitation_id
+  source_share_location = azurerm_resource_group.test.location
+
+  snapshot_schedule {
+    name                 = "acctest-%[3]s-%[2]d"
+    recurrence           = "%[4]s"
+    start_time           = "%[5]s"
+    synchronization_mode = "Incremental"
+  }
+}
+`, r.template(data), data.RandomInteger, name, recurrence, startTime)
+}
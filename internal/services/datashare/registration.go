@@ -49,5 +49,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_data_share_dataset_data_lake_gen2": resourceDataShareDataSetDataLakeGen2(),
 		"azurerm_data_share_dataset_kusto_cluster":  resourceDataShareDataSetKustoCluster(),
 		"azurerm_data_share_dataset_kusto_database": resourceDataShareDataSetKustoDatabase(),
+		"azurerm_data_share_invitation":             resourceDataShareInvitation(),
+		"azurerm_data_share_received_share":         resourceDataShareReceivedShare(),
 	}
 }
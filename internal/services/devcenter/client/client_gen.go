@@ -4,12 +4,14 @@ import (
 	"fmt"
 
 	devcenterV20230401 "github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01"
+	devcenterV20240201 "github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2024-02-01"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type AutoClient struct {
 	V20230401 devcenterV20230401.Client
+	V20240201 devcenterV20240201.Client
 }
 
 func NewClient(o *common.ClientOptions) (*AutoClient, error) {
@@ -21,7 +23,15 @@ func NewClient(o *common.ClientOptions) (*AutoClient, error) {
 		return nil, fmt.Errorf("building client for devcenter V20230401: %+v", err)
 	}
 
+	v20240201Client, err := devcenterV20240201.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
+		o.Configure(c, o.Authorizers.ResourceManager)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building client for devcenter V20240201: %+v", err)
+	}
+
 	return &AutoClient{
 		V20230401: *v20230401Client,
+		V20240201: *v20240201Client,
 	}, nil
 }
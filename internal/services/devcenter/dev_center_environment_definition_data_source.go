@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2024-02-01/environmentdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = DevCenterEnvironmentDefinitionDataSource{}
+
+type DevCenterEnvironmentDefinitionDataSource struct{}
+
+type DevCenterEnvironmentDefinitionDataSourceModel struct {
+	Name               string                                    `tfschema:"name"`
+	DevCenterCatalogId string                                    `tfschema:"dev_center_catalog_id"`
+	Description        string                                    `tfschema:"description"`
+	Parameter          []DevCenterEnvironmentDefinitionParameter `tfschema:"parameter"`
+	TemplatePath       string                                    `tfschema:"template_path"`
+}
+
+type DevCenterEnvironmentDefinitionParameter struct {
+	Id          string `tfschema:"id"`
+	Name        string `tfschema:"name"`
+	Description string `tfschema:"description"`
+	ReadOnly    bool   `tfschema:"read_only"`
+	Required    bool   `tfschema:"required"`
+	Type        string `tfschema:"type"`
+}
+
+func (DevCenterEnvironmentDefinitionDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dev_center_catalog_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: environmentdefinitions.ValidateDevCenterCatalogID,
+		},
+	}
+}
+
+func (DevCenterEnvironmentDefinitionDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"parameter": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"read_only": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"required": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"template_path": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (DevCenterEnvironmentDefinitionDataSource) ModelObject() interface{} {
+	return &DevCenterEnvironmentDefinitionDataSourceModel{}
+}
+
+func (DevCenterEnvironmentDefinitionDataSource) ResourceType() string {
+	return "azurerm_dev_center_environment_definition"
+}
+
+func (DevCenterEnvironmentDefinitionDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20240201.EnvironmentDefinitions
+
+			var state DevCenterEnvironmentDefinitionDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			catalogId, err := environmentdefinitions.ParseDevCenterCatalogID(state.DevCenterCatalogId)
+			if err != nil {
+				return err
+			}
+
+			id := environmentdefinitions.NewCatalogEnvironmentDefinitionID(catalogId.SubscriptionId, catalogId.ResourceGroupName, catalogId.DevCenterName, catalogId.CatalogName, state.Name)
+
+			resp, err := client.EnvironmentDefinitionsGet(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Description = pointer.From(props.Description)
+					state.Parameter = flattenDevCenterEnvironmentDefinitionParameters(props.Parameters)
+					state.TemplatePath = pointer.From(props.TemplatePath)
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenDevCenterEnvironmentDefinitionParameters(input *[]environmentdefinitions.EnvironmentDefinitionParameter) []DevCenterEnvironmentDefinitionParameter {
+	result := make([]DevCenterEnvironmentDefinitionParameter, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, DevCenterEnvironmentDefinitionParameter{
+			Id:          pointer.From(v.Id),
+			Name:        pointer.From(v.Name),
+			Description: pointer.From(v.Description),
+			ReadOnly:    pointer.From(v.ReadOnly),
+			Required:    pointer.From(v.Required),
+			Type:        string(pointer.From(v.Type)),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DevCenterEnvironmentDefinitionDataSource struct{}
+
+func TestAccDevCenterEnvironmentDefinitionDataSource_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_DEV_CENTER_ENVIRONMENT_DEFINITION_NAME") == "" {
+		t.Skip("Skipping as `ARM_TEST_DEV_CENTER_ENVIRONMENT_DEFINITION_NAME` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_dev_center_environment_definition", "test")
+	d := DevCenterEnvironmentDefinitionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("template_path").Exists(),
+			),
+		},
+	})
+}
+
+func (d DevCenterEnvironmentDefinitionDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dev_center_environment_definition" "test" {
+  name                  = "%s"
+  dev_center_catalog_id = azurerm_dev_center_catalog.test.id
+}
+`, DevCenterCatalogsResource{}.basic(data), os.Getenv("ARM_TEST_DEV_CENTER_ENVIRONMENT_DEFINITION_NAME"))
+}
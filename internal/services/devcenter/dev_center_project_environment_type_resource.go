@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/environmenttypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = DevCenterProjectEnvironmentTypeResource{}
+var _ sdk.ResourceWithUpdate = DevCenterProjectEnvironmentTypeResource{}
+
+type DevCenterProjectEnvironmentTypeResource struct{}
+
+func (r DevCenterProjectEnvironmentTypeResource) ModelObject() interface{} {
+	return &DevCenterProjectEnvironmentTypeResourceModel{}
+}
+
+type DevCenterProjectEnvironmentTypeResourceModel struct {
+	Name                       string                                              `tfschema:"name"`
+	Location                   string                                              `tfschema:"location"`
+	DevCenterProjectId         string                                              `tfschema:"dev_center_project_id"`
+	DeploymentTargetId         string                                              `tfschema:"deployment_target_id"`
+	Identity                   []identity.ModelSystemAssignedUserAssigned          `tfschema:"identity"`
+	CreatorRoleAssignmentRoles []string                                            `tfschema:"creator_role_assignment_roles"`
+	UserRoleAssignment         []DevCenterProjectEnvironmentTypeUserRoleAssignment `tfschema:"user_role_assignment"`
+	Tags                       map[string]string                                   `tfschema:"tags"`
+}
+
+type DevCenterProjectEnvironmentTypeUserRoleAssignment struct {
+	UserId string   `tfschema:"user_id"`
+	Roles  []string `tfschema:"roles"`
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return environmenttypes.ValidateEnvironmentTypeID
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) ResourceType() string {
+	return "azurerm_dev_center_project_environment_type"
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterEnvironmentTypeName,
+		},
+
+		"location": commonschema.Location(),
+
+		"dev_center_project_id": commonschema.ResourceIDReferenceRequiredForceNew(&environmenttypes.ProjectId{}),
+
+		"deployment_target_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateSubscriptionID,
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityRequired(),
+
+		"creator_role_assignment_roles": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"user_role_assignment": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"user_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"roles": {
+						Type:     pluginsdk.TypeSet,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.EnvironmentTypes
+
+			var model DevCenterProjectEnvironmentTypeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			projectId, err := environmenttypes.ParseProjectID(model.DevCenterProjectId)
+			if err != nil {
+				return err
+			}
+
+			id := environmenttypes.NewEnvironmentTypeID(projectId.SubscriptionId, projectId.ResourceGroupName, projectId.ProjectName, model.Name)
+
+			existing, err := client.ProjectEnvironmentTypesGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := environmenttypes.ProjectEnvironmentType{
+				Location: pointer.To(location.Normalize(model.Location)),
+				Identity: identityValue,
+				Properties: &environmenttypes.ProjectEnvironmentTypeProperties{
+					CreatorRoleAssignment: expandDevCenterProjectEnvironmentTypeCreatorRoleAssignment(model.CreatorRoleAssignmentRoles),
+					DeploymentTargetId:    pointer.To(model.DeploymentTargetId),
+					Status:                pointer.To(environmenttypes.EnvironmentTypeEnableStatusEnabled),
+					UserRoleAssignments:   expandDevCenterProjectEnvironmentTypeUserRoleAssignments(model.UserRoleAssignment),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if _, err := client.ProjectEnvironmentTypesCreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.EnvironmentTypes
+
+			id, err := environmenttypes.ParseEnvironmentTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ProjectEnvironmentTypesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterProjectEnvironmentTypeResourceModel{
+				Name:               id.EnvironmentTypeName,
+				DevCenterProjectId: environmenttypes.NewProjectID(id.SubscriptionId, id.ResourceGroupName, id.ProjectName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(pointer.From(model.Location))
+				state.Tags = pointer.From(model.Tags)
+
+				identityValue, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(identityValue)
+
+				if props := model.Properties; props != nil {
+					state.DeploymentTargetId = pointer.From(props.DeploymentTargetId)
+					state.CreatorRoleAssignmentRoles = flattenDevCenterProjectEnvironmentTypeCreatorRoleAssignment(props.CreatorRoleAssignment)
+					state.UserRoleAssignment = flattenDevCenterProjectEnvironmentTypeUserRoleAssignments(props.UserRoleAssignments)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.EnvironmentTypes
+
+			id, err := environmenttypes.ParseEnvironmentTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterProjectEnvironmentTypeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := environmenttypes.ProjectEnvironmentTypeUpdate{
+				Properties: &environmenttypes.ProjectEnvironmentTypeUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("deployment_target_id") {
+				parameters.Properties.DeploymentTargetId = pointer.To(model.DeploymentTargetId)
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				parameters.Identity = identityValue
+			}
+
+			if metadata.ResourceData.HasChange("creator_role_assignment_roles") {
+				parameters.Properties.CreatorRoleAssignment = expandDevCenterProjectEnvironmentTypeCreatorRoleAssignment(model.CreatorRoleAssignmentRoles)
+			}
+
+			if metadata.ResourceData.HasChange("user_role_assignment") {
+				parameters.Properties.UserRoleAssignments = expandDevCenterProjectEnvironmentTypeUserRoleAssignments(model.UserRoleAssignment)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = pointer.To(model.Tags)
+			}
+
+			if _, err := client.ProjectEnvironmentTypesUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.EnvironmentTypes
+
+			id, err := environmenttypes.ParseEnvironmentTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.ProjectEnvironmentTypesDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterProjectEnvironmentTypeCreatorRoleAssignment(input []string) *environmenttypes.ProjectEnvironmentTypeUpdatePropertiesCreatorRoleAssignment {
+	roles := make(map[string]environmenttypes.EnvironmentRole)
+	for _, v := range input {
+		roles[v] = environmenttypes.EnvironmentRole{}
+	}
+
+	return &environmenttypes.ProjectEnvironmentTypeUpdatePropertiesCreatorRoleAssignment{
+		Roles: pointer.To(roles),
+	}
+}
+
+func flattenDevCenterProjectEnvironmentTypeCreatorRoleAssignment(input *environmenttypes.ProjectEnvironmentTypeUpdatePropertiesCreatorRoleAssignment) []string {
+	result := make([]string, 0)
+	if input == nil || input.Roles == nil {
+		return result
+	}
+
+	for k := range *input.Roles {
+		result = append(result, k)
+	}
+
+	return result
+}
+
+func expandDevCenterProjectEnvironmentTypeUserRoleAssignments(input []DevCenterProjectEnvironmentTypeUserRoleAssignment) *map[string]environmenttypes.UserRoleAssignment {
+	result := make(map[string]environmenttypes.UserRoleAssignment)
+	for _, v := range input {
+		roles := make(map[string]environmenttypes.EnvironmentRole)
+		for _, role := range v.Roles {
+			roles[role] = environmenttypes.EnvironmentRole{}
+		}
+
+		result[v.UserId] = environmenttypes.UserRoleAssignment{
+			Roles: pointer.To(roles),
+		}
+	}
+
+	return &result
+}
+
+func flattenDevCenterProjectEnvironmentTypeUserRoleAssignments(input *map[string]environmenttypes.UserRoleAssignment) []DevCenterProjectEnvironmentTypeUserRoleAssignment {
+	result := make([]DevCenterProjectEnvironmentTypeUserRoleAssignment, 0)
+	if input == nil {
+		return result
+	}
+
+	for userId, assignment := range *input {
+		roles := make([]string, 0)
+		if assignment.Roles != nil {
+			for role := range *assignment.Roles {
+				roles = append(roles, role)
+			}
+		}
+
+		result = append(result, DevCenterProjectEnvironmentTypeUserRoleAssignment{
+			UserId: userId,
+			Roles:  roles,
+		})
+	}
+
+	return result
+}
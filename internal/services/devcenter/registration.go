@@ -26,7 +26,10 @@ func (r Registration) WebsiteCategories() []string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return r.autoRegistration.DataSources()
+	dataSources := []sdk.DataSource{
+		DevCenterEnvironmentDefinitionDataSource{},
+	}
+	return append(dataSources, r.autoRegistration.DataSources()...)
 }
 
 func (r Registration) Resources() []sdk.Resource {
@@ -36,6 +39,7 @@ func (r Registration) Resources() []sdk.Resource {
 		DevCenterDevBoxDefinitionResource{},
 		DevCenterEnvironmentTypeResource{},
 		DevCenterNetworkConnectionResource{},
+		DevCenterProjectEnvironmentTypeResource{},
 	}
 	return append(resources, r.autoRegistration.Resources()...)
 }
@@ -6,10 +6,14 @@ package digitaltwins
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/digitaltwinsinstance"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/timeseriesdatabaseconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -33,6 +37,11 @@ type TimeSeriesDatabaseConnectionModel struct {
 	KustoClusterUri              string `tfschema:"kusto_cluster_uri"`
 	KustoDatabaseName            string `tfschema:"kusto_database_name"`
 	KustoTableName               string `tfschema:"kusto_table_name"`
+
+	KustoRelationshipLifecycleEventsTableName string `tfschema:"kusto_relationship_lifecycle_events_table_name"`
+	KustoTwinLifecycleEventsTableName         string `tfschema:"kusto_twin_lifecycle_events_table_name"`
+	RecordPropertyAndItemRemovalsEnabled      bool   `tfschema:"record_property_and_item_removals_enabled"`
+	UserAssignedIdentityId                    string `tfschema:"user_assigned_identity_id"`
 }
 
 type TimeSeriesDatabaseConnectionResource struct{}
@@ -110,6 +119,36 @@ func (m TimeSeriesDatabaseConnectionResource) Arguments() map[string]*pluginsdk.
 			ForceNew:     true,
 			ValidateFunc: kustoValidate.EntityName,
 		},
+
+		"kusto_relationship_lifecycle_events_table_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: kustoValidate.EntityName,
+		},
+
+		"kusto_twin_lifecycle_events_table_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: kustoValidate.EntityName,
+		},
+
+		"record_property_and_item_removals_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+			ForceNew: true,
+		},
+
+		"user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
 	}
 
 	if !features.FourPointOhBeta() {
@@ -167,6 +206,11 @@ func (m TimeSeriesDatabaseConnectionResource) Create() sdk.ResourceFunc {
 				return meta.ResourceRequiresImport(m.ResourceType(), id)
 			}
 
+			// the connection authenticates to the Event Hub and Kusto Database using an Identity assigned to the Digital Twins Instance
+			if err := validateTimeSeriesDatabaseConnectionIdentity(ctx, meta.Client.DigitalTwins.InstanceClient, *digitalTwinsId, model.UserAssignedIdentityId); err != nil {
+				return err
+			}
+
 			properties := timeseriesdatabaseconnections.AzureDataExplorerConnectionProperties{
 				AdxDatabaseName:             model.KustoDatabaseName,
 				AdxEndpointUri:              model.KustoClusterUri,
@@ -174,6 +218,23 @@ func (m TimeSeriesDatabaseConnectionResource) Create() sdk.ResourceFunc {
 				EventHubEndpointUri:         model.EventhubNamespaceEndpointUri,
 				EventHubEntityPath:          model.EventhubName,
 				EventHubNamespaceResourceId: model.EventhubNamespaceId,
+
+				RecordPropertyAndItemRemovals: pointer.To(model.RecordPropertyAndItemRemovalsEnabled),
+			}
+
+			if model.KustoRelationshipLifecycleEventsTableName != "" {
+				properties.AdxRelationshipLifecycleEventsTableName = pointer.To(model.KustoRelationshipLifecycleEventsTableName)
+			}
+
+			if model.KustoTwinLifecycleEventsTableName != "" {
+				properties.AdxTwinLifecycleEventsTableName = pointer.To(model.KustoTwinLifecycleEventsTableName)
+			}
+
+			if model.UserAssignedIdentityId != "" {
+				properties.Identity = &timeseriesdatabaseconnections.ManagedIdentityReference{
+					Type:                 pointer.To(timeseriesdatabaseconnections.IdentityTypeUserAssigned),
+					UserAssignedIdentity: pointer.To(model.UserAssignedIdentityId),
+				}
 			}
 
 			if model.KustoTableName != "" {
@@ -249,6 +310,18 @@ func (m TimeSeriesDatabaseConnectionResource) Read() sdk.ResourceFunc {
 					kustoTableName = *properties.AdxTableName
 				}
 				output.KustoTableName = kustoTableName
+
+				output.KustoRelationshipLifecycleEventsTableName = pointer.From(properties.AdxRelationshipLifecycleEventsTableName)
+				output.KustoTwinLifecycleEventsTableName = pointer.From(properties.AdxTwinLifecycleEventsTableName)
+				output.RecordPropertyAndItemRemovalsEnabled = pointer.From(properties.RecordPropertyAndItemRemovals)
+
+				if connectionIdentity := properties.Identity; connectionIdentity != nil && pointer.From(connectionIdentity.Type) == timeseriesdatabaseconnections.IdentityTypeUserAssigned {
+					userAssignedIdentityId, err := commonids.ParseUserAssignedIdentityIDInsensitively(pointer.From(connectionIdentity.UserAssignedIdentity))
+					if err != nil {
+						return fmt.Errorf("parsing `user_assigned_identity_id`: %+v", err)
+					}
+					output.UserAssignedIdentityId = userAssignedIdentityId.ID()
+				}
 			}
 
 			return meta.Encode(&output)
@@ -273,3 +346,35 @@ func (m TimeSeriesDatabaseConnectionResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+// validateTimeSeriesDatabaseConnectionIdentity checks that the Identity used by the connection is assigned to the Digital
+// Twins Instance, since the connection can otherwise only fail once the service attempts to use it
+func validateTimeSeriesDatabaseConnectionIdentity(ctx context.Context, client *digitaltwinsinstance.DigitalTwinsInstanceClient, id timeseriesdatabaseconnections.DigitalTwinsInstanceId, userAssignedIdentityId string) error {
+	instanceId := digitaltwinsinstance.NewDigitalTwinsInstanceID(id.SubscriptionId, id.ResourceGroupName, id.DigitalTwinsInstanceName)
+	resp, err := client.DigitalTwinsGet(ctx, instanceId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", instanceId, err)
+	}
+
+	var instanceIdentity *identity.LegacySystemAndUserAssignedMap
+	if model := resp.Model; model != nil {
+		instanceIdentity = model.Identity
+	}
+
+	if userAssignedIdentityId == "" {
+		if instanceIdentity == nil || (instanceIdentity.Type != identity.TypeSystemAssigned && instanceIdentity.Type != identity.TypeSystemAssignedUserAssigned) {
+			return fmt.Errorf("a System Assigned Identity must be enabled on %s when `user_assigned_identity_id` is not specified", instanceId)
+		}
+		return nil
+	}
+
+	if instanceIdentity != nil {
+		for k := range instanceIdentity.IdentityIds {
+			if strings.EqualFold(k, userAssignedIdentityId) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("the User Assigned Identity %q specified in `user_assigned_identity_id` must be assigned to %s", userAssignedIdentityId, instanceId)
+}
@@ -0,0 +1,547 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespacetopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceResourceModel struct {
+	Name                     string                                     `tfschema:"name"`
+	ResourceGroupName        string                                     `tfschema:"resource_group_name"`
+	Location                 string                                     `tfschema:"location"`
+	Capacity                 int64                                      `tfschema:"capacity"`
+	Identity                 []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	InboundIpRules           []EventGridNamespaceInboundIpRuleModel     `tfschema:"inbound_ip_rule"`
+	PublicNetworkAccess      string                                     `tfschema:"public_network_access"`
+	Sku                      string                                     `tfschema:"sku"`
+	TopicSpacesConfiguration []EventGridNamespaceTopicSpacesModel       `tfschema:"topic_spaces_configuration"`
+	Tags                     map[string]string                          `tfschema:"tags"`
+}
+
+type EventGridNamespaceInboundIpRuleModel struct {
+	IpMask string `tfschema:"ip_mask"`
+	Action string `tfschema:"action"`
+}
+
+type EventGridNamespaceTopicSpacesModel struct {
+	AlternativeAuthenticationNameSources       []string                                   `tfschema:"alternative_authentication_name_source"`
+	MaximumClientSessionsPerAuthenticationName int64                                      `tfschema:"maximum_client_sessions_per_authentication_name"`
+	MaximumSessionExpiryInHours                int64                                      `tfschema:"maximum_session_expiry_in_hours"`
+	RouteTopicId                               string                                     `tfschema:"route_topic_id"`
+	DynamicRoutingEnrichment                   []EventGridNamespaceRoutingEnrichmentModel `tfschema:"dynamic_routing_enrichment"`
+	StaticRoutingEnrichment                    []EventGridNamespaceRoutingEnrichmentModel `tfschema:"static_routing_enrichment"`
+	Hostname                                   string                                     `tfschema:"hostname"`
+}
+
+type EventGridNamespaceRoutingEnrichmentModel struct {
+	Key   string `tfschema:"key"`
+	Value string `tfschema:"value"`
+}
+
+type EventGridNamespaceResource struct{}
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceResource{}
+
+func (EventGridNamespaceResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace"
+}
+
+func (EventGridNamespaceResource) ModelObject() interface{} {
+	return &EventGridNamespaceResourceModel{}
+}
+
+func (EventGridNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return namespaces.ValidateNamespaceID
+}
+
+func (EventGridNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 40),
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"inbound_ip_rule": {
+			Type:       pluginsdk.TypeList,
+			Optional:   true,
+			ConfigMode: pluginsdk.SchemaConfigModeAttr,
+			MaxItems:   128,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_mask": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"action": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(namespaces.IPActionTypeAllow),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(namespaces.IPActionTypeAllow),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"public_network_access": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(namespaces.PublicNetworkAccessEnabled),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(namespaces.PublicNetworkAccessEnabled),
+				string(namespaces.PublicNetworkAccessDisabled),
+			}, false),
+		},
+
+		"sku": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(namespaces.SkuNameStandard),
+			ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForSkuName(), false),
+		},
+
+		"topic_spaces_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"alternative_authentication_name_source": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForAlternativeAuthenticationNameSource(), false),
+						},
+					},
+
+					"maximum_client_sessions_per_authentication_name": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+
+					"maximum_session_expiry_in_hours": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 8),
+					},
+
+					"route_topic_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: namespacetopics.ValidateNamespaceTopicID,
+					},
+
+					"dynamic_routing_enrichment": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"key": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"static_routing_enrichment": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"key": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"hostname": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (EventGridNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model EventGridNamespaceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := namespaces.NewNamespaceID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := namespaces.Namespace{
+				Location: location.Normalize(model.Location),
+				Identity: identityValue,
+				Properties: &namespaces.NamespaceProperties{
+					InboundIPRules:           expandEventGridNamespaceInboundIpRules(model.InboundIpRules),
+					PublicNetworkAccess:      pointer.To(namespaces.PublicNetworkAccess(model.PublicNetworkAccess)),
+					TopicSpacesConfiguration: expandEventGridNamespaceTopicSpacesConfiguration(model.TopicSpacesConfiguration),
+				},
+				Sku: &namespaces.NamespaceSku{
+					Capacity: pointer.To(model.Capacity),
+					Name:     pointer.To(namespaces.SkuName(model.Sku)),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceResourceModel{
+				Name:              id.NamespaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				identityValue, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(identityValue)
+
+				if sku := model.Sku; sku != nil {
+					state.Capacity = pointer.From(sku.Capacity)
+					state.Sku = string(pointer.From(sku.Name))
+				}
+
+				if props := model.Properties; props != nil {
+					state.InboundIpRules = flattenEventGridNamespaceInboundIpRules(props.InboundIPRules)
+					state.PublicNetworkAccess = string(pointer.From(props.PublicNetworkAccess))
+					state.TopicSpacesConfiguration = flattenEventGridNamespaceTopicSpacesConfiguration(props.TopicSpacesConfiguration)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChanges("capacity", "sku") {
+				payload.Sku = &namespaces.NamespaceSku{
+					Capacity: pointer.To(model.Capacity),
+					Name:     pointer.To(namespaces.SkuName(model.Sku)),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = identityValue
+			}
+
+			if metadata.ResourceData.HasChange("inbound_ip_rule") {
+				payload.Properties.InboundIPRules = expandEventGridNamespaceInboundIpRules(model.InboundIpRules)
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access") {
+				payload.Properties.PublicNetworkAccess = pointer.To(namespaces.PublicNetworkAccess(model.PublicNetworkAccess))
+			}
+
+			if metadata.ResourceData.HasChange("topic_spaces_configuration") {
+				payload.Properties.TopicSpacesConfiguration = expandEventGridNamespaceTopicSpacesConfiguration(model.TopicSpacesConfiguration)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceInboundIpRules(input []EventGridNamespaceInboundIpRuleModel) *[]namespaces.InboundIPRule {
+	output := make([]namespaces.InboundIPRule, 0)
+	for _, v := range input {
+		output = append(output, namespaces.InboundIPRule{
+			Action: pointer.To(namespaces.IPActionType(v.Action)),
+			IPMask: pointer.To(v.IpMask),
+		})
+	}
+
+	return &output
+}
+
+func flattenEventGridNamespaceInboundIpRules(input *[]namespaces.InboundIPRule) []EventGridNamespaceInboundIpRuleModel {
+	output := make([]EventGridNamespaceInboundIpRuleModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, EventGridNamespaceInboundIpRuleModel{
+			Action: string(pointer.From(v.Action)),
+			IpMask: pointer.From(v.IPMask),
+		})
+	}
+
+	return output
+}
+
+func expandEventGridNamespaceTopicSpacesConfiguration(input []EventGridNamespaceTopicSpacesModel) *namespaces.TopicSpacesConfiguration {
+	if len(input) == 0 {
+		return &namespaces.TopicSpacesConfiguration{
+			State: pointer.To(namespaces.TopicSpacesConfigurationStateDisabled),
+		}
+	}
+
+	config := input[0]
+
+	authenticationNameSources := make([]namespaces.AlternativeAuthenticationNameSource, 0)
+	for _, v := range config.AlternativeAuthenticationNameSources {
+		authenticationNameSources = append(authenticationNameSources, namespaces.AlternativeAuthenticationNameSource(v))
+	}
+
+	dynamicEnrichments := make([]namespaces.DynamicRoutingEnrichment, 0)
+	for _, v := range config.DynamicRoutingEnrichment {
+		dynamicEnrichments = append(dynamicEnrichments, namespaces.DynamicRoutingEnrichment{
+			Key:   pointer.To(v.Key),
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	staticEnrichments := make([]namespaces.StaticRoutingEnrichment, 0)
+	for _, v := range config.StaticRoutingEnrichment {
+		staticEnrichments = append(staticEnrichments, namespaces.StaticStringRoutingEnrichment{
+			Key:   pointer.To(v.Key),
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	output := namespaces.TopicSpacesConfiguration{
+		ClientAuthentication: &namespaces.ClientAuthenticationSettings{
+			AlternativeAuthenticationNameSources: &authenticationNameSources,
+		},
+		MaximumClientSessionsPerAuthenticationName: pointer.To(config.MaximumClientSessionsPerAuthenticationName),
+		MaximumSessionExpiryInHours:                pointer.To(config.MaximumSessionExpiryInHours),
+		RoutingEnrichments: &namespaces.RoutingEnrichments{
+			Dynamic: &dynamicEnrichments,
+			Static:  &staticEnrichments,
+		},
+		State: pointer.To(namespaces.TopicSpacesConfigurationStateEnabled),
+	}
+
+	if config.RouteTopicId != "" {
+		output.RouteTopicResourceId = pointer.To(config.RouteTopicId)
+	}
+
+	return &output
+}
+
+func flattenEventGridNamespaceTopicSpacesConfiguration(input *namespaces.TopicSpacesConfiguration) []EventGridNamespaceTopicSpacesModel {
+	if input == nil || pointer.From(input.State) != namespaces.TopicSpacesConfigurationStateEnabled {
+		return []EventGridNamespaceTopicSpacesModel{}
+	}
+
+	authenticationNameSources := make([]string, 0)
+	if input.ClientAuthentication != nil && input.ClientAuthentication.AlternativeAuthenticationNameSources != nil {
+		for _, v := range *input.ClientAuthentication.AlternativeAuthenticationNameSources {
+			authenticationNameSources = append(authenticationNameSources, string(v))
+		}
+	}
+
+	dynamicEnrichments := make([]EventGridNamespaceRoutingEnrichmentModel, 0)
+	staticEnrichments := make([]EventGridNamespaceRoutingEnrichmentModel, 0)
+	if enrichments := input.RoutingEnrichments; enrichments != nil {
+		if enrichments.Dynamic != nil {
+			for _, v := range *enrichments.Dynamic {
+				dynamicEnrichments = append(dynamicEnrichments, EventGridNamespaceRoutingEnrichmentModel{
+					Key:   pointer.From(v.Key),
+					Value: pointer.From(v.Value),
+				})
+			}
+		}
+
+		if enrichments.Static != nil {
+			for _, v := range *enrichments.Static {
+				if enrichment, ok := v.(namespaces.StaticStringRoutingEnrichment); ok {
+					staticEnrichments = append(staticEnrichments, EventGridNamespaceRoutingEnrichmentModel{
+						Key:   pointer.From(enrichment.Key),
+						Value: pointer.From(enrichment.Value),
+					})
+				}
+			}
+		}
+	}
+
+	return []EventGridNamespaceTopicSpacesModel{
+		{
+			AlternativeAuthenticationNameSources:       authenticationNameSources,
+			MaximumClientSessionsPerAuthenticationName: pointer.From(input.MaximumClientSessionsPerAuthenticationName),
+			MaximumSessionExpiryInHours:                pointer.From(input.MaximumSessionExpiryInHours),
+			RouteTopicId:                               pointer.From(input.RouteTopicResourceId),
+			DynamicRoutingEnrichment:                   dynamicEnrichments,
+			StaticRoutingEnrichment:                    staticEnrichments,
+			Hostname:                                   pointer.From(input.Hostname),
+		},
+	}
+}
@@ -4,6 +4,7 @@
 package eventhub
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,8 +12,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2022-01-01-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
@@ -68,10 +69,42 @@ func resourceEventHub() *pluginsdk.Resource {
 
 			"message_retention": {
 				Type:         pluginsdk.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validate.ValidateEventHubMessageRetentionCount,
 			},
 
+			"retention_description": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cleanup_policy": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(eventhubs.CleanupPolicyRetentionDescriptionCompact),
+								string(eventhubs.CleanupPolicyRetentionDescriptionDelete),
+							}, false),
+						},
+
+						"retention_time_in_hours": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 2160),
+						},
+
+						"tombstone_retention_time_in_hours": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 2160),
+						},
+					},
+				},
+			},
+
 			"capture_description": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -162,6 +195,8 @@ func resourceEventHub() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(eventHubRetentionDescriptionCustomizeDiff),
 	}
 }
 
@@ -191,12 +226,13 @@ func resourceEventHubCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	eventhubStatus := eventhubs.EntityStatus(d.Get("status").(string))
 	parameters := eventhubs.Eventhub{
 		Properties: &eventhubs.EventhubProperties{
-			PartitionCount:         utils.Int64(int64(d.Get("partition_count").(int))),
-			MessageRetentionInDays: utils.Int64(int64(d.Get("message_retention").(int))),
-			Status:                 &eventhubStatus,
+			PartitionCount: utils.Int64(int64(d.Get("partition_count").(int))),
+			Status:         &eventhubStatus,
 		},
 	}
 
+	expandEventHubRetention(d, parameters.Properties)
+
 	if _, ok := d.GetOk("capture_description"); ok {
 		parameters.Properties.CaptureDescription = expandEventHubCaptureDescription(d)
 	}
@@ -243,13 +279,14 @@ func resourceEventHubUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	eventhubStatus := eventhubs.EntityStatus(d.Get("status").(string))
 	parameters := eventhubs.Eventhub{
 		Properties: &eventhubs.EventhubProperties{
-			PartitionCount:         utils.Int64(int64(d.Get("partition_count").(int))),
-			MessageRetentionInDays: utils.Int64(int64(d.Get("message_retention").(int))),
-			Status:                 &eventhubStatus,
-			CaptureDescription:     expandEventHubCaptureDescription(d),
+			PartitionCount:     utils.Int64(int64(d.Get("partition_count").(int))),
+			Status:             &eventhubStatus,
+			CaptureDescription: expandEventHubCaptureDescription(d),
 		},
 	}
 
+	expandEventHubRetention(d, parameters.Properties)
+
 	if d.HasChange("capture_description") {
 		parameters.Properties.CaptureDescription = expandEventHubCaptureDescription(d)
 	}
@@ -291,6 +328,10 @@ func resourceEventHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			d.Set("partition_count", props.PartitionCount)
 			d.Set("message_retention", props.MessageRetentionInDays)
 			d.Set("partition_ids", props.PartitionIds)
+
+			if err := d.Set("retention_description", flattenEventHubRetentionDescription(props.RetentionDescription)); err != nil {
+				return fmt.Errorf("setting `retention_description`: %+v", err)
+			}
 			d.Set("status", string(*props.Status))
 
 			captureDescription := flattenEventHubCaptureDescription(props.CaptureDescription)
@@ -325,6 +366,109 @@ func resourceEventHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func eventHubRetentionDescriptionCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// both fields are Computed, so the raw config is used to determine which of these is being managed
+	config := d.GetRawConfig().AsValueMap()
+	retentionDescription := config["retention_description"]
+	if !retentionDescription.IsKnown() {
+		return nil
+	}
+
+	messageRetentionSpecified := !config["message_retention"].IsNull()
+	retentionDescriptionSpecified := !retentionDescription.IsNull() && retentionDescription.LengthInt() > 0
+	if messageRetentionSpecified == retentionDescriptionSpecified {
+		return fmt.Errorf("exactly one of `message_retention` or `retention_description` must be specified")
+	}
+	if messageRetentionSpecified || !retentionDescription.IsWhollyKnown() {
+		return nil
+	}
+
+	inputs := d.Get("retention_description").([]interface{})
+	if len(inputs) == 0 || inputs[0] == nil {
+		return nil
+	}
+	input := inputs[0].(map[string]interface{})
+
+	retentionTimeInHours := input["retention_time_in_hours"].(int)
+	tombstoneRetentionTimeInHours := input["tombstone_retention_time_in_hours"].(int)
+
+	switch eventhubs.CleanupPolicyRetentionDescription(input["cleanup_policy"].(string)) {
+	case eventhubs.CleanupPolicyRetentionDescriptionCompact:
+		if retentionTimeInHours != 0 {
+			return fmt.Errorf("`retention_time_in_hours` cannot be specified when `cleanup_policy` is `Compact`")
+		}
+		if tombstoneRetentionTimeInHours == 0 {
+			return fmt.Errorf("`tombstone_retention_time_in_hours` must be specified when `cleanup_policy` is `Compact`")
+		}
+	case eventhubs.CleanupPolicyRetentionDescriptionDelete:
+		if tombstoneRetentionTimeInHours != 0 {
+			return fmt.Errorf("`tombstone_retention_time_in_hours` can only be specified when `cleanup_policy` is `Compact`")
+		}
+		if retentionTimeInHours == 0 {
+			return fmt.Errorf("`retention_time_in_hours` must be specified when `cleanup_policy` is `Delete`")
+		}
+	}
+
+	return nil
+}
+
+func expandEventHubRetention(d *pluginsdk.ResourceData, props *eventhubs.EventhubProperties) {
+	// both fields are Computed, so the raw config is used to determine which of the two is being managed
+	if v := d.GetRawConfig().AsValueMap()["message_retention"]; !v.IsNull() {
+		props.MessageRetentionInDays = utils.Int64(int64(d.Get("message_retention").(int)))
+		return
+	}
+
+	inputs := d.Get("retention_description").([]interface{})
+	if len(inputs) == 0 || inputs[0] == nil {
+		return
+	}
+	input := inputs[0].(map[string]interface{})
+
+	cleanupPolicy := eventhubs.CleanupPolicyRetentionDescription(input["cleanup_policy"].(string))
+	retentionDescription := eventhubs.RetentionDescription{
+		CleanupPolicy: &cleanupPolicy,
+	}
+
+	switch cleanupPolicy {
+	case eventhubs.CleanupPolicyRetentionDescriptionCompact:
+		retentionDescription.TombstoneRetentionTimeInHours = utils.Int64(int64(input["tombstone_retention_time_in_hours"].(int)))
+	case eventhubs.CleanupPolicyRetentionDescriptionDelete:
+		retentionDescription.RetentionTimeInHours = utils.Int64(int64(input["retention_time_in_hours"].(int)))
+	}
+
+	props.RetentionDescription = &retentionDescription
+}
+
+func flattenEventHubRetentionDescription(input *eventhubs.RetentionDescription) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	cleanupPolicy := ""
+	if input.CleanupPolicy != nil {
+		cleanupPolicy = string(*input.CleanupPolicy)
+	}
+
+	retentionTimeInHours := 0
+	if input.RetentionTimeInHours != nil {
+		retentionTimeInHours = int(*input.RetentionTimeInHours)
+	}
+
+	tombstoneRetentionTimeInHours := 0
+	if input.TombstoneRetentionTimeInHours != nil {
+		tombstoneRetentionTimeInHours = int(*input.TombstoneRetentionTimeInHours)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cleanup_policy":                    cleanupPolicy,
+			"retention_time_in_hours":           retentionTimeInHours,
+			"tombstone_retention_time_in_hours": tombstoneRetentionTimeInHours,
+		},
+	}
+}
+
 func expandEventHubCaptureDescription(d *pluginsdk.ResourceData) *eventhubs.CaptureDescription {
 	inputs := d.Get("capture_description").([]interface{})
 	if len(inputs) == 0 || inputs[0] == nil {
@@ -914,12 +914,12 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 					"http_port": {
 						Type:         pluginsdk.TypeInt,
 						Optional:     true,
-						ValidateFunc: validation.IntBetween(0, 35536),
+						ValidateFunc: validation.IntBetween(0, 65535),
 					},
 					"https_port": {
 						Type:         pluginsdk.TypeInt,
 						Optional:     true,
-						ValidateFunc: validation.IntBetween(0, 35536),
+						ValidateFunc: validation.IntBetween(0, 65535),
 					},
 					"enable_pac_file": {
 						Type:     pluginsdk.TypeBool,
@@ -928,7 +928,7 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 					"pac_file_port": {
 						Type:         pluginsdk.TypeInt,
 						Optional:     true,
-						ValidateFunc: validation.IntBetween(0, 35536),
+						ValidateFunc: validation.IntBetween(0, 65535),
 					},
 					"pac_file": {
 						Type:         pluginsdk.TypeString,
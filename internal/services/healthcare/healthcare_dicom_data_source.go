@@ -86,6 +86,24 @@ func dataSourceHealthcareDicomService() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"data_lake": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"storage_account_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"file_system_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.TagsDataSource(),
 		},
 	}
@@ -123,6 +141,14 @@ func dataSourceHealthcareApisDicomServiceRead(d *pluginsdk.ResourceData, meta in
 			d.Set("authentication", flattenDicomAuthentication(props.AuthenticationConfiguration))
 			d.Set("private_endpoint", flattenDicomServicePrivateEndpoint(props.PrivateEndpointConnections))
 			d.Set("service_url", props.ServiceUrl)
+
+			dataLake, err := flattenDicomStorageConfiguration(props.StorageConfiguration)
+			if err != nil {
+				return err
+			}
+			if err := d.Set("data_lake", dataLake); err != nil {
+				return fmt.Errorf("setting `data_lake`: %+v", err)
+			}
 		}
 
 		i, err := identity.FlattenLegacySystemAndUserAssignedMap(m.Identity)
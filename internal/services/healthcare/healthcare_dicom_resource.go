@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
@@ -115,6 +117,30 @@ func resourceHealthcareApisDicomService() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"data_lake": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"storage_account_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: commonids.ValidateStorageAccountID,
+						},
+
+						"file_system_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -156,7 +182,8 @@ func resourceHealthcareApisDicomServiceCreate(d *pluginsdk.ResourceData, meta in
 	parameters := dicomservices.DicomService{
 		Identity: i,
 		Properties: &dicomservices.DicomServiceProperties{
-			PublicNetworkAccess: pointer.To(dicomservices.PublicNetworkAccessEnabled),
+			PublicNetworkAccess:  pointer.To(dicomservices.PublicNetworkAccessEnabled),
+			StorageConfiguration: expandDicomStorageConfiguration(d.Get("data_lake").([]interface{})),
 		},
 		Location: pointer.To(location.Normalize(d.Get("location").(string))),
 		Tags:     tags.Expand(t),
@@ -206,6 +233,14 @@ func resourceHealthcareApisDicomServiceRead(d *pluginsdk.ResourceData, meta inte
 			d.Set("private_endpoint", flattenDicomServicePrivateEndpoint(props.PrivateEndpointConnections))
 			d.Set("service_url", props.ServiceUrl)
 
+			dataLake, err := flattenDicomStorageConfiguration(props.StorageConfiguration)
+			if err != nil {
+				return err
+			}
+			if err := d.Set("data_lake", dataLake); err != nil {
+				return fmt.Errorf("setting `data_lake`: %+v", err)
+			}
+
 			if pna := pointer.From(props.PublicNetworkAccess); pna != "" {
 				d.Set("public_network_access_enabled", pointer.From(props.PublicNetworkAccess) == dicomservices.PublicNetworkAccessEnabled)
 			}
@@ -242,7 +277,8 @@ func resourceHealthcareApisDicomServiceUpdate(d *pluginsdk.ResourceData, meta in
 	parameters := dicomservices.DicomService{
 		Location: pointer.To(location.Normalize(d.Get("location").(string))),
 		Properties: &dicomservices.DicomServiceProperties{
-			PublicNetworkAccess: pointer.To(dicomservices.PublicNetworkAccessEnabled),
+			PublicNetworkAccess:  pointer.To(dicomservices.PublicNetworkAccessEnabled),
+			StorageConfiguration: expandDicomStorageConfiguration(d.Get("data_lake").([]interface{})),
 		},
 		Identity: i,
 	}
@@ -355,6 +391,36 @@ func flattenDicomAuthentication(input *dicomservices.DicomServiceAuthenticationC
 	return []interface{}{authBlock}
 }
 
+func expandDicomStorageConfiguration(input []interface{}) *dicomservices.StorageConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &dicomservices.StorageConfiguration{
+		FileSystemName:    pointer.To(raw["file_system_name"].(string)),
+		StorageResourceId: pointer.To(raw["storage_account_id"].(string)),
+	}
+}
+
+func flattenDicomStorageConfiguration(input *dicomservices.StorageConfiguration) ([]interface{}, error) {
+	if input == nil || input.StorageResourceId == nil {
+		return []interface{}{}, nil
+	}
+
+	storageAccountId, err := commonids.ParseStorageAccountIDInsensitively(*input.StorageResourceId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `storage_account_id`: %+v", err)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"file_system_name":   pointer.From(input.FileSystemName),
+			"storage_account_id": storageAccountId.ID(),
+		},
+	}, nil
+}
+
 func flattenDicomServicePrivateEndpoint(input *[]dicomservices.PrivateEndpointConnection) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
@@ -86,6 +86,20 @@ func TestAccHealthCareDicomResource_updateUserAssignedIdentity(t *testing.T) {
 	})
 }
 
+func TestAccHealthCareDicomResource_dataLake(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_healthcare_dicom_service", "test")
+	r := HealthCareDicomResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataLake(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r)),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccHealthCareDicomResource_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_healthcare_dicom_service", "test")
 	r := HealthCareDicomResource{}
@@ -193,6 +207,56 @@ resource "azurerm_healthcare_dicom_service" "test" {
 `, r.template(data), data.RandomInteger, data.RandomIntOfLength(10), data.Locations.Primary)
 }
 
+func (r HealthCareDicomResource) dataLake(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctest-%d"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_healthcare_dicom_service" "test" {
+  name         = "dicom%d"
+  workspace_id = azurerm_healthcare_workspace.test.id
+  location     = "%s"
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  data_lake {
+    storage_account_id = azurerm_storage_account.test.id
+    file_system_name   = azurerm_storage_data_lake_gen2_filesystem.test.name
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger, data.RandomString, data.RandomInteger, data.RandomIntOfLength(10), data.Locations.Primary)
+}
+
 func (r HealthCareDicomResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -137,6 +137,29 @@ func dataSourceHealthcareApisFhirService() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"configuration_import": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"integration_data_store_storage_account_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"initial_import_mode_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -178,6 +201,9 @@ func dataSourceHealthcareApisFhirServiceRead(d *pluginsdk.ResourceData, meta int
 			if props.ExportConfiguration != nil && props.ExportConfiguration.StorageAccountName != nil {
 				d.Set("configuration_export_storage_account_name", props.ExportConfiguration.StorageAccountName)
 			}
+			if err := d.Set("configuration_import", flattenFhirImportConfiguration(props.ImportConfiguration)); err != nil {
+				return fmt.Errorf("setting `configuration_import`: %+v", err)
+			}
 		}
 
 		i, err := identity.FlattenLegacySystemAndUserAssignedMap(m.Identity)
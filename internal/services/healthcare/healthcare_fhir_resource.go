@@ -219,6 +219,33 @@ func resourceHealthcareApisFhirService() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"configuration_import": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"integration_data_store_storage_account_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"initial_import_mode_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"public_network_access_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -282,6 +309,8 @@ func resourceHealthcareApisFhirServiceCreate(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	parameters.Properties.ImportConfiguration = expandFhirImportConfiguration(d.Get("configuration_import").([]interface{}))
+
 	acrConfig := fhirservices.FhirServiceAcrConfiguration{}
 	ociArtifactsRaw, hasValues := d.GetOk("oci_artifact")
 	if hasValues {
@@ -366,6 +395,9 @@ func resourceHealthcareApisFhirServiceRead(d *pluginsdk.ResourceData, meta inter
 			if props.ExportConfiguration != nil && props.ExportConfiguration.StorageAccountName != nil {
 				d.Set("configuration_export_storage_account_name", props.ExportConfiguration.StorageAccountName)
 			}
+			if err := d.Set("configuration_import", flattenFhirImportConfiguration(props.ImportConfiguration)); err != nil {
+				return fmt.Errorf("setting `configuration_import`: %+v", err)
+			}
 			if props.PublicNetworkAccess != nil {
 				d.Set("public_network_access_enabled", pointer.From(props.PublicNetworkAccess) == fhirservices.PublicNetworkAccessEnabled)
 			}
@@ -436,6 +468,8 @@ func resourceHealthcareApisFhirServiceUpdate(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	parameters.Properties.ImportConfiguration = expandFhirImportConfiguration(d.Get("configuration_import").([]interface{}))
+
 	acrConfig := fhirservices.FhirServiceAcrConfiguration{}
 	ociArtifactsRaw, hasValues := d.GetOk("oci_artifact")
 	if hasValues {
@@ -663,6 +697,37 @@ func flattenFhirCorsConfiguration(corsConfig *fhirservices.FhirServiceCorsConfig
 	}
 }
 
+func expandFhirImportConfiguration(input []interface{}) *fhirservices.FhirServiceImportConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return &fhirservices.FhirServiceImportConfiguration{
+			Enabled:           pointer.To(false),
+			InitialImportMode: pointer.To(false),
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &fhirservices.FhirServiceImportConfiguration{
+		Enabled:              pointer.To(raw["enabled"].(bool)),
+		InitialImportMode:    pointer.To(raw["initial_import_mode_enabled"].(bool)),
+		IntegrationDataStore: pointer.To(raw["integration_data_store_storage_account_name"].(string)),
+	}
+}
+
+func flattenFhirImportConfiguration(importConfig *fhirservices.FhirServiceImportConfiguration) []interface{} {
+	// the API always returns an import configuration, so treat one without an integration data store as unset
+	if importConfig == nil || pointer.From(importConfig.IntegrationDataStore) == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":                     pointer.From(importConfig.Enabled),
+			"initial_import_mode_enabled": pointer.From(importConfig.InitialImportMode),
+			"integration_data_store_storage_account_name": pointer.From(importConfig.IntegrationDataStore),
+		},
+	}
+}
+
 func flattenFhirAuthentication(authConfig *fhirservices.FhirServiceAuthenticationConfiguration) []interface{} {
 	if authConfig == nil {
 		return []interface{}{}
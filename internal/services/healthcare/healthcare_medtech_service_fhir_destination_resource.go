@@ -85,6 +85,7 @@ func resourceHealthcareApisMedTechServiceFhirDestination() *pluginsdk.Resource {
 			"destination_fhir_mapping_json": {
 				Type:             pluginsdk.TypeString,
 				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
 				StateFunc:        utils.NormalizeJson,
 				DiffSuppressFunc: suppressJsonOrderingDifference,
 			},
@@ -124,12 +125,11 @@ func resourceHealthcareApisMedTechServiceFhirDestinationCreate(d *pluginsdk.Reso
 		},
 	}
 
-	fhirMap := iotconnectors.IotMappingProperties{}
-	fhirMappingJson := fmt.Sprintf(`{ "content": %s }`, d.Get("destination_fhir_mapping_json").(string))
-	if err := json.Unmarshal([]byte(fhirMappingJson), &fhirMap); err != nil {
-		return err
+	fhirMap, err := expandMedTechServiceFhirDestinationMapping(d.Get("destination_fhir_mapping_json").(string))
+	if err != nil {
+		return fmt.Errorf("expanding `destination_fhir_mapping_json`: %+v", err)
 	}
-	iotFhirServiceParameters.Properties.FhirMapping = fhirMap
+	iotFhirServiceParameters.Properties.FhirMapping = *fhirMap
 
 	err = client.IotConnectorFhirDestinationCreateOrUpdateThenPoll(ctx, id, iotFhirServiceParameters)
 	if err != nil {
@@ -172,22 +172,9 @@ func resourceHealthcareApisMedTechServiceFhirDestinationRead(d *pluginsdk.Resour
 		d.Set("destination_fhir_service_id", props.FhirServiceResourceId)
 
 		if props.FhirMapping.Content != nil {
-			fhirMapData, err := json.Marshal(props.FhirMapping)
+			mapContent, err := flattenMedTechServiceFhirDestinationMapping(props.FhirMapping)
 			if err != nil {
-				return err
-			}
-
-			var m map[string]*json.RawMessage
-			if err = json.Unmarshal(fhirMapData, &m); err != nil {
-				return err
-			}
-			mapContent := ""
-			if v, ok := m["content"]; ok {
-				contents, err := json.Marshal(v)
-				if err != nil {
-					return err
-				}
-				mapContent = string(contents)
+				return fmt.Errorf("flattening `destination_fhir_mapping_json`: %+v", err)
 			}
 			d.Set("destination_fhir_mapping_json", mapContent)
 		}
@@ -216,12 +203,11 @@ func resourceHealthcareApisMedTechServiceFhirDestinationUpdate(d *pluginsdk.Reso
 		},
 	}
 
-	fhirMap := iotconnectors.IotMappingProperties{}
-	fhirMappingJson := fmt.Sprintf(`{ "content": %s }`, d.Get("destination_fhir_mapping_json").(string))
-	if err := json.Unmarshal([]byte(fhirMappingJson), &fhirMap); err != nil {
-		return err
+	fhirMap, err := expandMedTechServiceFhirDestinationMapping(d.Get("destination_fhir_mapping_json").(string))
+	if err != nil {
+		return fmt.Errorf("expanding `destination_fhir_mapping_json`: %+v", err)
 	}
-	medTechFhirServiceParameters.Properties.FhirMapping = fhirMap
+	medTechFhirServiceParameters.Properties.FhirMapping = *fhirMap
 
 	err = client.IotConnectorFhirDestinationCreateOrUpdateThenPoll(ctx, id, medTechFhirServiceParameters)
 	if err != nil {
@@ -278,3 +264,34 @@ func healthcareApiMedTechServiceFhirDestinationStateCodeRefreshFunc(ctx context.
 		return resp, "Pending", nil
 	}
 }
+
+func expandMedTechServiceFhirDestinationMapping(input string) (*iotconnectors.IotMappingProperties, error) {
+	var content interface{}
+	if err := json.Unmarshal([]byte(input), &content); err != nil {
+		return nil, err
+	}
+
+	// the FHIR destination mapping must be a `CollectionFhirTemplate` (or the legacy `CollectionFhir`) template
+	if contentMap, ok := content.(map[string]interface{}); ok {
+		if templateType, ok := contentMap["templateType"].(string); ok && templateType != "CollectionFhirTemplate" && templateType != "CollectionFhir" {
+			return nil, fmt.Errorf("expected `templateType` to be `CollectionFhirTemplate` or `CollectionFhir` but got %q", templateType)
+		}
+	}
+
+	return &iotconnectors.IotMappingProperties{
+		Content: &content,
+	}, nil
+}
+
+func flattenMedTechServiceFhirDestinationMapping(input iotconnectors.IotMappingProperties) (string, error) {
+	if input.Content == nil {
+		return "", nil
+	}
+
+	contents, err := json.Marshal(input.Content)
+	if err != nil {
+		return "", err
+	}
+
+	return string(contents), nil
+}
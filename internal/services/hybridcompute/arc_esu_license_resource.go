@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-05-20-preview/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ArcEsuLicenseModel struct {
+	Name                 string            `tfschema:"name"`
+	ResourceGroupName    string            `tfschema:"resource_group_name"`
+	Location             string            `tfschema:"location"`
+	CoreType             string            `tfschema:"core_type"`
+	Edition              string            `tfschema:"edition"`
+	ProcessorCount       int64             `tfschema:"processor_count"`
+	State                string            `tfschema:"state"`
+	Target               string            `tfschema:"target"`
+	Tags                 map[string]string `tfschema:"tags"`
+	AssignedLicenseCount int64             `tfschema:"assigned_license_count"`
+	ImmutableId          string            `tfschema:"immutable_id"`
+}
+
+type ArcEsuLicenseResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcEsuLicenseResource{}
+
+func (r ArcEsuLicenseResource) ResourceType() string {
+	return "azurerm_arc_esu_license"
+}
+
+func (r ArcEsuLicenseResource) ModelObject() interface{} {
+	return &ArcEsuLicenseModel{}
+}
+
+func (r ArcEsuLicenseResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return licenses.ValidateLicenseID
+}
+
+func (r ArcEsuLicenseResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringIsNotEmpty,
+				validation.StringDoesNotContainAny("/"),
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"core_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseCoreType(), false),
+		},
+
+		"edition": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseEdition(), false),
+		},
+
+		"processor_count": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"target": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseTarget(), false),
+		},
+
+		"state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(licenses.LicenseStateActivated),
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseState(), false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcEsuLicenseResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"assigned_license_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"immutable_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcEsuLicenseResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ArcEsuLicenseModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.HybridCompute.LicensesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := licenses.NewLicenseID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := licenses.License{
+				Location: location.Normalize(model.Location),
+				Properties: &licenses.LicenseProperties{
+					LicenseType: pointer.To(licenses.LicenseTypeESU),
+					LicenseDetails: &licenses.LicenseDetails{
+						Edition:    pointer.To(licenses.LicenseEdition(model.Edition)),
+						Processors: pointer.To(model.ProcessorCount),
+						State:      pointer.To(licenses.LicenseState(model.State)),
+						Target:     pointer.To(licenses.LicenseTarget(model.Target)),
+						Type:       pointer.To(licenses.LicenseCoreType(model.CoreType)),
+					},
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcEsuLicenseResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicensesClient
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ArcEsuLicenseModel{
+				Name:              id.LicenseName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if details := props.LicenseDetails; details != nil {
+						state.AssignedLicenseCount = pointer.From(details.AssignedLicenses)
+						state.CoreType = string(pointer.From(details.Type))
+						state.Edition = string(pointer.From(details.Edition))
+						state.ImmutableId = pointer.From(details.ImmutableId)
+						state.ProcessorCount = pointer.From(details.Processors)
+						state.State = string(pointer.From(details.State))
+						state.Target = string(pointer.From(details.Target))
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcEsuLicenseResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicensesClient
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcEsuLicenseModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := licenses.LicenseUpdate{
+				Properties: &licenses.LicenseUpdateProperties{
+					LicenseDetails: &licenses.LicenseUpdatePropertiesLicenseDetails{},
+				},
+			}
+			details := payload.Properties.LicenseDetails
+
+			if metadata.ResourceData.HasChange("core_type") {
+				details.Type = pointer.To(licenses.LicenseCoreType(model.CoreType))
+			}
+
+			if metadata.ResourceData.HasChange("edition") {
+				details.Edition = pointer.To(licenses.LicenseEdition(model.Edition))
+			}
+
+			if metadata.ResourceData.HasChange("processor_count") {
+				details.Processors = pointer.To(model.ProcessorCount)
+			}
+
+			if metadata.ResourceData.HasChange("state") {
+				details.State = pointer.To(licenses.LicenseState(model.State))
+			}
+
+			if metadata.ResourceData.HasChange("target") {
+				details.Target = pointer.To(licenses.LicenseTarget(model.Target))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcEsuLicenseResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicensesClient
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-05-20-preview/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcEsuLicenseResource struct{}
+
+func TestAccArcEsuLicense_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_esu_license", "test")
+	r := ArcEsuLicenseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("immutable_id").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcEsuLicense_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_esu_license", "test")
+	r := ArcEsuLicenseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccArcEsuLicense_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_esu_license", "test")
+	r := ArcEsuLicenseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ArcEsuLicenseResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := licenses.ParseLicenseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.LicensesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ArcEsuLicenseResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-esu-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_arc_esu_license" "test" {
+  name                = "acctest-esu-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  core_type           = "vCore"
+  edition             = "Standard"
+  processor_count     = 8
+  target              = "Windows Server 2012"
+  state               = "Deactivated"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ArcEsuLicenseResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_esu_license" "import" {
+  name                = azurerm_arc_esu_license.test.name
+  resource_group_name = azurerm_arc_esu_license.test.resource_group_name
+  location            = azurerm_arc_esu_license.test.location
+  core_type           = azurerm_arc_esu_license.test.core_type
+  edition             = azurerm_arc_esu_license.test.edition
+  processor_count     = azurerm_arc_esu_license.test.processor_count
+  target              = azurerm_arc_esu_license.test.target
+  state               = azurerm_arc_esu_license.test.state
+}
+`, r.basic(data))
+}
+
+func (r ArcEsuLicenseResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-esu-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_arc_esu_license" "test" {
+  name                = "acctest-esu-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  core_type           = "pCore"
+  edition             = "Datacenter"
+  processor_count     = 16
+  target              = "Windows Server 2012 R2"
+  state               = "Deactivated"
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-05-20-preview/licenseprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachineLicenseProfileResource struct{}
+
+func TestAccArcMachineLicenseProfile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+	template := ArcMachineExtensionResource{}.template(data)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(template),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineLicenseProfile_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+	template := ArcMachineExtensionResource{}.template(data)
+	basicConfig := r.basic(template)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: basicConfig,
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(basicConfig),
+			ExpectError: acceptance.RequiresImportError("azurerm_arc_machine_license_profile"),
+		},
+	})
+}
+
+func TestAccArcMachineLicenseProfile_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+	template := ArcMachineExtensionResource{}.template(data)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(template),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.esuLicense(data, template),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(template),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ArcMachineLicenseProfileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ArcMachineLicenseProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.LicenseProfilesClient.Get(ctx, licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ArcMachineLicenseProfileResource) basic(template string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "test" {
+  arc_machine_id                      = data.azurerm_arc_machine.test.id
+  software_assurance_customer_enabled = true
+}
+`, template)
+}
+
+func (r ArcMachineLicenseProfileResource) requiresImport(basicConfig string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "import" {
+  arc_machine_id                      = azurerm_arc_machine_license_profile.test.arc_machine_id
+  software_assurance_customer_enabled = azurerm_arc_machine_license_profile.test.software_assurance_customer_enabled
+}
+`, basicConfig)
+}
+
+func (r ArcMachineLicenseProfileResource) esuLicense(data acceptance.TestData, template string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_esu_license" "test" {
+  name                = "acctest-esu-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  core_type           = "vCore"
+  edition             = "Standard"
+  processor_count     = 8
+  target              = "Windows Server 2012"
+}
+
+resource "azurerm_arc_machine_license_profile" "test" {
+  arc_machine_id                      = data.azurerm_arc_machine.test.id
+  esu_license_id                      = azurerm_arc_esu_license.test.id
+  software_assurance_customer_enabled = false
+}
+`, template, data.RandomInteger)
+}
@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ArcMachinePatchSettingsModel struct {
+	ArcMachineId   string `tfschema:"arc_machine_id"`
+	AssessmentMode string `tfschema:"assessment_mode"`
+	PatchMode      string `tfschema:"patch_mode"`
+}
+
+type ArcMachinePatchSettingsResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcMachinePatchSettingsResource{}
+
+func (r ArcMachinePatchSettingsResource) ResourceType() string {
+	return "azurerm_arc_machine_patch_settings"
+}
+
+func (r ArcMachinePatchSettingsResource) ModelObject() interface{} {
+	return &ArcMachinePatchSettingsModel{}
+}
+
+func (r ArcMachinePatchSettingsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return machines.ValidateMachineID
+}
+
+func (r ArcMachinePatchSettingsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"assessment_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(machines.AssessmentModeTypesImageDefault),
+			ValidateFunc: validation.StringInSlice(machines.PossibleValuesForAssessmentModeTypes(), false),
+		},
+
+		"patch_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(machines.PatchModeTypesImageDefault),
+			ValidateFunc: validation.StringInSlice(machines.PossibleValuesForPatchModeTypes(), false),
+		},
+	}
+}
+
+func (r ArcMachinePatchSettingsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ArcMachinePatchSettingsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ArcMachinePatchSettingsModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.HybridCompute.MachinesClient
+
+			id, err := machines.ParseMachineID(model.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id, machines.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			// the Patch Settings of a Machine always exist, so they're treated as present once configured away from the defaults
+			if current := flattenArcMachinePatchSettings(existing.Model.Properties); current.AssessmentMode != string(machines.AssessmentModeTypesImageDefault) || current.PatchMode != string(machines.PatchModeTypesImageDefault) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandArcMachinePatchSettingsUpdate(existing.Model.Properties.OsType, model.AssessmentMode, model.PatchMode)
+			if err != nil {
+				return fmt.Errorf("expanding patch settings for %s: %+v", *id, err)
+			}
+
+			if _, err := client.Update(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating patch settings for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachinePatchSettingsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachinesClient
+
+			id, err := machines.ParseMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, machines.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var props *machines.MachineProperties
+			if model := resp.Model; model != nil {
+				props = model.Properties
+			}
+
+			state := flattenArcMachinePatchSettings(props)
+			state.ArcMachineId = id.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachinePatchSettingsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachinesClient
+
+			id, err := machines.ParseMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachinePatchSettingsModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id, machines.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload, err := expandArcMachinePatchSettingsUpdate(existing.Model.Properties.OsType, model.AssessmentMode, model.PatchMode)
+			if err != nil {
+				return fmt.Errorf("expanding patch settings for %s: %+v", *id, err)
+			}
+
+			if _, err := client.Update(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating patch settings for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachinePatchSettingsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachinesClient
+
+			id, err := machines.ParseMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id, machines.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			// the Patch Settings can't be removed from a Machine, so they're reset to the defaults instead
+			payload, err := expandArcMachinePatchSettingsUpdate(existing.Model.Properties.OsType, string(machines.AssessmentModeTypesImageDefault), string(machines.PatchModeTypesImageDefault))
+			if err != nil {
+				return fmt.Errorf("expanding patch settings for %s: %+v", *id, err)
+			}
+
+			if _, err := client.Update(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("resetting patch settings for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandArcMachinePatchSettingsUpdate(osType *string, assessmentMode string, patchMode string) (*machines.MachineUpdate, error) {
+	patchSettings := &machines.PatchSettings{
+		AssessmentMode: pointer.To(machines.AssessmentModeTypes(assessmentMode)),
+		PatchMode:      pointer.To(machines.PatchModeTypes(patchMode)),
+	}
+
+	osProfile := &machines.OSProfile{}
+	switch strings.ToLower(pointer.From(osType)) {
+	case "windows":
+		osProfile.WindowsConfiguration = &machines.OSProfileWindowsConfiguration{
+			PatchSettings: patchSettings,
+		}
+	case "linux":
+		osProfile.LinuxConfiguration = &machines.OSProfileLinuxConfiguration{
+			PatchSettings: patchSettings,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported OS Type %q", pointer.From(osType))
+	}
+
+	return &machines.MachineUpdate{
+		Properties: &machines.MachineUpdateProperties{
+			OsProfile: osProfile,
+		},
+	}, nil
+}
+
+func flattenArcMachinePatchSettings(input *machines.MachineProperties) ArcMachinePatchSettingsModel {
+	output := ArcMachinePatchSettingsModel{
+		AssessmentMode: string(machines.AssessmentModeTypesImageDefault),
+		PatchMode:      string(machines.PatchModeTypesImageDefault),
+	}
+
+	if input == nil || input.OsProfile == nil {
+		return output
+	}
+
+	var patchSettings *machines.PatchSettings
+	if v := input.OsProfile.WindowsConfiguration; v != nil && v.PatchSettings != nil {
+		patchSettings = v.PatchSettings
+	}
+	if v := input.OsProfile.LinuxConfiguration; v != nil && v.PatchSettings != nil {
+		patchSettings = v.PatchSettings
+	}
+
+	if patchSettings != nil {
+		if patchSettings.AssessmentMode != nil {
+			output.AssessmentMode = string(*patchSettings.AssessmentMode)
+		}
+		if patchSettings.PatchMode != nil {
+			output.PatchMode = string(*patchSettings.PatchMode)
+		}
+	}
+
+	return output
+}
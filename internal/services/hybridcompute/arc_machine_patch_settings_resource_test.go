@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachinePatchSettingsResource struct{}
+
+func TestAccArcMachinePatchSettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_patch_settings", "test")
+	r := ArcMachinePatchSettingsResource{}
+	template := ArcMachineExtensionResource{}.template(data)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(template),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assessment_mode").HasValue("AutomaticByPlatform"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachinePatchSettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_patch_settings", "test")
+	r := ArcMachinePatchSettingsResource{}
+	template := ArcMachineExtensionResource{}.template(data)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(template),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(template),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("patch_mode").HasValue("AutomaticByPlatform"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(template),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ArcMachinePatchSettingsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := machines.ParseMachineID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.MachinesClient.Get(ctx, *id, machines.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ArcMachinePatchSettingsResource) basic(template string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_patch_settings" "test" {
+  arc_machine_id  = data.azurerm_arc_machine.test.id
+  assessment_mode = "AutomaticByPlatform"
+}
+`, template)
+}
+
+func (r ArcMachinePatchSettingsResource) complete(template string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_patch_settings" "test" {
+  arc_machine_id  = data.azurerm_arc_machine.test.id
+  assessment_mode = "AutomaticByPlatform"
+  patch_mode      = "AutomaticByPlatform"
+}
+`, template)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privatelinkscopes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachinePrivateLinkScopeAssociationModel struct {
+	ArcMachineId       string `tfschema:"arc_machine_id"`
+	PrivateLinkScopeId string `tfschema:"private_link_scope_id"`
+}
+
+type ArcMachinePrivateLinkScopeAssociationResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcMachinePrivateLinkScopeAssociationResource{}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) ResourceType() string {
+	return "azurerm_arc_machine_private_link_scope_association"
+}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) ModelObject() interface{} {
+	return &ArcMachinePrivateLinkScopeAssociationModel{}
+}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return machines.ValidateMachineID
+}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"private_link_scope_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: privatelinkscopes.ValidateProviderPrivateLinkScopeID,
+		},
+	}
+}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ArcMachinePrivateLinkScopeAssociationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.HybridCompute.MachinesClient
+
+			id, err := machines.ParseMachineID(model.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id, machines.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			if pointer.From(existing.Model.Properties.PrivateLinkScopeResourceId) != "" {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := machines.MachineUpdate{
+				Properties: &machines.MachineUpdateProperties{
+					PrivateLinkScopeResourceId: pointer.To(model.PrivateLinkScopeId),
+				},
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("associating %s with %s: %+v", *id, model.PrivateLinkScopeId, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachinesClient
+
+			id, err := machines.ParseMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, machines.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var privateLinkScopeId string
+			if model := resp.Model; model != nil && model.Properties != nil {
+				privateLinkScopeId = pointer.From(model.Properties.PrivateLinkScopeResourceId)
+			}
+
+			if privateLinkScopeId == "" {
+				return metadata.MarkAsGone(id)
+			}
+
+			scopeId, err := privatelinkscopes.ParseProviderPrivateLinkScopeIDInsensitively(privateLinkScopeId)
+			if err != nil {
+				return err
+			}
+
+			state := ArcMachinePrivateLinkScopeAssociationModel{
+				ArcMachineId:       id.ID(),
+				PrivateLinkScopeId: scopeId.ID(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachinesClient
+
+			id, err := machines.ParseMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachinePrivateLinkScopeAssociationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			payload := machines.MachineUpdate{
+				Properties: &machines.MachineUpdateProperties{
+					PrivateLinkScopeResourceId: pointer.To(model.PrivateLinkScopeId),
+				},
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("associating %s with %s: %+v", *id, model.PrivateLinkScopeId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachinesClient
+
+			id, err := machines.ParseMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			// an empty string removes the Private Link Scope from the Machine
+			payload := machines.MachineUpdate{
+				Properties: &machines.MachineUpdateProperties{
+					PrivateLinkScopeResourceId: pointer.To(""),
+				},
+			}
+
+			if resp, err := client.Update(ctx, *id, payload); err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("removing the Private Link Scope from %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachinePrivateLinkScopeAssociationResource struct{}
+
+func TestAccArcMachinePrivateLinkScopeAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_private_link_scope_association", "test")
+	r := ArcMachinePrivateLinkScopeAssociationResource{}
+	template := ArcMachineExtensionResource{}.template(data)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(template, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachinePrivateLinkScopeAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_private_link_scope_association", "test")
+	r := ArcMachinePrivateLinkScopeAssociationResource{}
+	template := ArcMachineExtensionResource{}.template(data)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(template, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(template, data)
+		}),
+	})
+}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := machines.ParseMachineID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.MachinesClient.Get(ctx, *id, machines.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		return pointer.To(pointer.From(model.Properties.PrivateLinkScopeResourceId) != ""), nil
+	}
+	return pointer.To(false), nil
+}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) basic(template string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_private_link_scope" "test" {
+  name                          = "acctestPLS-%d"
+  resource_group_name           = azurerm_resource_group.test.name
+  location                      = azurerm_resource_group.test.location
+  public_network_access_enabled = true
+}
+
+resource "azurerm_arc_machine_private_link_scope_association" "test" {
+  arc_machine_id        = data.azurerm_arc_machine.test.id
+  private_link_scope_id = azurerm_arc_private_link_scope.test.id
+}
+`, template, data.RandomInteger)
+}
+
+func (r ArcMachinePrivateLinkScopeAssociationResource) requiresImport(template string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_private_link_scope_association" "import" {
+  arc_machine_id        = azurerm_arc_machine_private_link_scope_association.test.arc_machine_id
+  private_link_scope_id = azurerm_arc_machine_private_link_scope_association.test.private_link_scope_id
+}
+`, r.basic(template, data))
+}
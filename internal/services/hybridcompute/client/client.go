@@ -10,10 +10,14 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privateendpointconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privatelinkscopes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-05-20-preview/licenseprofiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-05-20-preview/licenses"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
+	LicenseProfilesClient            *licenseprofiles.LicenseProfilesClient
+	LicensesClient                   *licenses.LicensesClient
 	MachineExtensionsClient          *machineextensions.MachineExtensionsClient
 	MachinesClient                   *machines.MachinesClient
 	PrivateEndpointConnectionsClient *privateendpointconnections.PrivateEndpointConnectionsClient
@@ -21,6 +25,18 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	licenseProfilesClient, err := licenseprofiles.NewLicenseProfilesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building LicenseProfiles client: %+v", err)
+	}
+	o.Configure(licenseProfilesClient.Client, o.Authorizers.ResourceManager)
+
+	licensesClient, err := licenses.NewLicensesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Licenses client: %+v", err)
+	}
+	o.Configure(licensesClient.Client, o.Authorizers.ResourceManager)
+
 	machineExtensionsClient, err := machineextensions.NewMachineExtensionsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building MachineExtensions client: %+v", err)
@@ -46,6 +62,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(privateLinkScopesClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
+		LicenseProfilesClient:            licenseProfilesClient,
+		LicensesClient:                   licensesClient,
 		MachineExtensionsClient:          machineExtensionsClient,
 		MachinesClient:                   machinesClient,
 		PrivateEndpointConnectionsClient: privateEndpointConnectionsClient,
@@ -58,7 +58,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns a list of Resources supported by this Service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ArcEsuLicenseResource{},
 		ArcMachineExtensionResource{},
+		ArcMachineLicenseProfileResource{},
+		ArcMachinePatchSettingsResource{},
+		ArcMachinePrivateLinkScopeAssociationResource{},
 		ArcPrivateLinkScopeResource{},
 	}
 }
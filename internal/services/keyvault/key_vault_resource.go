@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/nameavailability"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
@@ -241,6 +242,10 @@ func resourceKeyVault() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			nameavailability.CustomizeDiff("Key Vault", keyVaultNameAvailability),
+		),
 	}
 
 	return resource
@@ -1093,3 +1098,35 @@ func getSoftDeletedStateForKeyVault(ctx context.Context, client *vaults.VaultsCl
 	// otherwise we've found an existing key vault that is not soft deleted
 	return nil, nil
 }
+
+func keyVaultNameAvailability(ctx context.Context, client *clients.Client, d *pluginsdk.ResourceDiff, name string) (*nameavailability.Result, error) {
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+	input := vaults.VaultCheckNameAvailabilityParameters{
+		Name: name,
+		Type: vaults.TypeMicrosoftPointKeyVaultVaults,
+	}
+	resp, err := client.KeyVault.VaultsClient.CheckNameAvailability(ctx, subscriptionId, input)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Model == nil || resp.Model.NameAvailable == nil {
+		return nil, fmt.Errorf("`model` was nil")
+	}
+
+	result := &nameavailability.Result{
+		Available: *resp.Model.NameAvailable,
+		Message:   pointer.From(resp.Model.Message),
+	}
+
+	// the name of a soft-deleted Key Vault isn't available, however this is recovered during the Create (rather than
+	// a new Key Vault being created) when it exists within this Subscription and the user hasn't opted out of this
+	if !result.Available && client.Features.KeyVault.RecoverSoftDeletedKeyVaults && d.NewValueKnown("location") {
+		deletedVaultId := vaults.NewDeletedVaultID(subscriptionId.SubscriptionId, location.Normalize(d.Get("location").(string)), name)
+		deleted, err := client.KeyVault.VaultsClient.GetDeleted(ctx, deletedVaultId)
+		if err == nil && deleted.Model != nil {
+			result.Available = true
+		}
+	}
+
+	return result, nil
+}
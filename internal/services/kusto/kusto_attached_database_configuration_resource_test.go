@@ -32,6 +32,37 @@ func TestAccKustoAttachedDatabaseConfiguration_basic(t *testing.T) {
 	})
 }
 
+func TestAccKustoAttachedDatabaseConfiguration_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_attached_database_configuration", "test")
+	r := KustoAttachedDatabaseConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKustoAttachedDatabaseConfiguration_databaseNamePrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_attached_database_configuration", "test")
+	r := KustoAttachedDatabaseConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.databaseNamePrefix(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("database_name_prefix").HasValue("follower_"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (KustoAttachedDatabaseConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := attacheddatabaseconfigurations.ParseAttachedDatabaseConfigurationID(state.ID)
 	if err != nil {
@@ -52,7 +83,77 @@ func (KustoAttachedDatabaseConfigurationResource) Exists(ctx context.Context, cl
 	return &exists, nil
 }
 
-func (KustoAttachedDatabaseConfigurationResource) basic(data acceptance.TestData) string {
+func (r KustoAttachedDatabaseConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_attached_database_configuration" "test" {
+  name                = "acctestka-%d"
+  resource_group_name = azurerm_resource_group.rg.name
+  location            = azurerm_resource_group.rg.location
+  cluster_name        = azurerm_kusto_cluster.cluster1.name
+  cluster_resource_id = azurerm_kusto_cluster.cluster2.id
+  database_name       = azurerm_kusto_database.test.name
+
+  sharing {
+    external_tables_to_exclude    = ["ExternalTable2"]
+    external_tables_to_include    = ["ExternalTable1"]
+    materialized_views_to_exclude = ["MaterializedViewTable2"]
+    materialized_views_to_include = ["MaterializedViewTable1"]
+    tables_to_exclude             = ["Table2"]
+    tables_to_include             = ["Table1"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KustoAttachedDatabaseConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_attached_database_configuration" "test" {
+  name                                = "acctestka-%d"
+  resource_group_name                 = azurerm_resource_group.rg.name
+  location                            = azurerm_resource_group.rg.location
+  cluster_name                        = azurerm_kusto_cluster.cluster1.name
+  cluster_resource_id                 = azurerm_kusto_cluster.cluster2.id
+  database_name                       = azurerm_kusto_database.test.name
+  database_name_override              = "acctestkdfollower-%d"
+  default_principal_modification_kind = "Union"
+
+  sharing {
+    external_tables_to_exclude    = ["ExternalTable2"]
+    external_tables_to_include    = ["ExternalTable1"]
+    functions_to_exclude          = ["Function2"]
+    functions_to_include          = ["Function1"]
+    materialized_views_to_exclude = ["MaterializedViewTable2"]
+    materialized_views_to_include = ["MaterializedViewTable1"]
+    tables_to_exclude             = ["Table2"]
+    tables_to_include             = ["Table1"]
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r KustoAttachedDatabaseConfigurationResource) databaseNamePrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_attached_database_configuration" "test" {
+  name                 = "acctestka-%d"
+  resource_group_name  = azurerm_resource_group.rg.name
+  location             = azurerm_resource_group.rg.location
+  cluster_name         = azurerm_kusto_cluster.cluster1.name
+  cluster_resource_id  = azurerm_kusto_cluster.cluster2.id
+  database_name        = "*"
+  database_name_prefix = "follower_"
+
+  depends_on = [azurerm_kusto_database.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (KustoAttachedDatabaseConfigurationResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -98,23 +199,5 @@ resource "azurerm_kusto_database" "test" {
   location            = azurerm_resource_group.rg.location
   cluster_name        = azurerm_kusto_cluster.cluster2.name
 }
-
-resource "azurerm_kusto_attached_database_configuration" "test" {
-  name                = "acctestka-%d"
-  resource_group_name = azurerm_resource_group.rg.name
-  location            = azurerm_resource_group.rg.location
-  cluster_name        = azurerm_kusto_cluster.cluster1.name
-  cluster_resource_id = azurerm_kusto_cluster.cluster2.id
-  database_name       = azurerm_kusto_database.test.name
-
-  sharing {
-    external_tables_to_exclude    = ["ExternalTable2"]
-    external_tables_to_include    = ["ExternalTable1"]
-    materialized_views_to_exclude = ["MaterializedViewTable2"]
-    materialized_views_to_include = ["MaterializedViewTable1"]
-    tables_to_exclude             = ["Table2"]
-    tables_to_include             = ["Table1"]
-  }
-}
-`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, data.RandomInteger, data.RandomInteger)
 }
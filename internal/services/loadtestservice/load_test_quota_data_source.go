@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadtestservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/quotas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type LoadTestQuotaDataSource struct{}
+
+var _ sdk.DataSource = LoadTestQuotaDataSource{}
+
+type LoadTestQuotaDataSourceModel struct {
+	Name     string `tfschema:"name"`
+	Location string `tfschema:"location"`
+	Limit    int64  `tfschema:"limit"`
+	Usage    int64  `tfschema:"usage"`
+}
+
+func (r LoadTestQuotaDataSource) ModelObject() interface{} {
+	return &LoadTestQuotaDataSourceModel{}
+}
+
+func (r LoadTestQuotaDataSource) ResourceType() string {
+	return "azurerm_load_test_quota"
+}
+
+func (r LoadTestQuotaDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": commonschema.Location(),
+	}
+}
+
+func (r LoadTestQuotaDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"limit": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"usage": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r LoadTestQuotaDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LoadTestService.V20221201.Quotas
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state LoadTestQuotaDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			id := quotas.NewQuotaID(subscriptionId, location.Normalize(state.Location), state.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state.Location = location.Normalize(id.LocationName)
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Limit = pointer.From(props.Limit)
+					state.Usage = pointer.From(props.Usage)
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
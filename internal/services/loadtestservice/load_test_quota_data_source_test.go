@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadtestservice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LoadTestQuotaTestDataSource struct{}
+
+func TestAccLoadTestQuotaDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_load_test_quota", "test")
+	d := LoadTestQuotaTestDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("limit").Exists(),
+				check.That(data.ResourceName).Key("usage").Exists(),
+			),
+		},
+	})
+}
+
+func (d LoadTestQuotaTestDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_load_test_quota" "test" {
+  name     = "maxConcurrentTestRuns"
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
@@ -28,6 +28,7 @@ func (r Registration) Name() string {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		LoadTestDataSource{},
+		LoadTestQuotaDataSource{},
 	}
 }
 
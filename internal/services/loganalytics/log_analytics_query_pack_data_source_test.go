@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LogAnalyticsQueryPackDataSource struct{}
+
+func TestAccLogAnalyticsQueryPackDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_log_analytics_query_pack", "test")
+	d := LogAnalyticsQueryPackDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("query_pack_id").Exists(),
+			),
+		},
+	})
+}
+
+func (d LogAnalyticsQueryPackDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_log_analytics_query_pack" "test" {
+  name                = azurerm_log_analytics_query_pack.test.name
+  resource_group_name = azurerm_log_analytics_query_pack.test.resource_group_name
+}
+`, LogAnalyticsQueryPackResource{}.basic(data))
+}
@@ -4,11 +4,13 @@
 package logic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -22,6 +24,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	keyVaultClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -30,6 +35,8 @@ import (
 
 var logicAppResourceName = "azurerm_logic_app"
 
+const logicAppWorkflowConnectionsParameter = "$connections"
+
 func resourceLogicAppWorkflow() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLogicAppWorkflowCreate,
@@ -218,24 +225,54 @@ func resourceLogicAppWorkflow() *pluginsdk.Resource {
 				},
 			},
 
+			"key_vault_parameter": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"key_vault_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						},
+					},
+				},
+			},
+
 			"enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
 
+			"workflow_definition": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+				ConflictsWith:    []string{"workflow_parameters", "workflow_schema", "workflow_version"},
+			},
+
 			"workflow_schema": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#",
+				ConflictsWith: []string{"workflow_definition"},
 			},
 
 			"workflow_version": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  "1.0.0.0",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       "1.0.0.0",
+				ConflictsWith: []string{"workflow_definition"},
 			},
 
 			"workflow_parameters": {
@@ -244,6 +281,7 @@ func resourceLogicAppWorkflow() *pluginsdk.Resource {
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
+				ConflictsWith: []string{"workflow_definition"},
 			},
 
 			"access_endpoint": {
@@ -309,10 +347,34 @@ func resourceLogicAppWorkflowCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("expanding `workflow_parameters`: %+v", err)
 	}
 
+	// nolint gosimple
+	var definition interface{}
+	definition = map[string]interface{}{
+		"$schema":        workflowSchema,
+		"contentVersion": workflowVersion,
+		"actions":        make(map[string]interface{}),
+		"triggers":       make(map[string]interface{}),
+		"parameters":     workflowParameters,
+	}
+
+	if v, ok := d.GetOk("workflow_definition"); ok {
+		definitionMap, err := pluginsdk.ExpandJsonFromString(v.(string))
+		if err != nil {
+			return fmt.Errorf("expanding `workflow_definition`: %+v", err)
+		}
+		definition = definitionMap
+		workflowParameters = logicAppWorkflowDefinitionParameters(definitionMap)
+	}
+
 	parameters, err := expandLogicAppWorkflowParameters(d.Get("parameters").(map[string]interface{}), workflowParameters)
 	if err != nil {
 		return err
 	}
+
+	if err := expandLogicAppWorkflowKeyVaultParameters(ctx, meta.(*clients.Client).KeyVault, d.Get("key_vault_parameter").(*pluginsdk.Set).List(), workflowParameters, *parameters); err != nil {
+		return err
+	}
+
 	t := d.Get("tags").(map[string]interface{})
 
 	isEnabled := workflows.WorkflowStateEnabled
@@ -325,16 +387,6 @@ func resourceLogicAppWorkflowCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("expanding `identity`: %+v", err)
 	}
 
-	// nolint gosimple
-	var definition interface{}
-	definition = map[string]interface{}{
-		"$schema":        workflowSchema,
-		"contentVersion": workflowVersion,
-		"actions":        make(map[string]interface{}),
-		"triggers":       make(map[string]interface{}),
-		"parameters":     workflowParameters,
-	}
-
 	properties := workflows.Workflow{
 		Identity: identity,
 		Location: utils.String(location),
@@ -404,21 +456,46 @@ func resourceLogicAppWorkflowUpdate(d *pluginsdk.ResourceData, meta interface{})
 	if err != nil {
 		return fmt.Errorf("expanding `workflow_parameters`: %+v", err)
 	}
+
+	var existingDefinition map[string]interface{}
+	if read.Model.Properties.Definition != nil {
+		if v, ok := (*read.Model.Properties.Definition).(map[string]interface{}); ok {
+			existingDefinition = v
+		}
+	}
+	existingParamDefs := logicAppWorkflowDefinitionParameters(existingDefinition)
+
+	var definition interface{}
+	if v, ok := d.GetOk("workflow_definition"); ok {
+		definitionMap, err := pluginsdk.ExpandJsonFromString(v.(string))
+		if err != nil {
+			return fmt.Errorf("expanding `workflow_definition`: %+v", err)
+		}
+		workflowParameters = logicAppWorkflowDefinitionParameters(definitionMap)
+		definition = definitionMap
+	} else if existingDefinition != nil {
+		// the actions and triggers may be managed by their own resources, so only the parameters are replaced here
+		existingDefinition["parameters"] = workflowParameters
+		definition = existingDefinition
+	}
+
 	parameters, err := expandLogicAppWorkflowParameters(d.Get("parameters").(map[string]interface{}), workflowParameters)
 	if err != nil {
 		return err
 	}
 
-	t := d.Get("tags").(map[string]interface{})
+	if err := expandLogicAppWorkflowKeyVaultParameters(ctx, meta.(*clients.Client).KeyVault, d.Get("key_vault_parameter").(*pluginsdk.Set).List(), workflowParameters, *parameters); err != nil {
+		return err
+	}
 
-	var definition interface{}
-	if read.Model.Properties.Definition != nil {
-		definitionRaw := *read.Model.Properties.Definition
-		definitionMap := definitionRaw.(map[string]interface{})
-		definitionMap["parameters"] = workflowParameters
-		definition = definitionMap
+	// API Connections are commonly wired up outside of Terraform (e.g. in the Portal or from an exported ARM
+	// template), so when `$connections` isn't specified we retain the existing value rather than removing it
+	if definitionMap, ok := definition.(map[string]interface{}); ok {
+		preserveLogicAppWorkflowConnections(definitionMap, existingParamDefs, *parameters, read.Model.Properties.Parameters)
 	}
 
+	t := d.Get("tags").(map[string]interface{})
+
 	isEnabled := workflows.WorkflowStateEnabled
 	if v := d.Get("enabled").(bool); !v {
 		isEnabled = workflows.WorkflowStateDisabled
@@ -526,19 +603,36 @@ func resourceLogicAppWorkflowRead(d *pluginsdk.ResourceData, meta interface{}) e
 			if definition := props.Definition; definition != nil {
 				definitionRaw := *props.Definition
 				if v, ok := definitionRaw.(map[string]interface{}); ok {
-					if v["$schema"] != nil {
-						d.Set("workflow_schema", v["$schema"].(string))
-					}
-					if v["contentVersion"] != nil {
-						d.Set("workflow_version", v["contentVersion"].(string))
-					}
-					if p, ok := v["parameters"]; ok {
-						workflowParameters, err := flattenLogicAppWorkflowWorkflowParameters(p.(map[string]interface{}))
+					// the full definition is only tracked when it's managed through `workflow_definition`, since otherwise
+					// the actions and triggers are managed by their own resources
+					_, definitionManaged := d.GetOk("workflow_definition")
+					if definitionManaged {
+						workflowDefinition, err := pluginsdk.FlattenJsonToString(v)
 						if err != nil {
-							return fmt.Errorf("flattening `workflow_parameters`: %+v", err)
+							return fmt.Errorf("flattening `workflow_definition`: %+v", err)
 						}
-						if err := d.Set("workflow_parameters", workflowParameters); err != nil {
-							return fmt.Errorf("setting `workflow_parameters`: %+v", err)
+						d.Set("workflow_definition", workflowDefinition)
+					} else {
+						if v["$schema"] != nil {
+							d.Set("workflow_schema", v["$schema"].(string))
+						}
+						if v["contentVersion"] != nil {
+							d.Set("workflow_version", v["contentVersion"].(string))
+						}
+					}
+					if p, ok := v["parameters"]; ok && p != nil {
+						if !definitionManaged {
+							workflowParameters, err := flattenLogicAppWorkflowWorkflowParameters(p.(map[string]interface{}))
+							if err != nil {
+								return fmt.Errorf("flattening `workflow_parameters`: %+v", err)
+							}
+							// `$connections` is retained during updates when it's not specified, so only track it when it's configured
+							if _, ok := d.Get("workflow_parameters").(map[string]interface{})[logicAppWorkflowConnectionsParameter]; !ok {
+								delete(workflowParameters, logicAppWorkflowConnectionsParameter)
+							}
+							if err := d.Set("workflow_parameters", workflowParameters); err != nil {
+								return fmt.Errorf("setting `workflow_parameters`: %+v", err)
+							}
 						}
 
 						// The props.Parameters (the value of the param) is accompany with the "parameters" (the definition of the param) inside the props.Definition.
@@ -654,7 +748,7 @@ func expandLogicAppWorkflowParameters(input map[string]interface{}, paramDefs ma
 			value = v
 		}
 
-		if k == "$connections" {
+		if k == logicAppWorkflowConnectionsParameter {
 			output[k] = workflows.WorkflowParameter{
 				Value: &value,
 			}
@@ -682,7 +776,24 @@ func flattenLogicAppWorkflowParameters(d *pluginsdk.ResourceData, input *map[str
 		paramInState = params
 	}
 
+	// Parameters sourced from Key Vault are tracked in `key_vault_parameter` rather than `parameters`.
+	keyVaultParams := make(map[string]struct{})
+	for _, raw := range d.Get("key_vault_parameter").(*pluginsdk.Set).List() {
+		if v, ok := raw.(map[string]interface{}); ok {
+			keyVaultParams[v["name"].(string)] = struct{}{}
+		}
+	}
+
 	for k, v := range *input {
+		if _, ok := keyVaultParams[k]; ok {
+			continue
+		}
+
+		// `$connections` is retained during updates when it's not specified, so only track it when it's configured
+		if _, ok := paramInState[k]; k == logicAppWorkflowConnectionsParameter && !ok {
+			continue
+		}
+
 		defRaw, ok := paramDefs[k]
 		if !ok {
 			// This should never happen.
@@ -786,6 +897,94 @@ func flattenLogicAppWorkflowParameters(d *pluginsdk.ResourceData, input *map[str
 	return output, nil
 }
 
+func expandLogicAppWorkflowKeyVaultParameters(ctx context.Context, keyVaultsClient *keyVaultClient.Client, input []interface{}, paramDefs map[string]interface{}, output map[string]workflows.WorkflowParameter) error {
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+
+		if _, ok := output[name]; ok {
+			return fmt.Errorf("the parameter %s is specified in both `parameters` and `key_vault_parameter`", name)
+		}
+
+		defRaw, ok := paramDefs[name]
+		if !ok {
+			return fmt.Errorf("no parameter definition for %s", name)
+		}
+		def := defRaw.(map[string]interface{})
+		var t workflows.ParameterType
+		switch {
+		case strings.EqualFold(def["type"].(string), string(workflows.ParameterTypeSecureString)):
+			t = workflows.ParameterTypeSecureString
+		case strings.EqualFold(def["type"].(string), string(workflows.ParameterTypeSecureObject)):
+			t = workflows.ParameterTypeSecureObject
+		default:
+			return fmt.Errorf("the parameter %s must be of type `%s` or `%s` to be sourced from Key Vault, got `%s`", name, workflows.ParameterTypeSecureString, workflows.ParameterTypeSecureObject, def["type"])
+		}
+
+		secretId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(v["key_vault_secret_id"].(string))
+		if err != nil {
+			return err
+		}
+
+		secret, err := keyVaultsClient.ManagementClient.GetSecret(ctx, secretId.KeyVaultBaseUrl, secretId.Name, secretId.Version)
+		if err != nil {
+			return fmt.Errorf("retrieving Key Vault Secret %q for parameter %s: %+v", secretId.ID(), name, err)
+		}
+		if secret.Value == nil {
+			return fmt.Errorf("retrieving Key Vault Secret %q for parameter %s: `value` was nil", secretId.ID(), name)
+		}
+
+		var value interface{} = *secret.Value
+		if t == workflows.ParameterTypeSecureObject {
+			var uv map[string]interface{}
+			if err := json.Unmarshal([]byte(*secret.Value), &uv); err != nil {
+				return fmt.Errorf("unmarshalling the Key Vault Secret for parameter %s to map[string]interface{}: %v", name, err)
+			}
+			value = uv
+		}
+
+		output[name] = workflows.WorkflowParameter{
+			Type:  &t,
+			Value: &value,
+		}
+	}
+
+	return nil
+}
+
+// logicAppWorkflowDefinitionParameters returns the parameter definitions declared within a workflow definition
+func logicAppWorkflowDefinitionParameters(definition map[string]interface{}) map[string]interface{} {
+	if v, ok := definition["parameters"].(map[string]interface{}); ok {
+		return v
+	}
+	return nil
+}
+
+// preserveLogicAppWorkflowConnections retains the existing `$connections` parameter (both its definition and value)
+// when it hasn't been specified, so that updating the workflow doesn't remove its API Connections
+func preserveLogicAppWorkflowConnections(definition map[string]interface{}, existingParamDefs map[string]interface{}, parameters map[string]workflows.WorkflowParameter, existingParameters *map[string]workflows.WorkflowParameter) {
+	if existing, ok := existingParamDefs[logicAppWorkflowConnectionsParameter]; ok {
+		defs := logicAppWorkflowDefinitionParameters(definition)
+		if defs == nil {
+			defs = make(map[string]interface{})
+		}
+		if _, ok := defs[logicAppWorkflowConnectionsParameter]; !ok {
+			defs[logicAppWorkflowConnectionsParameter] = existing
+			definition["parameters"] = defs
+		}
+	}
+
+	if existingParameters != nil {
+		if existing, ok := (*existingParameters)[logicAppWorkflowConnectionsParameter]; ok {
+			if _, ok := parameters[logicAppWorkflowConnectionsParameter]; !ok {
+				parameters[logicAppWorkflowConnectionsParameter] = workflows.WorkflowParameter{
+					Value: existing.Value,
+				}
+			}
+		}
+	}
+}
+
 func expandLogicAppWorkflowWorkflowParameters(input map[string]interface{}) (map[string]interface{}, error) {
 	if len(input) == 0 {
 		return nil, nil
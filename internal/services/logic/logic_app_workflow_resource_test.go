@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflows"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -159,6 +160,65 @@ func TestAccLogicAppWorkflow_parameters(t *testing.T) {
 	})
 }
 
+func TestAccLogicAppWorkflow_definition(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_workflow", "test")
+	r := LogicAppWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.definition(data, "Hello"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("workflow_definition", "workflow_parameters"),
+		{
+			Config: r.definition(data, "Goodbye"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("workflow_definition", "workflow_parameters"),
+	})
+}
+
+func TestAccLogicAppWorkflow_keyVaultParameter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_workflow", "test")
+	r := LogicAppWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVaultParameter(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_vault_parameter"),
+	})
+}
+
+func TestAccLogicAppWorkflow_connectionsRetained(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_workflow", "test")
+	r := LogicAppWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.parameters(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("parameters.secstr", "parameters.secobj"),
+		{
+			Config: r.parametersWithoutConnections(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.hasConnectionsParameter(data.ResourceName),
+			),
+		},
+	})
+}
+
 func TestAccLogicAppWorkflow_accessControl(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_logic_app_workflow", "test")
 	r := LogicAppWorkflowResource{}
@@ -230,6 +290,41 @@ func (LogicAppWorkflowResource) Exists(ctx context.Context, clients *clients.Cli
 	return utils.Bool(resp.Model != nil), nil
 }
 
+func (LogicAppWorkflowResource) hasConnectionsParameter(resourceName string) pluginsdk.TestCheckFunc {
+	return func(state *pluginsdk.State) error {
+		client, err := testclient.Build()
+		if err != nil {
+			return fmt.Errorf("building client: %+v", err)
+		}
+		ctx := client.StopContext
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		id, err := workflows.ParseWorkflowID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Logic.WorkflowClient.Get(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.Parameters == nil {
+			return fmt.Errorf("retrieving %s: `parameters` was nil", *id)
+		}
+
+		if _, ok := (*resp.Model.Properties.Parameters)["$connections"]; !ok {
+			return fmt.Errorf("expected the `$connections` parameter to be retained on %s", *id)
+		}
+
+		return nil
+	}
+}
+
 func (LogicAppWorkflowResource) empty(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -453,6 +548,148 @@ resource "azurerm_logic_app_workflow" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (LogicAppWorkflowResource) parametersWithoutConnections(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-logic-%d"
+  location = "%s"
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlaw-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  workflow_parameters = {
+    str = jsonencode({
+      type = "String"
+    })
+  }
+
+  parameters = {
+    str = "updated"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (LogicAppWorkflowResource) definition(data acceptance.TestData, greeting string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-logic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlaw-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  workflow_definition = jsonencode({
+    "$schema"      = "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#"
+    contentVersion = "1.0.0.0"
+    parameters = {
+      greeting = {
+        type         = "String"
+        defaultValue = ""
+      }
+    }
+    triggers = {
+      manual = {
+        type = "Request"
+        kind = "Http"
+        inputs = {
+          schema = {}
+        }
+      }
+    }
+    actions = {
+      Response = {
+        type     = "Response"
+        kind     = "Http"
+        runAfter = {}
+        inputs = {
+          statusCode = 200
+          body       = "@parameters('greeting')"
+        }
+      }
+    }
+    outputs = {}
+  })
+
+  parameters = {
+    greeting = "%[3]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, greeting)
+}
+
+func (LogicAppWorkflowResource) keyVaultParameter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-logic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctestkv%[3]s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    secret_permissions = [
+      "Delete",
+      "Get",
+      "Purge",
+      "Set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "api-key"
+  value        = "s3cr3t"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlaw-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  workflow_parameters = {
+    apiKey = jsonencode({
+      type = "SecureString"
+    })
+  }
+
+  key_vault_parameter {
+    name                = "apiKey"
+    key_vault_secret_id = azurerm_key_vault_secret.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (LogicAppWorkflowResource) accessControl(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -8,6 +8,9 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/machinelearningcomputes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlinedeployment"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlineendpoint"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/registrymanagement"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -15,6 +18,9 @@ import (
 type Client struct {
 	Datastore               *datastore.DatastoreClient
 	MachineLearningComputes *machinelearningcomputes.MachineLearningComputesClient
+	OnlineDeployments       *onlinedeployment.OnlineDeploymentClient
+	OnlineEndpoints         *onlineendpoint.OnlineEndpointClient
+	Registries              *registrymanagement.RegistryManagementClient
 	Workspaces              *workspaces.WorkspacesClient
 }
 
@@ -37,9 +43,30 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(computesClient.Client, o.Authorizers.ResourceManager)
 
+	onlineDeploymentsClient, err := onlinedeployment.NewOnlineDeploymentClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building OnlineDeployments client: %+v", err)
+	}
+	o.Configure(onlineDeploymentsClient.Client, o.Authorizers.ResourceManager)
+
+	onlineEndpointsClient, err := onlineendpoint.NewOnlineEndpointClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building OnlineEndpoints client: %+v", err)
+	}
+	o.Configure(onlineEndpointsClient.Client, o.Authorizers.ResourceManager)
+
+	registriesClient, err := registrymanagement.NewRegistryManagementClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Registries client: %+v", err)
+	}
+	o.Configure(registriesClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		MachineLearningComputes: computesClient,
 		Datastore:               datastoreClient,
+		OnlineDeployments:       onlineDeploymentsClient,
+		OnlineEndpoints:         onlineEndpointsClient,
+		Registries:              registriesClient,
 		Workspaces:              workspacesClient,
 	}, nil
 }
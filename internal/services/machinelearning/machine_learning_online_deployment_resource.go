@@ -0,0 +1,525 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlinedeployment"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlineendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningOnlineDeployment struct{}
+
+type MachineLearningOnlineDeploymentModel struct {
+	Name                             string                           `tfschema:"name"`
+	OnlineEndpointId                 string                           `tfschema:"online_endpoint_id"`
+	InstanceType                     string                           `tfschema:"instance_type"`
+	InstanceCount                    int64                            `tfschema:"instance_count"`
+	Model                            string                           `tfschema:"model"`
+	EnvironmentId                    string                           `tfschema:"environment_id"`
+	CodeConfiguration                []OnlineDeploymentCodeConfig     `tfschema:"code_configuration"`
+	Description                      string                           `tfschema:"description"`
+	EnvironmentVariables             map[string]string                `tfschema:"environment_variables"`
+	AppInsightsEnabled               bool                             `tfschema:"app_insights_enabled"`
+	EgressPublicNetworkAccessEnabled bool                             `tfschema:"egress_public_network_access_enabled"`
+	RequestSettings                  []OnlineDeploymentRequestSetting `tfschema:"request_settings"`
+	TrafficPercentage                int64                            `tfschema:"traffic_percentage"`
+	Tags                             map[string]string                `tfschema:"tags"`
+}
+
+type OnlineDeploymentCodeConfig struct {
+	CodeId        string `tfschema:"code_id"`
+	ScoringScript string `tfschema:"scoring_script"`
+}
+
+type OnlineDeploymentRequestSetting struct {
+	MaxConcurrentRequestsPerInstance int64  `tfschema:"max_concurrent_requests_per_instance"`
+	MaxQueueWait                     string `tfschema:"max_queue_wait"`
+	RequestTimeout                   string `tfschema:"request_timeout"`
+}
+
+var _ sdk.ResourceWithUpdate = MachineLearningOnlineDeployment{}
+
+func (r MachineLearningOnlineDeployment) ModelObject() interface{} {
+	return &MachineLearningOnlineDeploymentModel{}
+}
+
+func (r MachineLearningOnlineDeployment) ResourceType() string {
+	return "azurerm_machine_learning_online_deployment"
+}
+
+func (r MachineLearningOnlineDeployment) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return onlinedeployment.ValidateOnlineEndpointDeploymentID
+}
+
+func (r MachineLearningOnlineDeployment) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.OnlineEndpointName,
+		},
+
+		"online_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: onlineendpoint.ValidateOnlineEndpointID,
+		},
+
+		"instance_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"instance_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"model": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"environment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"code_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"code_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"scoring_script": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"environment_variables": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"app_insights_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"egress_public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"request_settings": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"max_concurrent_requests_per_instance": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"max_queue_wait": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"request_timeout": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"traffic_percentage": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MachineLearningOnlineDeployment) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployments
+			endpointsClient := metadata.Client.MachineLearning.OnlineEndpoints
+
+			var model MachineLearningOnlineDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			endpointId, err := onlineendpoint.ParseOnlineEndpointID(model.OnlineEndpointId)
+			if err != nil {
+				return err
+			}
+
+			id := onlinedeployment.NewOnlineEndpointDeploymentID(endpointId.SubscriptionId, endpointId.ResourceGroupName, endpointId.WorkspaceName, endpointId.OnlineEndpointName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			endpoint, err := endpointsClient.Get(ctx, *endpointId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *endpointId, err)
+			}
+			if endpoint.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *endpointId)
+			}
+
+			payload := onlinedeployment.OnlineDeploymentTrackedResource{
+				Location:   endpoint.Model.Location,
+				Properties: expandMachineLearningOnlineDeployment(model),
+				Sku: &onlinedeployment.Sku{
+					Name:     "Default",
+					Capacity: pointer.To(model.InstanceCount),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if model.TrafficPercentage > 0 {
+				if err := updateMachineLearningOnlineEndpointTraffic(ctx, endpointsClient, *endpointId, id.DeploymentName, pointer.To(model.TrafficPercentage)); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployments
+			endpointsClient := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			endpointId := onlineendpoint.NewOnlineEndpointID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.OnlineEndpointName)
+
+			state := MachineLearningOnlineDeploymentModel{
+				Name:             id.DeploymentName,
+				OnlineEndpointId: endpointId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if sku := model.Sku; sku != nil {
+					state.InstanceCount = pointer.From(sku.Capacity)
+				}
+
+				if props, ok := model.Properties.(onlinedeployment.ManagedOnlineDeployment); ok {
+					state.InstanceType = pointer.From(props.InstanceType)
+					state.Model = pointer.From(props.Model)
+					state.EnvironmentId = pointer.From(props.EnvironmentId)
+					state.Description = pointer.From(props.Description)
+					state.EnvironmentVariables = pointer.From(props.EnvironmentVariables)
+					state.AppInsightsEnabled = pointer.From(props.AppInsightsEnabled)
+					state.EgressPublicNetworkAccessEnabled = pointer.From(props.EgressPublicNetworkAccess) != onlinedeployment.EgressPublicNetworkAccessTypeDisabled
+					state.CodeConfiguration = flattenMachineLearningOnlineDeploymentCodeConfiguration(props.CodeConfiguration)
+					state.RequestSettings = flattenMachineLearningOnlineDeploymentRequestSettings(props.RequestSettings)
+				}
+			}
+
+			endpoint, err := endpointsClient.Get(ctx, endpointId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", endpointId, err)
+			}
+			if endpoint.Model != nil {
+				traffic := pointer.From(endpoint.Model.Properties.Traffic)
+				state.TrafficPercentage = traffic[id.DeploymentName]
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployments
+			endpointsClient := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MachineLearningOnlineDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("instance_count", "model", "environment_id", "code_configuration", "environment_variables", "app_insights_enabled", "request_settings", "tags") {
+				existing, err := client.Get(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if existing.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", *id)
+				}
+
+				payload := *existing.Model
+				payload.Properties = expandMachineLearningOnlineDeployment(model)
+				payload.Sku = &onlinedeployment.Sku{
+					Name:     "Default",
+					Capacity: pointer.To(model.InstanceCount),
+				}
+				payload.Tags = pointer.To(model.Tags)
+
+				if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("traffic_percentage") {
+				endpointId := onlineendpoint.NewOnlineEndpointID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.OnlineEndpointName)
+				if err := updateMachineLearningOnlineEndpointTraffic(ctx, endpointsClient, endpointId, id.DeploymentName, pointer.To(model.TrafficPercentage)); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployments
+			endpointsClient := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a deployment which still receives traffic can't be deleted, so drain it from the endpoint first
+			endpointId := onlineendpoint.NewOnlineEndpointID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.OnlineEndpointName)
+			if err := updateMachineLearningOnlineEndpointTraffic(ctx, endpointsClient, endpointId, id.DeploymentName, nil); err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// updateMachineLearningOnlineEndpointTraffic sets the share of the endpoint traffic routed to the
+// specified deployment, removing the deployment from the traffic split when `percentage` is nil.
+func updateMachineLearningOnlineEndpointTraffic(ctx context.Context, client *onlineendpoint.OnlineEndpointClient, id onlineendpoint.OnlineEndpointId, deploymentName string, percentage *int64) error {
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+
+	traffic := pointer.From(existing.Model.Properties.Traffic)
+	if traffic == nil {
+		traffic = make(map[string]int64)
+	}
+
+	current, ok := traffic[deploymentName]
+	if percentage == nil {
+		if !ok {
+			return nil
+		}
+		delete(traffic, deploymentName)
+	} else {
+		if ok && current == *percentage {
+			return nil
+		}
+		traffic[deploymentName] = *percentage
+	}
+
+	payload := *existing.Model
+	payload.Properties.Traffic = pointer.To(traffic)
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("updating the traffic of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandMachineLearningOnlineDeployment(input MachineLearningOnlineDeploymentModel) onlinedeployment.ManagedOnlineDeployment {
+	egressPublicNetworkAccess := onlinedeployment.EgressPublicNetworkAccessTypeEnabled
+	if !input.EgressPublicNetworkAccessEnabled {
+		egressPublicNetworkAccess = onlinedeployment.EgressPublicNetworkAccessTypeDisabled
+	}
+
+	output := onlinedeployment.ManagedOnlineDeployment{
+		AppInsightsEnabled:        pointer.To(input.AppInsightsEnabled),
+		EgressPublicNetworkAccess: pointer.To(egressPublicNetworkAccess),
+		EnvironmentVariables:      pointer.To(input.EnvironmentVariables),
+		InstanceType:              pointer.To(input.InstanceType),
+		Model:                     pointer.To(input.Model),
+		ScaleSettings:             onlinedeployment.DefaultScaleSettings{},
+	}
+
+	if input.Description != "" {
+		output.Description = pointer.To(input.Description)
+	}
+
+	if input.EnvironmentId != "" {
+		output.EnvironmentId = pointer.To(input.EnvironmentId)
+	}
+
+	if len(input.CodeConfiguration) > 0 {
+		output.CodeConfiguration = &onlinedeployment.CodeConfiguration{
+			CodeId:        pointer.To(input.CodeConfiguration[0].CodeId),
+			ScoringScript: input.CodeConfiguration[0].ScoringScript,
+		}
+	}
+
+	if len(input.RequestSettings) > 0 {
+		settings := input.RequestSettings[0]
+		output.RequestSettings = &onlinedeployment.OnlineRequestSettings{}
+
+		if settings.MaxConcurrentRequestsPerInstance > 0 {
+			output.RequestSettings.MaxConcurrentRequestsPerInstance = pointer.To(settings.MaxConcurrentRequestsPerInstance)
+		}
+
+		if settings.MaxQueueWait != "" {
+			output.RequestSettings.MaxQueueWait = pointer.To(settings.MaxQueueWait)
+		}
+
+		if settings.RequestTimeout != "" {
+			output.RequestSettings.RequestTimeout = pointer.To(settings.RequestTimeout)
+		}
+	}
+
+	return output
+}
+
+func flattenMachineLearningOnlineDeploymentCodeConfiguration(input *onlinedeployment.CodeConfiguration) []OnlineDeploymentCodeConfig {
+	if input == nil {
+		return []OnlineDeploymentCodeConfig{}
+	}
+
+	return []OnlineDeploymentCodeConfig{
+		{
+			CodeId:        pointer.From(input.CodeId),
+			ScoringScript: input.ScoringScript,
+		},
+	}
+}
+
+func flattenMachineLearningOnlineDeploymentRequestSettings(input *onlinedeployment.OnlineRequestSettings) []OnlineDeploymentRequestSetting {
+	if input == nil {
+		return []OnlineDeploymentRequestSetting{}
+	}
+
+	return []OnlineDeploymentRequestSetting{
+		{
+			MaxConcurrentRequestsPerInstance: pointer.From(input.MaxConcurrentRequestsPerInstance),
+			MaxQueueWait:                     pointer.From(input.MaxQueueWait),
+			RequestTimeout:                   pointer.From(input.RequestTimeout),
+		},
+	}
+}
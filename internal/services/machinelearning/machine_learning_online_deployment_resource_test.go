@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlinedeployment"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MachineLearningOnlineDeploymentResource struct{}
+
+func TestAccMachineLearningOnlineDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningOnlineDeployment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMachineLearningOnlineDeployment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_percentage").HasValue("100"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MachineLearningOnlineDeploymentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.OnlineDeployments.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MachineLearningOnlineDeploymentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "test" {
+  name               = "acctest-mld-%[2]s"
+  online_endpoint_id = azurerm_machine_learning_online_endpoint.test.id
+  instance_type      = "Standard_DS3_v2"
+  model              = "azureml://registries/azureml/models/distilbert-base-uncased/versions/1"
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r MachineLearningOnlineDeploymentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "test" {
+  name                 = "acctest-mld-%[2]s"
+  online_endpoint_id   = azurerm_machine_learning_online_endpoint.test.id
+  instance_type        = "Standard_DS3_v2"
+  instance_count       = 2
+  model                = "azureml://registries/azureml/models/distilbert-base-uncased/versions/1"
+  app_insights_enabled = true
+  traffic_percentage   = 100
+
+  environment_variables = {
+    WORKER_COUNT = "2"
+  }
+
+  request_settings {
+    max_concurrent_requests_per_instance = 2
+    request_timeout                      = "PT10S"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r MachineLearningOnlineDeploymentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "import" {
+  name               = azurerm_machine_learning_online_deployment.test.name
+  online_endpoint_id = azurerm_machine_learning_online_deployment.test.online_endpoint_id
+  instance_type      = azurerm_machine_learning_online_deployment.test.instance_type
+  model              = azurerm_machine_learning_online_deployment.test.model
+}
+`, r.basic(data))
+}
+
+func (r MachineLearningOnlineDeploymentResource) template(data acceptance.TestData) string {
+	return MachineLearningOnlineEndpointResource{}.basic(data)
+}
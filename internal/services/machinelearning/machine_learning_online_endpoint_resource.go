@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlineendpoint"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningOnlineEndpoint struct{}
+
+type MachineLearningOnlineEndpointModel struct {
+	Name                       string                                     `tfschema:"name"`
+	WorkspaceId                string                                     `tfschema:"machine_learning_workspace_id"`
+	Location                   string                                     `tfschema:"location"`
+	Identity                   []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	AuthenticationMode         string                                     `tfschema:"authentication_mode"`
+	Description                string                                     `tfschema:"description"`
+	PublicNetworkAccessEnabled bool                                       `tfschema:"public_network_access_enabled"`
+	Tags                       map[string]string                          `tfschema:"tags"`
+	Traffic                    map[string]int64                           `tfschema:"traffic"`
+	ScoringUri                 string                                     `tfschema:"scoring_uri"`
+	SwaggerUri                 string                                     `tfschema:"swagger_uri"`
+	PrimaryKey                 string                                     `tfschema:"primary_key"`
+	SecondaryKey               string                                     `tfschema:"secondary_key"`
+}
+
+var _ sdk.ResourceWithUpdate = MachineLearningOnlineEndpoint{}
+
+func (r MachineLearningOnlineEndpoint) ModelObject() interface{} {
+	return &MachineLearningOnlineEndpointModel{}
+}
+
+func (r MachineLearningOnlineEndpoint) ResourceType() string {
+	return "azurerm_machine_learning_online_endpoint"
+}
+
+func (r MachineLearningOnlineEndpoint) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return onlineendpoint.ValidateOnlineEndpointID
+}
+
+func (r MachineLearningOnlineEndpoint) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.OnlineEndpointName,
+		},
+
+		"machine_learning_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityRequired(),
+
+		"authentication_mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(onlineendpoint.EndpointAuthModeKey),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(onlineendpoint.EndpointAuthModeAADToken),
+				string(onlineendpoint.EndpointAuthModeAMLToken),
+				string(onlineendpoint.EndpointAuthModeKey),
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"traffic": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeInt,
+			},
+		},
+
+		"scoring_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"swagger_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoints
+
+			var model MachineLearningOnlineEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := onlineendpoint.NewOnlineEndpointID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := onlineendpoint.OnlineEndpointTrackedResource{
+				Location: location.Normalize(model.Location),
+				Identity: expandedIdentity,
+				Properties: onlineendpoint.OnlineEndpoint{
+					AuthMode:            onlineendpoint.EndpointAuthMode(model.AuthenticationMode),
+					PublicNetworkAccess: pointer.To(expandMachineLearningOnlineEndpointPublicNetworkAccess(model.PublicNetworkAccessEnabled)),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MachineLearningOnlineEndpointModel{
+				Name:        id.OnlineEndpointName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				flattenedIdentity, err := identity.FlattenLegacySystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = flattenedIdentity
+
+				props := model.Properties
+				state.AuthenticationMode = string(props.AuthMode)
+				state.Description = pointer.From(props.Description)
+				state.PublicNetworkAccessEnabled = pointer.From(props.PublicNetworkAccess) != onlineendpoint.PublicNetworkAccessTypeDisabled
+				state.Traffic = pointer.From(props.Traffic)
+				state.ScoringUri = pointer.From(props.ScoringUri)
+				state.SwaggerUri = pointer.From(props.SwaggerUri)
+
+				if props.AuthMode == onlineendpoint.EndpointAuthModeKey {
+					keys, err := client.ListKeys(ctx, *id)
+					if err != nil {
+						return fmt.Errorf("listing keys for %s: %+v", *id, err)
+					}
+					if keys.Model != nil {
+						state.PrimaryKey = pointer.From(keys.Model.PrimaryKey)
+						state.SecondaryKey = pointer.From(keys.Model.SecondaryKey)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MachineLearningOnlineEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the traffic of the endpoint is managed by the Online Deployments
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChange("authentication_mode") {
+				payload.Properties.AuthMode = onlineendpoint.EndpointAuthMode(model.AuthenticationMode)
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				payload.Properties.PublicNetworkAccess = pointer.To(expandMachineLearningOnlineEndpointPublicNetworkAccess(model.PublicNetworkAccessEnabled))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMachineLearningOnlineEndpointPublicNetworkAccess(input bool) onlineendpoint.PublicNetworkAccessType {
+	if input {
+		return onlineendpoint.PublicNetworkAccessTypeEnabled
+	}
+	return onlineendpoint.PublicNetworkAccessTypeDisabled
+}
@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/registrymanagement"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningRegistry struct{}
+
+type MachineLearningRegistryModel struct {
+	Name                       string                                     `tfschema:"name"`
+	ResourceGroupName          string                                     `tfschema:"resource_group_name"`
+	Location                   string                                     `tfschema:"location"`
+	Identity                   []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	ContainerRegistrySku       string                                     `tfschema:"container_registry_sku"`
+	StorageAccountType         string                                     `tfschema:"storage_account_type"`
+	PublicNetworkAccessEnabled bool                                       `tfschema:"public_network_access_enabled"`
+	ReplicationRegions         []string                                   `tfschema:"replication_regions"`
+	Tags                       map[string]string                          `tfschema:"tags"`
+	DiscoveryUrl               string                                     `tfschema:"discovery_url"`
+	MlFlowRegistryUri          string                                     `tfschema:"mlflow_registry_uri"`
+	ManagedResourceGroupId     string                                     `tfschema:"managed_resource_group_id"`
+}
+
+var _ sdk.ResourceWithUpdate = MachineLearningRegistry{}
+
+func (r MachineLearningRegistry) ModelObject() interface{} {
+	return &MachineLearningRegistryModel{}
+}
+
+func (r MachineLearningRegistry) ResourceType() string {
+	return "azurerm_machine_learning_registry"
+}
+
+func (r MachineLearningRegistry) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return registrymanagement.ValidateRegistryID
+}
+
+func (r MachineLearningRegistry) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RegistryName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"container_registry_sku": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "Premium",
+			ValidateFunc: validation.StringInSlice([]string{
+				"Premium",
+			}, false),
+		},
+
+		"storage_account_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "Standard_LRS",
+			ValidateFunc: validation.StringInSlice([]string{
+				"Standard_LRS",
+				"Standard_GRS",
+				"Standard_RAGRS",
+				"Standard_ZRS",
+				"Standard_GZRS",
+				"Standard_RAGZRS",
+				"Premium_LRS",
+				"Premium_ZRS",
+			}, false),
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"replication_regions": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:             pluginsdk.TypeString,
+				ValidateFunc:     location.EnhancedValidate,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MachineLearningRegistry) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"discovery_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"mlflow_registry_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"managed_resource_group_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registries
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MachineLearningRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := registrymanagement.NewRegistryID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.RegistriesGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := registrymanagement.RegistryTrackedResource{
+				Location: location.Normalize(model.Location),
+				Identity: expandedIdentity,
+				Properties: registrymanagement.Registry{
+					PublicNetworkAccess: pointer.To(expandMachineLearningRegistryPublicNetworkAccess(model.PublicNetworkAccessEnabled)),
+					RegionDetails:       pointer.To(expandMachineLearningRegistryRegionDetails(model, nil)),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.RegistriesCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registries
+
+			id, err := registrymanagement.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.RegistriesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MachineLearningRegistryModel{
+				Name:              id.RegistryName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				flattenedIdentity, err := identity.FlattenLegacySystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = flattenedIdentity
+
+				props := model.Properties
+				state.DiscoveryUrl = pointer.From(props.DiscoveryUrl)
+				state.MlFlowRegistryUri = pointer.From(props.MlFlowRegistryUri)
+				state.PublicNetworkAccessEnabled = pointer.From(props.PublicNetworkAccess) != "Disabled"
+				if props.ManagedResourceGroup != nil {
+					state.ManagedResourceGroupId = pointer.From(props.ManagedResourceGroup.ResourceId)
+				}
+
+				replicationRegions := make([]string, 0)
+				if props.RegionDetails != nil {
+					for _, region := range *props.RegionDetails {
+						regionLocation := location.Normalize(pointer.From(region.Location))
+						if regionLocation != state.Location {
+							replicationRegions = append(replicationRegions, regionLocation)
+							continue
+						}
+
+						if region.AcrDetails != nil && len(*region.AcrDetails) > 0 {
+							if acr := (*region.AcrDetails)[0].SystemCreatedAcrAccount; acr != nil {
+								state.ContainerRegistrySku = pointer.From(acr.AcrAccountSku)
+							}
+						}
+						if region.StorageAccountDetails != nil && len(*region.StorageAccountDetails) > 0 {
+							if storage := (*region.StorageAccountDetails)[0].SystemCreatedStorageAccount; storage != nil {
+								state.StorageAccountType = pointer.From(storage.StorageAccountType)
+							}
+						}
+					}
+				}
+				state.ReplicationRegions = replicationRegions
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registries
+
+			id, err := registrymanagement.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MachineLearningRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.RegistriesGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				payload.Properties.PublicNetworkAccess = pointer.To(expandMachineLearningRegistryPublicNetworkAccess(model.PublicNetworkAccessEnabled))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			regionsRemoved := false
+			if metadata.ResourceData.HasChange("replication_regions") {
+				payload.Properties.RegionDetails = pointer.To(expandMachineLearningRegistryRegionDetails(model, payload.Properties.RegionDetails))
+
+				o, n := metadata.ResourceData.GetChange("replication_regions")
+				newRegions := make(map[string]struct{})
+				for _, v := range n.([]interface{}) {
+					newRegions[location.Normalize(v.(string))] = struct{}{}
+				}
+				for _, v := range o.([]interface{}) {
+					if _, ok := newRegions[location.Normalize(v.(string))]; !ok {
+						regionsRemoved = true
+					}
+				}
+			}
+
+			// replication regions can only be removed from a registry using the dedicated operation
+			if regionsRemoved {
+				if err := client.RegistriesRemoveRegionsThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("removing replication regions from %s: %+v", *id, err)
+				}
+			} else {
+				if err := client.RegistriesCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registries
+
+			id, err := registrymanagement.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.RegistriesDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMachineLearningRegistryPublicNetworkAccess(input bool) string {
+	if input {
+		return "Enabled"
+	}
+	return "Disabled"
+}
+
+// expandMachineLearningRegistryRegionDetails builds the region details for the primary location and each replication
+// region, retaining the details of any region which already exists so that its storage and registry accounts are kept
+func expandMachineLearningRegistryRegionDetails(model MachineLearningRegistryModel, existing *[]registrymanagement.RegistryRegionArmDetails) []registrymanagement.RegistryRegionArmDetails {
+	existingRegions := make(map[string]registrymanagement.RegistryRegionArmDetails)
+	if existing != nil {
+		for _, region := range *existing {
+			existingRegions[location.Normalize(pointer.From(region.Location))] = region
+		}
+	}
+
+	locations := []string{location.Normalize(model.Location)}
+	for _, v := range model.ReplicationRegions {
+		locations = append(locations, location.Normalize(v))
+	}
+
+	output := make([]registrymanagement.RegistryRegionArmDetails, 0)
+	for _, regionLocation := range locations {
+		if region, ok := existingRegions[regionLocation]; ok {
+			output = append(output, region)
+			continue
+		}
+
+		output = append(output, registrymanagement.RegistryRegionArmDetails{
+			Location: pointer.To(regionLocation),
+			AcrDetails: &[]registrymanagement.AcrDetails{
+				{
+					SystemCreatedAcrAccount: &registrymanagement.SystemCreatedAcrAccount{
+						AcrAccountSku: pointer.To(model.ContainerRegistrySku),
+					},
+				},
+			},
+			StorageAccountDetails: &[]registrymanagement.StorageAccountDetails{
+				{
+					SystemCreatedStorageAccount: &registrymanagement.SystemCreatedStorageAccount{
+						AllowBlobPublicAccess:    pointer.To(false),
+						StorageAccountHnsEnabled: pointer.To(false),
+						StorageAccountType:       pointer.To(model.StorageAccountType),
+					},
+				},
+			},
+		})
+	}
+
+	return output
+}
@@ -18,6 +18,7 @@ import (
 	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -196,6 +197,95 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 							Computed:     true,
 							ValidateFunc: validation.StringInSlice(workspaces.PossibleValuesForIsolationMode(), false),
 						},
+
+						"outbound_rule_fqdn": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"destination_fqdn": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+
+						"outbound_rule_private_endpoint": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"service_resource_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: azure.ValidateResourceID,
+									},
+
+									"sub_resource_target": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"spark_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+
+						"outbound_rule_service_tag": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"service_tag": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"protocol": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"*",
+											"TCP",
+											"UDP",
+											"ICMP",
+										}, false),
+									},
+
+									"port_ranges": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -500,6 +590,7 @@ func resourceMachineLearningWorkspaceUpdate(d *pluginsdk.ResourceData, meta inte
 				}
 			}
 		}
+		payload.Properties.ServerlessComputeSettings = serverlessCompute
 	}
 
 	if d.HasChange("tags") {
@@ -805,9 +896,50 @@ func expandMachineLearningWorkspaceManagedNetwork(i []interface{}) *workspaces.M
 
 	v := i[0].(map[string]interface{})
 
-	return &workspaces.ManagedNetworkSettings{
+	output := workspaces.ManagedNetworkSettings{
 		IsolationMode: pointer.To(workspaces.IsolationMode(v["isolation_mode"].(string))),
 	}
+
+	outboundRules := make(map[string]workspaces.OutboundRule)
+
+	for _, item := range v["outbound_rule_fqdn"].(*pluginsdk.Set).List() {
+		rule := item.(map[string]interface{})
+		outboundRules[rule["name"].(string)] = workspaces.FqdnOutboundRule{
+			Category:    pointer.To(workspaces.RuleCategoryUserDefined),
+			Destination: pointer.To(rule["destination_fqdn"].(string)),
+		}
+	}
+
+	for _, item := range v["outbound_rule_private_endpoint"].(*pluginsdk.Set).List() {
+		rule := item.(map[string]interface{})
+		outboundRules[rule["name"].(string)] = workspaces.PrivateEndpointOutboundRule{
+			Category: pointer.To(workspaces.RuleCategoryUserDefined),
+			Destination: &workspaces.PrivateEndpointDestination{
+				ServiceResourceId: pointer.To(rule["service_resource_id"].(string)),
+				SubresourceTarget: pointer.To(rule["sub_resource_target"].(string)),
+				SparkEnabled:      pointer.To(rule["spark_enabled"].(bool)),
+			},
+		}
+	}
+
+	for _, item := range v["outbound_rule_service_tag"].(*pluginsdk.Set).List() {
+		rule := item.(map[string]interface{})
+		outboundRules[rule["name"].(string)] = workspaces.ServiceTagOutboundRule{
+			Category: pointer.To(workspaces.RuleCategoryUserDefined),
+			Destination: &workspaces.ServiceTagDestination{
+				Action:     pointer.To(workspaces.RuleActionAllow),
+				ServiceTag: pointer.To(rule["service_tag"].(string)),
+				Protocol:   pointer.To(rule["protocol"].(string)),
+				PortRanges: pointer.To(rule["port_ranges"].(string)),
+			},
+		}
+	}
+
+	if len(outboundRules) > 0 {
+		output.OutboundRules = &outboundRules
+	}
+
+	return &output
 }
 
 func flattenMachineLearningWorkspaceManagedNetwork(i *workspaces.ManagedNetworkSettings) *[]interface{} {
@@ -821,9 +953,75 @@ func flattenMachineLearningWorkspaceManagedNetwork(i *workspaces.ManagedNetworkS
 		out["isolation_mode"] = *i.IsolationMode
 	}
 
+	fqdnRules := make([]interface{}, 0)
+	privateEndpointRules := make([]interface{}, 0)
+	serviceTagRules := make([]interface{}, 0)
+
+	if i.OutboundRules != nil {
+		for name, item := range *i.OutboundRules {
+			// the rules which are required by the workspace itself are managed by the service
+			switch rule := item.(type) {
+			case workspaces.FqdnOutboundRule:
+				if !isMachineLearningWorkspaceUserDefinedOutboundRule(rule.Category) {
+					continue
+				}
+				fqdnRules = append(fqdnRules, map[string]interface{}{
+					"name":             name,
+					"destination_fqdn": pointer.From(rule.Destination),
+				})
+
+			case workspaces.PrivateEndpointOutboundRule:
+				if !isMachineLearningWorkspaceUserDefinedOutboundRule(rule.Category) {
+					continue
+				}
+				serviceResourceId := ""
+				subResourceTarget := ""
+				sparkEnabled := false
+				if destination := rule.Destination; destination != nil {
+					serviceResourceId = pointer.From(destination.ServiceResourceId)
+					subResourceTarget = pointer.From(destination.SubresourceTarget)
+					sparkEnabled = pointer.From(destination.SparkEnabled)
+				}
+				privateEndpointRules = append(privateEndpointRules, map[string]interface{}{
+					"name":                name,
+					"service_resource_id": serviceResourceId,
+					"sub_resource_target": subResourceTarget,
+					"spark_enabled":       sparkEnabled,
+				})
+
+			case workspaces.ServiceTagOutboundRule:
+				if !isMachineLearningWorkspaceUserDefinedOutboundRule(rule.Category) {
+					continue
+				}
+				serviceTag := ""
+				protocol := ""
+				portRanges := ""
+				if destination := rule.Destination; destination != nil {
+					serviceTag = pointer.From(destination.ServiceTag)
+					protocol = pointer.From(destination.Protocol)
+					portRanges = pointer.From(destination.PortRanges)
+				}
+				serviceTagRules = append(serviceTagRules, map[string]interface{}{
+					"name":        name,
+					"service_tag": serviceTag,
+					"protocol":    protocol,
+					"port_ranges": portRanges,
+				})
+			}
+		}
+	}
+
+	out["outbound_rule_fqdn"] = fqdnRules
+	out["outbound_rule_private_endpoint"] = privateEndpointRules
+	out["outbound_rule_service_tag"] = serviceTagRules
+
 	return &[]interface{}{out}
 }
 
+func isMachineLearningWorkspaceUserDefinedOutboundRule(input *workspaces.RuleCategory) bool {
+	return input == nil || *input == workspaces.RuleCategoryUserDefined
+}
+
 func expandMachineLearningWorkspaceServerlessCompute(i []interface{}) *workspaces.ServerlessComputeSettings {
 	if len(i) == 0 || i[0] == nil {
 		return nil
@@ -353,6 +353,34 @@ func TestAccMachineLearningWorkspace_purgeSoftDelete(t *testing.T) {
 	})
 }
 
+func TestAccMachineLearningWorkspace_managedNetworkOutboundRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace", "test")
+	r := WorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedNetworkOutboundRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_network.0.outbound_rule_fqdn.#").HasValue("1"),
+				check.That(data.ResourceName).Key("managed_network.0.outbound_rule_private_endpoint.#").HasValue("1"),
+				check.That(data.ResourceName).Key("managed_network.0.outbound_rule_service_tag.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.managedNetworkOutboundRulesUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_network.0.outbound_rule_fqdn.#").HasValue("2"),
+				check.That(data.ResourceName).Key("managed_network.0.outbound_rule_private_endpoint.#").HasValue("0"),
+				check.That(data.ResourceName).Key("managed_network.0.outbound_rule_service_tag.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMachineLearningWorkspace_serverlessCompute(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace", "test")
 	r := WorkspaceResource{}
@@ -1206,6 +1234,114 @@ resource "azurerm_machine_learning_workspace" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r WorkspaceResource) managedNetworkOutboundRules(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy       = false
+      purge_soft_deleted_keys_on_destroy = false
+    }
+    resource_group {
+      prevent_deletion_if_contains_resources = false
+    }
+  }
+}
+
+%[1]s
+
+resource "azurerm_storage_account" "outbound" {
+  name                     = "acctestsaob%[3]s"
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_machine_learning_workspace" "test" {
+  name                    = "acctest-MLW-%[2]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  key_vault_id            = azurerm_key_vault.test.id
+  storage_account_id      = azurerm_storage_account.test.id
+
+  managed_network {
+    isolation_mode = "AllowOnlyApprovedOutbound"
+
+    outbound_rule_fqdn {
+      name             = "pypi"
+      destination_fqdn = "pypi.org"
+    }
+
+    outbound_rule_private_endpoint {
+      name                = "storage"
+      service_resource_id = azurerm_storage_account.outbound.id
+      sub_resource_target = "blob"
+    }
+
+    outbound_rule_service_tag {
+      name        = "datafactory"
+      service_tag = "DataFactory"
+      protocol    = "TCP"
+      port_ranges = "443"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template, data.RandomInteger, data.RandomString)
+}
+
+func (r WorkspaceResource) managedNetworkOutboundRulesUpdated(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy       = false
+      purge_soft_deleted_keys_on_destroy = false
+    }
+    resource_group {
+      prevent_deletion_if_contains_resources = false
+    }
+  }
+}
+
+%[1]s
+
+resource "azurerm_machine_learning_workspace" "test" {
+  name                    = "acctest-MLW-%[2]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  key_vault_id            = azurerm_key_vault.test.id
+  storage_account_id      = azurerm_storage_account.test.id
+
+  managed_network {
+    isolation_mode = "AllowOnlyApprovedOutbound"
+
+    outbound_rule_fqdn {
+      name             = "pypi"
+      destination_fqdn = "pypi.org"
+    }
+
+    outbound_rule_fqdn {
+      name             = "pythonhosted"
+      destination_fqdn = "files.pythonhosted.org"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r WorkspaceResource) serverlessCompute(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
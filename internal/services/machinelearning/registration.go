@@ -57,5 +57,8 @@ func (r Registration) Resources() []sdk.Resource {
 		MachineLearningDataStoreBlobStorage{},
 		MachineLearningDataStoreDataLakeGen2{},
 		MachineLearningDataStoreFileShare{},
+		MachineLearningOnlineDeployment{},
+		MachineLearningOnlineEndpoint{},
+		MachineLearningRegistry{},
 	}
 }
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-05-01/managementgroups" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceManagementGroupHierarchySettings() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceManagementGroupHierarchySettingsCreate,
+		Read:   resourceManagementGroupHierarchySettingsRead,
+		Update: resourceManagementGroupHierarchySettingsUpdate,
+		Delete: resourceManagementGroupHierarchySettingsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagementGroupHierarchySettingsID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			// Hierarchy Settings can only be set on the Tenant Root Management Group
+			"management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagementGroupID,
+			},
+
+			"default_management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ManagementGroupID,
+				AtLeastOneOf: []string{
+					"default_management_group_id",
+					"require_authorization_for_group_creation",
+				},
+			},
+
+			"require_authorization_for_group_creation": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+				AtLeastOneOf: []string{
+					"default_management_group_id",
+					"require_authorization_for_group_creation",
+				},
+			},
+		},
+	}
+}
+
+func resourceManagementGroupHierarchySettingsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.HierarchySettingsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managementGroupId, err := parse.ManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewManagementGroupHierarchySettingsID(managementGroupId.Name)
+
+	existing, err := client.Get(ctx, id.ManagementGroup)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Hierarchy Settings for Management Group %q: %+v", id.ManagementGroup, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_management_group_hierarchy_settings", id.ID())
+	}
+
+	payload := managementgroups.CreateOrUpdateSettingsRequest{
+		CreateOrUpdateSettingsProperties: expandManagementGroupHierarchySettings(d),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ManagementGroup, payload); err != nil {
+		return fmt.Errorf("creating Hierarchy Settings for Management Group %q: %+v", id.ManagementGroup, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceManagementGroupHierarchySettingsRead(d, meta)
+}
+
+func resourceManagementGroupHierarchySettingsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.HierarchySettingsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagementGroupHierarchySettingsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ManagementGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Hierarchy Settings for Management Group %q were not found - removing from state", id.ManagementGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Hierarchy Settings for Management Group %q: %+v", id.ManagementGroup, err)
+	}
+
+	d.Set("management_group_id", parse.NewManagementGroupId(id.ManagementGroup).ID())
+
+	defaultManagementGroupId := ""
+	requireAuthorizationForGroupCreation := false
+	if props := resp.HierarchySettingsProperties; props != nil {
+		requireAuthorizationForGroupCreation = pointer.From(props.RequireAuthorizationForGroupCreation)
+
+		if props.DefaultManagementGroup != nil && *props.DefaultManagementGroup != "" {
+			defaultId, err := parse.ManagementGroupID(*props.DefaultManagementGroup)
+			if err != nil {
+				return err
+			}
+
+			// the Tenant Root Management Group is returned when no default Management Group has been set
+			if !strings.EqualFold(defaultId.Name, id.ManagementGroup) || d.Get("default_management_group_id").(string) != "" {
+				defaultManagementGroupId = defaultId.ID()
+			}
+		}
+	}
+	d.Set("default_management_group_id", defaultManagementGroupId)
+	d.Set("require_authorization_for_group_creation", requireAuthorizationForGroupCreation)
+
+	return nil
+}
+
+func resourceManagementGroupHierarchySettingsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.HierarchySettingsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagementGroupHierarchySettingsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	payload := managementgroups.CreateOrUpdateSettingsRequest{
+		CreateOrUpdateSettingsProperties: expandManagementGroupHierarchySettings(d),
+	}
+
+	// a PUT is used rather than a PATCH so that any omitted settings are reset
+	if _, err := client.CreateOrUpdate(ctx, id.ManagementGroup, payload); err != nil {
+		return fmt.Errorf("updating Hierarchy Settings for Management Group %q: %+v", id.ManagementGroup, err)
+	}
+
+	return resourceManagementGroupHierarchySettingsRead(d, meta)
+}
+
+func resourceManagementGroupHierarchySettingsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.HierarchySettingsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagementGroupHierarchySettingsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.ManagementGroup)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting Hierarchy Settings for Management Group %q: %+v", id.ManagementGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandManagementGroupHierarchySettings(d *pluginsdk.ResourceData) *managementgroups.CreateOrUpdateSettingsProperties {
+	props := &managementgroups.CreateOrUpdateSettingsProperties{
+		RequireAuthorizationForGroupCreation: pointer.To(d.Get("require_authorization_for_group_creation").(bool)),
+	}
+
+	// omitting the default Management Group places new Subscriptions in the Tenant Root Management Group
+	if v := d.Get("default_management_group_id").(string); v != "" {
+		props.DefaultManagementGroup = pointer.To(v)
+	}
+
+	return props
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagementGroupHierarchySettingsResource struct{}
+
+// NOTE: Hierarchy Settings are a singleton for the Tenant, so all steps are combined in a single test
+func TestAccManagementGroupHierarchySettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_hierarchy_settings", "test")
+	r := ManagementGroupHierarchySettingsResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		data.RequiresImportErrorStep(r.requiresImport),
+		{
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.requireAuthorization(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("require_authorization_for_group_creation").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ManagementGroupHierarchySettingsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagementGroupHierarchySettingsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ManagementGroups.HierarchySettingsClient.Get(ctx, id.ManagementGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Hierarchy Settings for Management Group %q: %+v", id.ManagementGroup, err)
+	}
+
+	return utils.Bool(resp.HierarchySettingsProperties != nil), nil
+}
+
+func (ManagementGroupHierarchySettingsResource) basic(data acceptance.TestData, defaultManagementGroup string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_management_group" "root" {
+  name = data.azurerm_client_config.current.tenant_id
+}
+
+resource "azurerm_management_group" "first" {
+  display_name = "acctestmg-first-%[1]d"
+}
+
+resource "azurerm_management_group" "second" {
+  display_name = "acctestmg-second-%[1]d"
+}
+
+resource "azurerm_management_group_hierarchy_settings" "test" {
+  management_group_id         = data.azurerm_management_group.root.id
+  default_management_group_id = azurerm_management_group.%[2]s.id
+}
+`, data.RandomInteger, defaultManagementGroup)
+}
+
+func (ManagementGroupHierarchySettingsResource) requireAuthorization(_ acceptance.TestData) string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_management_group" "root" {
+  name = data.azurerm_client_config.current.tenant_id
+}
+
+resource "azurerm_management_group_hierarchy_settings" "test" {
+  management_group_id                      = data.azurerm_management_group.root.id
+  require_authorization_for_group_creation = true
+}
+`
+}
+
+func (r ManagementGroupHierarchySettingsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_hierarchy_settings" "import" {
+  management_group_id         = azurerm_management_group_hierarchy_settings.test.management_group_id
+  default_management_group_id = azurerm_management_group_hierarchy_settings.test.default_management_group_id
+}
+`, r.basic(data, "first"))
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"regexp"
+)
+
+type ManagementGroupHierarchySettingsId struct {
+	ManagementGroup string
+}
+
+func NewManagementGroupHierarchySettingsID(managementGroupName string) ManagementGroupHierarchySettingsId {
+	return ManagementGroupHierarchySettingsId{
+		ManagementGroup: managementGroupName,
+	}
+}
+
+func (r ManagementGroupHierarchySettingsId) ID() string {
+	managementGroupHierarchySettingsFmt := "/providers/Microsoft.Management/managementGroups/%s/settings/default"
+	return fmt.Sprintf(managementGroupHierarchySettingsFmt, r.ManagementGroup)
+}
+
+func ManagementGroupHierarchySettingsID(input string) (*ManagementGroupHierarchySettingsId, error) {
+	regex := regexp.MustCompile(`^/providers/[Mm]icrosoft\.[Mm]anagement/[Mm]anagement[Gg]roups/([^/]+)/settings/default$`)
+	matches := regex.FindStringSubmatch(input)
+	if len(matches) != 2 {
+		return nil, fmt.Errorf("unable to parse Management Group Hierarchy Settings ID %q, format should look like '/providers/Microsoft.Management/managementGroups/<management_group_name>/settings/default'", input)
+	}
+
+	return &ManagementGroupHierarchySettingsId{
+		ManagementGroup: matches[1],
+	}, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "testing"
+
+func TestManagementGroupHierarchySettingsID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Error    bool
+		Expected *ManagementGroupHierarchySettingsId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "Management Group ID",
+			Input: "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+		{
+			Name:  "No Settings Name",
+			Input: "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000/settings/",
+			Error: true,
+		},
+		{
+			Name:  "Wrong Settings Name",
+			Input: "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000/settings/other",
+			Error: true,
+		},
+		{
+			Name:  "Hierarchy Settings ID",
+			Input: "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000/settings/default",
+			Expected: &ManagementGroupHierarchySettingsId{
+				ManagementGroup: "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Name:  "Hierarchy Settings ID with wrong casing",
+			Input: "/providers/microsoft.management/managementgroups/00000000-0000-0000-0000-000000000000/settings/default",
+			Expected: &ManagementGroupHierarchySettingsId{
+				ManagementGroup: "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Name:  "Extra Segments",
+			Input: "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000/settings/default/another",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ManagementGroupHierarchySettingsID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.ManagementGroup != v.Expected.ManagementGroup {
+			t.Fatalf("Expected %q but got %q for ManagementGroup", v.Expected.ManagementGroup, actual.ManagementGroup)
+		}
+	}
+}
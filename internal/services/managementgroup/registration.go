@@ -39,6 +39,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_management_group":                          resourceManagementGroup(),
+		"azurerm_management_group_hierarchy_settings":       resourceManagementGroupHierarchySettings(),
 		"azurerm_management_group_subscription_association": resourceManagementGroupSubscriptionAssociation(),
 	}
 }
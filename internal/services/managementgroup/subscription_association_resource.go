@@ -153,6 +153,14 @@ func resourceManagementGroupSubscriptionAssociationDelete(d *pluginsdk.ResourceD
 		return err
 	}
 
+	// when the Subscription is moved to another Management Group (e.g. using `create_before_destroy`) it's already been
+	// removed from this one - deleting the association at this point would move it back to the Tenant Root Management Group
+	// any error retrieving the Management Group is surfaced by the Delete below
+	if _, state, err := subscriptionAssociationRefreshFunc(ctx, meta.(*clients.Client).ManagementGroups.GroupsClient, *id)(); err == nil && state == "NotFound" {
+		log.Printf("[DEBUG] %s is no longer in Management Group %q - skipping removal", id.SubscriptionId, id.ManagementGroup)
+		return nil
+	}
+
 	resp, err := client.Delete(ctx, id.ManagementGroup, id.SubscriptionId, "")
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -30,6 +30,7 @@ func TestAccManagementGroupSubscriptionAssociation(t *testing.T) {
 		"Resource": {
 			"basic":          testAccManagementGroupSubscriptionAssociation_basic,
 			"requiresImport": testAccManagementGroupSubscriptionAssociation_requiresImport,
+			"move":           testAccManagementGroupSubscriptionAssociation_move,
 		},
 	}
 
@@ -77,6 +78,27 @@ func testAccManagementGroupSubscriptionAssociation_requiresImport(t *testing.T)
 	})
 }
 
+func testAccManagementGroupSubscriptionAssociation_move(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_subscription_association", "test")
+
+	r := ManagementGroupSubscriptionAssociation{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.move("first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.move("second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
 func (r ManagementGroupSubscriptionAssociation) basic() string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -97,6 +119,33 @@ resource "azurerm_management_group_subscription_association" "test" {
 `, os.Getenv("ARM_SUBSCRIPTION_ID_ALT"))
 }
 
+func (r ManagementGroupSubscriptionAssociation) move(managementGroup string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "test" {
+  subscription_id = %q
+}
+
+resource "azurerm_management_group" "first" {
+}
+
+resource "azurerm_management_group" "second" {
+}
+
+resource "azurerm_management_group_subscription_association" "test" {
+  management_group_id = azurerm_management_group.%s.id
+  subscription_id     = data.azurerm_subscription.test.id
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, os.Getenv("ARM_SUBSCRIPTION_ID_ALT"), managementGroup)
+}
+
 func (r ManagementGroupSubscriptionAssociation) requiresImport(_ acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maps/2023-06-01/accounts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -34,11 +36,54 @@ func dataSourceMapsAccount() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
 
+			"location": commonschema.LocationComputed(),
+
 			"sku_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 
+			"cors": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allowed_origins": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"identity": commonschema.SystemAssignedUserAssignedIdentityComputed(),
+
+			"local_authentication_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"data_store": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"unique_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"storage_account_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.Tags(),
 
 			"x_ms_client_id": {
@@ -83,9 +128,32 @@ func dataSourceMapsAccountRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	d.Set("resource_group_name", id.ResourceGroupName)
 
 	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
 		d.Set("sku_name", string(model.Sku.Name))
+
+		identityFlattened, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", identityFlattened); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
 		if props := model.Properties; props != nil {
 			d.Set("x_ms_client_id", props.UniqueId)
+			d.Set("cors", flattenCors(props.Cors))
+
+			dataStore, err := flattenDataStore(props.LinkedResources)
+			if err != nil {
+				return fmt.Errorf("flattening `data_store`: %+v", err)
+			}
+			d.Set("data_store", dataStore)
+
+			localAuthenticationEnabled := true
+			if props.DisableLocalAuth != nil {
+				localAuthenticationEnabled = !*props.DisableLocalAuth
+			}
+			d.Set("local_authentication_enabled", localAuthenticationEnabled)
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
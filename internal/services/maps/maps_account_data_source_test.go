@@ -30,6 +30,12 @@ func TestAccMapsAccountDataSource_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("x_ms_client_id").Exists(),
 				check.That(data.ResourceName).Key("primary_access_key").Exists(),
 				check.That(data.ResourceName).Key("secondary_access_key").Exists(),
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("identity.0.type").HasValue("SystemAssigned"),
+				check.That(data.ResourceName).Key("cors.0.allowed_origins.#").HasValue("2"),
+				check.That(data.ResourceName).Key("data_store.0.unique_name").HasValue("swampy"),
+				check.That(data.ResourceName).Key("data_store.0.storage_account_id").Exists(),
+				check.That(data.ResourceName).Key("local_authentication_enabled").HasValue("true"),
 			),
 		},
 	})
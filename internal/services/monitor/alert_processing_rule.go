@@ -6,6 +6,7 @@ package monitor
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
@@ -426,6 +427,52 @@ func expandAlertProcessingRuleSingleConditions(input []AlertProcessingRuleSingle
 	}
 }
 
+// validateAlertProcessingRuleSchedule validates the `schedule` block during the plan - `valueKnown` reports whether the
+// value at the given key is known, since values which aren't known yet are decoded as empty strings
+func validateAlertProcessingRuleSchedule(input []AlertProcessingRuleScheduleModel, valueKnown func(key string) bool) error {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	if v.EffectiveFrom != "" && v.EffectiveUntil != "" {
+		from, err := time.Parse("2006-01-02T15:04:05", v.EffectiveFrom)
+		if err != nil {
+			return fmt.Errorf("parsing `effective_from`: %+v", err)
+		}
+		until, err := time.Parse("2006-01-02T15:04:05", v.EffectiveUntil)
+		if err != nil {
+			return fmt.Errorf("parsing `effective_until`: %+v", err)
+		}
+		if !until.After(from) {
+			return fmt.Errorf("`effective_until` must be later than `effective_from`")
+		}
+	}
+
+	for i, recurrence := range v.Recurrence {
+		for j, item := range recurrence.Weekly {
+			key := fmt.Sprintf("schedule.0.recurrence.%d.weekly.%d", i, j)
+			if !valueKnown(key+".start_time") || !valueKnown(key+".end_time") {
+				continue
+			}
+			if (item.StartTime == "") != (item.EndTime == "") {
+				return fmt.Errorf("`start_time` and `end_time` must be specified together in a `weekly` recurrence")
+			}
+		}
+		for j, item := range recurrence.Monthly {
+			key := fmt.Sprintf("schedule.0.recurrence.%d.monthly.%d", i, j)
+			if !valueKnown(key+".start_time") || !valueKnown(key+".end_time") {
+				continue
+			}
+			if (item.StartTime == "") != (item.EndTime == "") {
+				return fmt.Errorf("`start_time` and `end_time` must be specified together in a `monthly` recurrence")
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandAlertProcessingRuleSchedule(input []AlertProcessingRuleScheduleModel) *alertprocessingrules.Schedule {
 	if len(input) == 0 {
 		return nil
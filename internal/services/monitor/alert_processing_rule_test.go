@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestValidateAlertProcessingRuleSchedule(t *testing.T) {
+	testData := []struct {
+		name    string
+		input   []AlertProcessingRuleScheduleModel
+		unknown []string
+		valid   bool
+	}{
+		{
+			name:  "no schedule",
+			input: []AlertProcessingRuleScheduleModel{},
+			valid: true,
+		},
+		{
+			name: "only effective_from",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					EffectiveFrom: "2022-01-01T01:02:03",
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "effective_until after effective_from",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					EffectiveFrom:  "2022-01-01T01:02:03",
+					EffectiveUntil: "2022-02-02T01:02:03",
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "effective_until equal to effective_from",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					EffectiveFrom:  "2022-01-01T01:02:03",
+					EffectiveUntil: "2022-01-01T01:02:03",
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "effective_until before effective_from",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					EffectiveFrom:  "2022-02-02T01:02:03",
+					EffectiveUntil: "2022-01-01T01:02:03",
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid effective_from",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					EffectiveFrom:  "2022-01-01",
+					EffectiveUntil: "2022-02-02T01:02:03",
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid effective_until",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					EffectiveFrom:  "2022-01-01T01:02:03",
+					EffectiveUntil: "2022-02-02",
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "weekly with start_time and end_time",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					Recurrence: []AlertProcessingRuleRecurrenceModel{
+						{
+							Weekly: []AlertProcessingRuleWeeklyModel{
+								{
+									StartTime:  "06:00:00",
+									EndTime:    "07:00:00",
+									DaysOfWeek: []string{"Monday"},
+								},
+							},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "weekly without times",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					Recurrence: []AlertProcessingRuleRecurrenceModel{
+						{
+							Weekly: []AlertProcessingRuleWeeklyModel{
+								{
+									DaysOfWeek: []string{"Monday"},
+								},
+							},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "weekly with only start_time",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					Recurrence: []AlertProcessingRuleRecurrenceModel{
+						{
+							Weekly: []AlertProcessingRuleWeeklyModel{
+								{
+									StartTime:  "06:00:00",
+									DaysOfWeek: []string{"Monday"},
+								},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "weekly with only end_time and an unknown start_time",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					Recurrence: []AlertProcessingRuleRecurrenceModel{
+						{
+							Weekly: []AlertProcessingRuleWeeklyModel{
+								{
+									EndTime:    "07:00:00",
+									DaysOfWeek: []string{"Monday"},
+								},
+							},
+						},
+					},
+				},
+			},
+			unknown: []string{"schedule.0.recurrence.0.weekly.0.start_time"},
+			valid:   true,
+		},
+		{
+			name: "monthly with start_time and end_time",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					Recurrence: []AlertProcessingRuleRecurrenceModel{
+						{
+							Monthly: []AlertProcessingRuleMonthlyModel{
+								{
+									StartTime:   "06:00:00",
+									EndTime:     "07:00:00",
+									DaysOfMonth: []int64{1},
+								},
+							},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "monthly with only end_time",
+			input: []AlertProcessingRuleScheduleModel{
+				{
+					Recurrence: []AlertProcessingRuleRecurrenceModel{
+						{
+							Monthly: []AlertProcessingRuleMonthlyModel{
+								{
+									EndTime:     "07:00:00",
+									DaysOfMonth: []int64{1},
+								},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		err := validateAlertProcessingRuleSchedule(v.input, func(key string) bool {
+			return !slices.Contains(v.unknown, key)
+		})
+		if v.valid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.name, err)
+		}
+		if !v.valid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.name)
+		}
+	}
+}
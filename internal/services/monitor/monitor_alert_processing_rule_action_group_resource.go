@@ -32,6 +32,8 @@ type AlertProcessingRuleActionGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertProcessingRuleActionGroupResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = AlertProcessingRuleActionGroupResource{}
+
 func (r AlertProcessingRuleActionGroupResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_action_group"
 }
@@ -176,6 +178,20 @@ func (r AlertProcessingRuleActionGroupResource) Update() sdk.ResourceFunc {
 	}
 }
 
+func (r AlertProcessingRuleActionGroupResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model AlertProcessingRuleActionGroupModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return validateAlertProcessingRuleSchedule(model.Schedule, metadata.ResourceDiff.NewValueKnown)
+		},
+	}
+}
+
 func (r AlertProcessingRuleActionGroupResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
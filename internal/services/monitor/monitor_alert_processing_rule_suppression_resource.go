@@ -30,6 +30,8 @@ type AlertProcessingRuleSuppressionResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertProcessingRuleSuppressionResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = AlertProcessingRuleSuppressionResource{}
+
 func (r AlertProcessingRuleSuppressionResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_suppression"
 }
@@ -163,6 +165,20 @@ func (r AlertProcessingRuleSuppressionResource) Update() sdk.ResourceFunc {
 	}
 }
 
+func (r AlertProcessingRuleSuppressionResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model AlertProcessingRuleSuppressionModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return validateAlertProcessingRuleSchedule(model.Schedule, metadata.ResourceDiff.NewValueKnown)
+		},
+	}
+}
+
 func (r AlertProcessingRuleSuppressionResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
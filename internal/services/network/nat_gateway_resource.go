@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -47,6 +48,13 @@ func resourceNatGateway() *pluginsdk.Resource {
 		}),
 
 		Schema: resourceNatGatewaySchema(),
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("zones", func(ctx context.Context, old, new, meta interface{}) bool {
+				// a zonal NAT Gateway can be expanded into additional zones in-place, any other change requires recreation
+				return !natGatewayZonesCanBeExpanded(old.(*pluginsdk.Set).List(), new.(*pluginsdk.Set).List())
+			}),
+		),
 	}
 }
 
@@ -79,13 +87,37 @@ func resourceNatGatewaySchema() map[string]*pluginsdk.Schema {
 			}, false),
 		},
 
-		"zones": commonschema.ZonesMultipleOptionalForceNew(),
+		"zones": commonschema.ZonesMultipleOptional(),
+
+		"public_ip_address_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"public_ip_prefix_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
 
 		"resource_guid": {
 			Type:     pluginsdk.TypeString,
 			Computed: true,
 		},
 
+		"subnet_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
 		"tags": commonschema.Tags(),
 	}
 }
@@ -193,6 +225,11 @@ func resourceNatGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
+	if d.HasChange("zones") {
+		zones := zones.ExpandUntyped(d.Get("zones").(*schema.Set).List())
+		payload.Zones = &zones
+	}
+
 	if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
@@ -234,6 +271,16 @@ func resourceNatGatewayRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		if props := model.Properties; props != nil {
 			d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
 			d.Set("resource_guid", props.ResourceGuid)
+
+			if err := d.Set("public_ip_address_ids", flattenNetworkSubResourceID(props.PublicIPAddresses)); err != nil {
+				return fmt.Errorf("setting `public_ip_address_ids`: %+v", err)
+			}
+			if err := d.Set("public_ip_prefix_ids", flattenNetworkSubResourceID(props.PublicIPPrefixes)); err != nil {
+				return fmt.Errorf("setting `public_ip_prefix_ids`: %+v", err)
+			}
+			if err := d.Set("subnet_ids", flattenNetworkSubResourceID(props.Subnets)); err != nil {
+				return fmt.Errorf("setting `subnet_ids`: %+v", err)
+			}
 		}
 		return tags.FlattenAndSet(d, model.Tags)
 	}
@@ -259,3 +306,24 @@ func resourceNatGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+func natGatewayZonesCanBeExpanded(old, new []interface{}) bool {
+	// a regional (non-zonal) NAT Gateway can't be made zonal, nor can a zonal NAT Gateway be made regional
+	if len(old) == 0 || len(new) == 0 {
+		return false
+	}
+
+	newZones := make(map[string]struct{}, len(new))
+	for _, z := range new {
+		newZones[z.(string)] = struct{}{}
+	}
+
+	// zones can only be added, removing a zone requires recreating the NAT Gateway
+	for _, z := range old {
+		if _, ok := newZones[z.(string)]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -75,6 +75,30 @@ func TestAccNatGateway_update(t *testing.T) {
 	})
 }
 
+func TestAccNatGateway_zonesExpansion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_nat_gateway", "test")
+	r := NatGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.zones(data, `["1"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zones.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.zones(data, `["1", "2", "3"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zones.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t NatGatewayResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := natgateways.ParseNatGatewayID(state.ID)
 	if err != nil {
@@ -158,3 +182,24 @@ resource "azurerm_nat_gateway_public_ip_prefix_association" "test" {
 }
 `, data.RandomInteger, data.Locations.Secondary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
+
+func (NatGatewayResource) zones(data acceptance.TestData, zones string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-network-%d"
+  location = "%s"
+}
+
+resource "azurerm_nat_gateway" "test" {
+  name                = "acctestnatGateway-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Standard"
+  zones               = %s
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, zones)
+}
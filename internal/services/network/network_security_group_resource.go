@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourcemove"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -54,7 +56,11 @@ func resourceNetworkSecurityGroup() *pluginsdk.Resource {
 
 			"location": commonschema.Location(),
 
-			"resource_group_name": commonschema.ResourceGroupName(),
+			"resource_group_name": resourcemove.ResourceGroupNameSchema(),
+
+			resourcemove.ResourceGroupMoveEnabledField: resourcemove.ResourceGroupMoveEnabledSchema(),
+
+			"id": resourcemove.IDSchema(),
 
 			"security_rule": {
 				Type:       pluginsdk.TypeSet,
@@ -178,6 +184,11 @@ func resourceNetworkSecurityGroup() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			resourcemove.ForceNewResourceGroupNameUnlessMoveEnabled(),
+			resourcemove.ComputeNewIDWhenMoved(),
+		),
 	}
 
 	return resource
@@ -238,6 +249,17 @@ func resourceNetworkSecurityGroupUpdate(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
+	if d.HasChange("resource_group_name") {
+		resourceGroupName := d.Get("resource_group_name").(string)
+		sourceResourceGroup := commonids.NewResourceGroupID(id.SubscriptionId, id.ResourceGroupName)
+		if err := resourcemove.MoveToResourceGroup(ctx, meta.(*clients.Client).Resource.GenericResourcesClient, id.ID(), sourceResourceGroup, resourceGroupName); err != nil {
+			return err
+		}
+
+		id.ResourceGroupName = resourceGroupName
+		d.SetId(id.ID())
+	}
+
 	existing, err := client.Get(ctx, *id, networksecuritygroups.DefaultGetOperationOptions())
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -50,6 +50,30 @@ func TestAccNetworkSecurityGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccNetworkSecurityGroup_moveResourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_group", "test")
+	r := NetworkSecurityGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.moveResourceGroup(data, "azurerm_resource_group.test.name"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.moveResourceGroup(data, "azurerm_resource_group.test2.name"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_group_name").HasValue(fmt.Sprintf("acctestRG2-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("security_rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccNetworkSecurityGroup_singleRule(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_network_security_group", "test")
 	r := NetworkSecurityGroupResource{}
@@ -265,6 +289,43 @@ resource "azurerm_network_security_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (NetworkSecurityGroupResource) moveResourceGroup(data acceptance.TestData, resourceGroupName string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group" "test2" {
+  name     = "acctestRG2-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                        = "acceptanceTestSecurityGroup1"
+  location                    = azurerm_resource_group.test.location
+  resource_group_name         = %[3]s
+  resource_group_move_enabled = true
+
+  security_rule {
+    name                       = "test123"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, resourceGroupName)
+}
+
 func (r NetworkSecurityGroupResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/privatednszonegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/privateendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/privatezones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PrivateEndpointPrivateDnsZoneGroupModel struct {
+	Name                  string                                     `tfschema:"name"`
+	PrivateEndpointId     string                                     `tfschema:"private_endpoint_id"`
+	PrivateDnsZoneConfigs []PrivateEndpointPrivateDnsZoneConfigModel `tfschema:"private_dns_zone_config"`
+}
+
+type PrivateEndpointPrivateDnsZoneConfigModel struct {
+	Id               string                                   `tfschema:"id"`
+	Name             string                                   `tfschema:"name"`
+	PrivateDnsZoneId string                                   `tfschema:"private_dns_zone_id"`
+	RecordSets       []PrivateEndpointPrivateDnsZoneRecordSet `tfschema:"record_sets"`
+}
+
+type PrivateEndpointPrivateDnsZoneRecordSet struct {
+	Fqdn        string   `tfschema:"fqdn"`
+	IPAddresses []string `tfschema:"ip_addresses"`
+	Name        string   `tfschema:"name"`
+	Ttl         int64    `tfschema:"ttl"`
+	Type        string   `tfschema:"type"`
+}
+
+type PrivateEndpointPrivateDnsZoneGroupResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateEndpointPrivateDnsZoneGroupResource{}
+
+func (r PrivateEndpointPrivateDnsZoneGroupResource) ResourceType() string {
+	return "azurerm_private_endpoint_private_dns_zone_group"
+}
+
+func (r PrivateEndpointPrivateDnsZoneGroupResource) ModelObject() interface{} {
+	return &PrivateEndpointPrivateDnsZoneGroupModel{}
+}
+
+func (r PrivateEndpointPrivateDnsZoneGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return privatednszonegroups.ValidatePrivateDnsZoneGroupID
+}
+
+func (r PrivateEndpointPrivateDnsZoneGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateLinkName,
+		},
+
+		"private_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: privateendpoints.ValidatePrivateEndpointID,
+		},
+
+		"private_dns_zone_config": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			// a Private DNS Zone Group supports up to 5 Private DNS Zones
+			MaxItems: 5,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"private_dns_zone_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: privatezones.ValidatePrivateDnsZoneID,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"record_sets": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"type": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"fqdn": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"ttl": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"ip_addresses": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r PrivateEndpointPrivateDnsZoneGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateEndpointPrivateDnsZoneGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateDnsZoneGroups
+
+			var model PrivateEndpointPrivateDnsZoneGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			privateEndpointId, err := privateendpoints.ParsePrivateEndpointID(model.PrivateEndpointId)
+			if err != nil {
+				return err
+			}
+
+			id := privatednszonegroups.NewPrivateDnsZoneGroupID(privateEndpointId.SubscriptionId, privateEndpointId.ResourceGroupName, privateEndpointId.PrivateEndpointName, model.Name)
+
+			locks.ByName(id.PrivateEndpointName, "azurerm_private_endpoint")
+			defer locks.UnlockByName(id.PrivateEndpointName, "azurerm_private_endpoint")
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := privatednszonegroups.PrivateDnsZoneGroup{
+				Name: pointer.To(id.PrivateDnsZoneGroupName),
+				Properties: &privatednszonegroups.PrivateDnsZoneGroupPropertiesFormat{
+					PrivateDnsZoneConfigs: expandPrivateEndpointPrivateDnsZoneConfigs(model.PrivateDnsZoneConfigs),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateEndpointPrivateDnsZoneGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateDnsZoneGroups
+
+			id, err := privatednszonegroups.ParsePrivateDnsZoneGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateEndpointPrivateDnsZoneGroupModel{
+				Name:              id.PrivateDnsZoneGroupName,
+				PrivateEndpointId: privateendpoints.NewPrivateEndpointID(id.SubscriptionId, id.ResourceGroupName, id.PrivateEndpointName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.PrivateDnsZoneConfigs = flattenPrivateEndpointPrivateDnsZoneConfigs(*id, props.PrivateDnsZoneConfigs)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateEndpointPrivateDnsZoneGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateDnsZoneGroups
+
+			id, err := privatednszonegroups.ParsePrivateDnsZoneGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateEndpointPrivateDnsZoneGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.PrivateEndpointName, "azurerm_private_endpoint")
+			defer locks.UnlockByName(id.PrivateEndpointName, "azurerm_private_endpoint")
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("private_dns_zone_config") {
+				payload.Properties.PrivateDnsZoneConfigs = expandPrivateEndpointPrivateDnsZoneConfigs(model.PrivateDnsZoneConfigs)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateEndpointPrivateDnsZoneGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateDnsZoneGroups
+
+			id, err := privatednszonegroups.ParsePrivateDnsZoneGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.PrivateEndpointName, "azurerm_private_endpoint")
+			defer locks.UnlockByName(id.PrivateEndpointName, "azurerm_private_endpoint")
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrivateEndpointPrivateDnsZoneConfigs(input []PrivateEndpointPrivateDnsZoneConfigModel) *[]privatednszonegroups.PrivateDnsZoneConfig {
+	output := make([]privatednszonegroups.PrivateDnsZoneConfig, 0)
+	for _, v := range input {
+		output = append(output, privatednszonegroups.PrivateDnsZoneConfig{
+			Name: pointer.To(v.Name),
+			Properties: &privatednszonegroups.PrivateDnsZonePropertiesFormat{
+				PrivateDnsZoneId: pointer.To(v.PrivateDnsZoneId),
+			},
+		})
+	}
+	return &output
+}
+
+func flattenPrivateEndpointPrivateDnsZoneConfigs(id privatednszonegroups.PrivateDnsZoneGroupId, input *[]privatednszonegroups.PrivateDnsZoneConfig) []PrivateEndpointPrivateDnsZoneConfigModel {
+	output := make([]PrivateEndpointPrivateDnsZoneConfigModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.Name == nil {
+			// necessary to build up the ID
+			continue
+		}
+
+		config := PrivateEndpointPrivateDnsZoneConfigModel{
+			Id:         parse.NewPrivateDnsZoneConfigID(id.SubscriptionId, id.ResourceGroupName, id.PrivateEndpointName, id.PrivateDnsZoneGroupName, *v.Name).ID(),
+			Name:       *v.Name,
+			RecordSets: make([]PrivateEndpointPrivateDnsZoneRecordSet, 0),
+		}
+
+		if props := v.Properties; props != nil {
+			config.PrivateDnsZoneId = pointer.From(props.PrivateDnsZoneId)
+
+			if props.RecordSets != nil {
+				for _, recordSet := range *props.RecordSets {
+					config.RecordSets = append(config.RecordSets, PrivateEndpointPrivateDnsZoneRecordSet{
+						Fqdn:        pointer.From(recordSet.Fqdn),
+						IPAddresses: pointer.From(recordSet.IPAddresses),
+						Name:        pointer.From(recordSet.RecordSetName),
+						Ttl:         pointer.From(recordSet.Ttl),
+						Type:        pointer.From(recordSet.RecordType),
+					})
+				}
+			}
+		}
+
+		output = append(output, config)
+	}
+
+	return output
+}
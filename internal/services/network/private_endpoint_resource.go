@@ -99,10 +99,14 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			// NOTE: this is Optional/Computed since the Private DNS Zone Group can also be managed using the separate
+			// `azurerm_private_endpoint_private_dns_zone_group` resource
 			"private_dns_zone_group": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:       pluginsdk.TypeList,
+				ConfigMode: pluginsdk.SchemaConfigModeAttr,
+				Optional:   true,
+				Computed:   true,
+				MaxItems:   1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"id": {
@@ -702,6 +702,8 @@ resource "azurerm_private_endpoint" "test" {
     subresource_names              = ["postgresqlServer"]
     is_manual_connection           = false
   }
+
+  private_dns_zone_group = []
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
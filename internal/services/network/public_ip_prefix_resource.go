@@ -53,6 +53,8 @@ func resourcePublicIpPrefix() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupName(),
 
+			"edge_zone": commonschema.EdgeZoneOptionalForceNew(),
+
 			"sku": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -115,7 +117,8 @@ func resourcePublicIpPrefixCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	publicIpPrefix := publicipprefixes.PublicIPPrefix{
-		Location: pointer.To(location.Normalize(d.Get("location").(string))),
+		ExtendedLocation: expandEdgeZoneNew(d.Get("edge_zone").(string)),
+		Location:         pointer.To(location.Normalize(d.Get("location").(string))),
 		Sku: &publicipprefixes.PublicIPPrefixSku{
 			Name: pointer.To(publicipprefixes.PublicIPPrefixSkuName(d.Get("sku").(string))),
 		},
@@ -189,6 +192,7 @@ func resourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) err
 
 	if model := resp.Model; model != nil {
 		d.Set("location", location.NormalizeNilable(model.Location))
+		d.Set("edge_zone", flattenEdgeZoneNew(model.ExtendedLocation))
 		d.Set("zones", zones.FlattenUntyped(model.Zones))
 		skuName := ""
 		if sku := model.Sku; sku != nil {
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourcemove"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -59,7 +60,7 @@ func resourcePublicIp() *pluginsdk.Resource {
 
 			"location": commonschema.Location(),
 
-			"resource_group_name": commonschema.ResourceGroupName(),
+			"resource_group_name": resourcemove.ResourceGroupNameSchema(),
 
 			"allocation_method": {
 				Type:     pluginsdk.TypeString,
@@ -157,6 +158,10 @@ func resourcePublicIp() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			resourcemove.ResourceGroupMoveEnabledField: resourcemove.ResourceGroupMoveEnabledSchema(),
+
+			"id": resourcemove.IDSchema(),
+
 			"public_ip_prefix_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -177,6 +182,11 @@ func resourcePublicIp() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			resourcemove.ForceNewResourceGroupNameUnlessMoveEnabled(),
+			resourcemove.ComputeNewIDWhenMoved(),
+		),
 	}
 }
 
@@ -306,6 +316,17 @@ func resourcePublicIpUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if d.HasChange("resource_group_name") {
+		resourceGroupName := d.Get("resource_group_name").(string)
+		sourceResourceGroup := commonids.NewResourceGroupID(id.SubscriptionId, id.ResourceGroupName)
+		if err := resourcemove.MoveToResourceGroup(ctx, meta.(*clients.Client).Resource.GenericResourcesClient, id.ID(), sourceResourceGroup, resourceGroupName); err != nil {
+			return err
+		}
+
+		id.ResourceGroupName = resourceGroupName
+		d.SetId(id.ID())
+	}
+
 	existing, err := client.Get(ctx, *id, publicipaddresses.DefaultGetOperationOptions())
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -61,6 +61,29 @@ func TestAccPublicIpStatic_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccPublicIpStatic_moveResourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
+	r := PublicIPResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.moveResourceGroup(data, "azurerm_resource_group.test.name"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.moveResourceGroup(data, "azurerm_resource_group.test2.name"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_group_name").HasValue(fmt.Sprintf("acctestRG2-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccPublicIp_zonesSingle(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
 	r := PublicIPResource{}
@@ -489,6 +512,33 @@ resource "azurerm_public_ip" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (PublicIPResource) moveResourceGroup(data acceptance.TestData, resourceGroupName string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group" "test2" {
+  name     = "acctestRG2-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                        = "acctestpublicip-%[1]d"
+  location                    = azurerm_resource_group.test.location
+  resource_group_name         = %[3]s
+  resource_group_move_enabled = true
+  allocation_method           = "Static"
+  sku                         = "Standard"
+}
+`, data.RandomInteger, data.Locations.Primary, resourceGroupName)
+}
+
 func (r PublicIPResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
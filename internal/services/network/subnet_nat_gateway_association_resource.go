@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/responsecache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -100,7 +101,10 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 		}
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *subnetId, *subnet.Model); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, *subnetId, *subnet.Model)
+	// the association is read from the Subnet, so any cached response is stale once this has been (even partially) changed
+	invalidateCachedSubnet(meta, *subnetId)
+	if err != nil {
 		return fmt.Errorf("updating NAT Gateway Association for %s: %+v", *subnetId, err)
 	}
 
@@ -144,7 +148,10 @@ func resourceSubnetNatGatewayAssociationRead(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	subnet, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
+	// the Route Table, NAT Gateway and Network Security Group associations all read the same Subnet, so the response can be shared
+	subnet, err := responsecache.Get(meta.(*clients.Client).ResponseCache, id.ID(), func() (subnets.GetOperationResponse, error) {
+		return client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
+	})
 	if err != nil {
 		if response.WasNotFound(subnet.HttpResponse) {
 			log.Printf("[DEBUG] %s could not be found - removing from state!", *id)
@@ -232,7 +239,9 @@ func resourceSubnetNatGatewayAssociationDelete(d *pluginsdk.ResourceData, meta i
 
 	subnet.Model.Properties.NatGateway = nil
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *id, *subnet.Model); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, *id, *subnet.Model)
+	invalidateCachedSubnet(meta, *id)
+	if err != nil {
 		return fmt.Errorf("removing %s: %+v", *id, err)
 	}
 
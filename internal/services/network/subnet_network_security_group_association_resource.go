@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/responsecache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -104,7 +105,10 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 		}
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *subnetId, *subnet.Model); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, *subnetId, *subnet.Model)
+	// the association is read from the Subnet, so any cached response is stale once this has been (even partially) changed
+	invalidateCachedSubnet(meta, *subnetId)
+	if err != nil {
 		return fmt.Errorf("updating Network Security Group Association for %s: %+v", *subnetId, err)
 	}
 
@@ -148,7 +152,9 @@ func resourceSubnetNetworkSecurityGroupAssociationRead(d *pluginsdk.ResourceData
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
+	resp, err := responsecache.Get(meta.(*clients.Client).ResponseCache, id.ID(), func() (subnets.GetOperationResponse, error) {
+		return client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
+	})
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s could not be found - removing from state!", *id)
@@ -245,7 +251,9 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 
 	read.Model.Properties.NetworkSecurityGroup = nil
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *id, *read.Model); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, *id, *read.Model)
+	invalidateCachedSubnet(meta, *id)
+	if err != nil {
 		return fmt.Errorf("removing Network Security Group Association from %s: %+v", *id, err)
 	}
 
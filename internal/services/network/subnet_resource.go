@@ -429,7 +429,10 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		Properties: &properties,
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, id, subnet); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, id, subnet)
+	// the Subnet may have been partially created even when this fails, so any cached response is removed regardless
+	invalidateCachedSubnet(meta, id)
+	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -583,7 +586,9 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		Properties: &props,
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *id, subnet); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, *id, subnet)
+	invalidateCachedSubnet(meta, *id)
+	if err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
@@ -702,7 +707,9 @@ func resourceSubnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	locks.ByName(id.SubnetName, SubnetResourceName)
 	defer locks.UnlockByName(id.SubnetName, SubnetResourceName)
 
-	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+	err = client.DeleteThenPoll(ctx, *id)
+	invalidateCachedSubnet(meta, *id)
+	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
@@ -878,6 +885,14 @@ func flattenSubnetServiceEndpointPolicies(input *[]subnets.ServiceEndpointPolicy
 	return output
 }
 
+// invalidateCachedSubnet removes any cached response for the Subnet and for the Virtual Network containing it, since
+// the Virtual Network includes its Subnets
+func invalidateCachedSubnet(meta interface{}, id commonids.SubnetId) {
+	cache := meta.(*clients.Client).ResponseCache
+	cache.Invalidate(id.ID())
+	cache.Invalidate(commonids.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName).ID())
+}
+
 func SubnetProvisioningStateRefreshFunc(ctx context.Context, client *subnets.SubnetsClient, id commonids.SubnetId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.Get(ctx, id, subnets.DefaultGetOperationOptions())
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/responsecache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -103,7 +104,10 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 		}
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *id, *subnet.Model); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, *id, *subnet.Model)
+	// the association is read from the Subnet, so any cached response is stale once this has been (even partially) changed
+	invalidateCachedSubnet(meta, *id)
+	if err != nil {
 		return fmt.Errorf("updating Route Table Association for %s: %+v", id, err)
 	}
 
@@ -147,7 +151,9 @@ func resourceSubnetRouteTableAssociationRead(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
+	resp, err := responsecache.Get(meta.(*clients.Client).ResponseCache, id.ID(), func() (subnets.GetOperationResponse, error) {
+		return client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
+	})
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s could not be found - removing from state!", id)
@@ -241,7 +247,9 @@ func resourceSubnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta i
 
 	read.Model.Properties.RouteTable = nil
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *id, *read.Model); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, *id, *read.Model)
+	invalidateCachedSubnet(meta, *id)
+	if err != nil {
 		return fmt.Errorf("removing Route Table Association from %s: %+v", id, err)
 	}
 
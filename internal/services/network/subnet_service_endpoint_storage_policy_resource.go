@@ -60,7 +60,6 @@ func resourceSubnetServiceEndpointStoragePolicy() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				MinItems: 1,
-				MaxItems: 2,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -77,14 +76,7 @@ func resourceSubnetServiceEndpointStoragePolicy() *pluginsdk.Resource {
 								ValidateFunc: validation.Any(
 									azure.ValidateResourceID,
 									mgValidate.ManagementGroupID,
-									validation.StringInSlice([]string{
-										"/services/Azure",
-										"/services/Azure/Batch",
-										"/services/Azure/DataFactory",
-										"/services/Azure/MachineLearning",
-										"/services/Azure/ManagedInstance",
-										"/services/Azure/WebPI",
-									}, false),
+									validation.StringInSlice(serviceEndpointPolicyServiceAliases(), false),
 								),
 							},
 						},
@@ -132,6 +124,10 @@ func resourceSubnetServiceEndpointStoragePolicyCreate(d *pluginsdk.ResourceData,
 		return tf.ImportAsExistsError("azurerm_subnet_service_endpoint_storage_policy", id.ID())
 	}
 
+	if err := validateServiceEndpointPolicyDefinitions(d.Get("definition").([]interface{})); err != nil {
+		return err
+	}
+
 	param := serviceendpointpolicies.ServiceEndpointPolicy{
 		Location: pointer.To(location.Normalize(d.Get("location").(string))),
 		Properties: &serviceendpointpolicies.ServiceEndpointPolicyPropertiesFormat{
@@ -174,6 +170,9 @@ func resourceSubnetServiceEndpointStoragePolicyUpdate(d *pluginsdk.ResourceData,
 	payload := existing.Model
 
 	if d.HasChange("definition") {
+		if err := validateServiceEndpointPolicyDefinitions(d.Get("definition").([]interface{})); err != nil {
+			return err
+		}
 		payload.Properties = &serviceendpointpolicies.ServiceEndpointPolicyPropertiesFormat{
 			ServiceEndpointPolicyDefinitions: expandServiceEndpointPolicyDefinitions(d.Get("definition").([]interface{})),
 		}
@@ -244,6 +243,49 @@ func resourceSubnetServiceEndpointStoragePolicyDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
+func serviceEndpointPolicyServiceAliases() []string {
+	return []string{
+		"/services/Azure",
+		"/services/Azure/Batch",
+		"/services/Azure/DataFactory",
+		"/services/Azure/DatabricksCommon",
+		"/services/Azure/MachineLearning",
+		"/services/Azure/ManagedInstance",
+		"/services/Azure/WebPI",
+	}
+}
+
+// validateServiceEndpointPolicyDefinitions ensures that each definition contains either Service Aliases (with a `service` of `Global`)
+// or Resource IDs (with a `service` of `Microsoft.Storage`), since the API rejects definitions which mix the two
+func validateServiceEndpointPolicyDefinitions(input []interface{}) error {
+	aliases := serviceEndpointPolicyServiceAliases()
+	for _, e := range input {
+		e := e.(map[string]interface{})
+		name := e["name"].(string)
+		service := e["service"].(string)
+
+		aliasCount := 0
+		resources := e["service_resources"].(*pluginsdk.Set).List()
+		for _, r := range resources {
+			if utils.SliceContainsValue(aliases, r.(string)) {
+				aliasCount++
+			}
+		}
+
+		if aliasCount > 0 && aliasCount != len(resources) {
+			return fmt.Errorf("`service_resources` in the definition %q must contain either Service Aliases or Resource IDs, but not both", name)
+		}
+		if aliasCount > 0 && service != "Global" {
+			return fmt.Errorf("`service` must be `Global` when `service_resources` in the definition %q contains Service Aliases", name)
+		}
+		if aliasCount == 0 && len(resources) > 0 && service != "Microsoft.Storage" {
+			return fmt.Errorf("`service` must be `Microsoft.Storage` when `service_resources` in the definition %q contains Resource IDs", name)
+		}
+	}
+
+	return nil
+}
+
 func expandServiceEndpointPolicyDefinitions(input []interface{}) *[]serviceendpointpolicies.ServiceEndpointPolicyDefinition {
 	if len(input) == 0 {
 		return nil
@@ -78,6 +78,22 @@ func TestAccSubnetServiceEndpointStoragePolicy_storage(t *testing.T) {
 	})
 }
 
+func TestAccSubnetServiceEndpointStoragePolicy_multipleDefinitions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet_service_endpoint_storage_policy", "test")
+	r := SubnetServiceEndpointPolicyStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleDefinitions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("definition.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSubnetServiceEndpointStoragePolicy_update_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_subnet_service_endpoint_storage_policy", "test")
 	r := SubnetServiceEndpointPolicyStorageResource{}
@@ -279,6 +295,7 @@ resource "azurerm_subnet_service_endpoint_storage_policy" "test" {
       "/services/Azure",
       "/services/Azure/Batch",
       "/services/Azure/DataFactory",
+      "/services/Azure/DatabricksCommon",
       "/services/Azure/MachineLearning",
       "/services/Azure/ManagedInstance",
       "/services/Azure/WebPI",
@@ -328,6 +345,52 @@ resource "azurerm_subnet_service_endpoint_storage_policy" "test" {
 `, r.template(data), data.RandomString, data.RandomInteger, data.Client().SubscriptionID)
 }
 
+func (r SubnetServiceEndpointPolicyStorageResource) multipleDefinitions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestasasepd%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "GRS"
+}
+
+resource "azurerm_subnet_service_endpoint_storage_policy" "test" {
+  name                = "acctestSEP-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  definition {
+    name        = "storageaccount"
+    description = "test definition1"
+    service     = "Microsoft.Storage"
+    service_resources = [
+      azurerm_storage_account.test.id,
+    ]
+  }
+
+  definition {
+    name        = "resourcegroup"
+    description = "test definition2"
+    service     = "Microsoft.Storage"
+    service_resources = [
+      azurerm_resource_group.test.id,
+    ]
+  }
+
+  definition {
+    name        = "alias"
+    description = "test definition3"
+    service     = "Global"
+    service_resources = [
+      "/services/Azure/DatabricksCommon",
+    ]
+  }
+}
+`, r.template(data), data.RandomString, data.RandomInteger)
+}
+
 func (r SubnetServiceEndpointPolicyStorageResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualnetworks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/responsecache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -96,7 +97,10 @@ func resourceVirtualNetworkDnsServersCreate(d *pluginsdk.ResourceData, meta inte
 
 	vnet.Model.Properties.DhcpOptions.DnsServers = utils.ExpandStringSlice(d.Get("dns_servers").([]interface{}))
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *vnetId, *vnet.Model); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, *vnetId, *vnet.Model)
+	// the DNS Servers are read from the Virtual Network, so any cached response is stale once this has been (even partially) changed
+	meta.(*clients.Client).ResponseCache.Invalidate(vnetId.ID())
+	if err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
@@ -129,7 +133,9 @@ func resourceVirtualNetworkDnsServersRead(d *pluginsdk.ResourceData, meta interf
 
 	vnetId := commonids.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
 
-	resp, err := client.Get(ctx, vnetId, virtualnetworks.DefaultGetOperationOptions())
+	resp, err := responsecache.Get(meta.(*clients.Client).ResponseCache, vnetId.ID(), func() (virtualnetworks.GetOperationResponse, error) {
+		return client.Get(ctx, vnetId, virtualnetworks.DefaultGetOperationOptions())
+	})
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
@@ -190,7 +196,9 @@ func resourceVirtualNetworkDnsServersUpdate(d *pluginsdk.ResourceData, meta inte
 		vnet.Model.Properties.DhcpOptions.DnsServers = utils.ExpandStringSlice(d.Get("dns_servers").([]interface{}))
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *vnetId, *vnet.Model); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, *vnetId, *vnet.Model)
+	meta.(*clients.Client).ResponseCache.Invalidate(vnetId.ID())
+	if err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
@@ -249,7 +257,9 @@ func resourceVirtualNetworkDnsServersDelete(d *pluginsdk.ResourceData, meta inte
 
 	vnet.Model.Properties.DhcpOptions.DnsServers = utils.ExpandStringSlice(make([]interface{}, 0))
 
-	if err := client.CreateOrUpdateThenPoll(ctx, vnetId, *vnet.Model); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, vnetId, *vnet.Model)
+	meta.(*clients.Client).ResponseCache.Invalidate(vnetId.ID())
+	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
 
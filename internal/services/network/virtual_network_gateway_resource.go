@@ -5,8 +5,10 @@ package network
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -47,6 +49,18 @@ func resourceVirtualNetworkGateway() *pluginsdk.Resource {
 		},
 
 		Schema: resourceVirtualNetworkGatewaySchema(),
+
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			// resizing is only supported within the same family of SKUs, or when upgrading to the zone-redundant
+			// equivalent of a VpnGw SKU - any other change requires the gateway to be recreated
+			pluginsdk.ForceNewIfChange("sku", func(ctx context.Context, old, new, meta interface{}) bool {
+				if old.(string) == "" || new.(string) == "" {
+					return false
+				}
+				return !virtualNetworkGatewaySkuCanBeResized(old.(string), new.(string))
+			}),
+			pluginsdk.CustomizeDiffShim(virtualNetworkGatewayActiveActiveCustomizeDiff),
+		),
 	}
 }
 
@@ -789,6 +803,19 @@ func resourceVirtualNetworkGatewayUpdate(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
+	// a full PUT of a Virtual Network Gateway can take a significant amount of time, so when only the tags
+	// have changed we PATCH them instead
+	if d.HasChange("tags") && !d.HasChangeExcept("tags") {
+		payload := virtualnetworkgateways.TagsObject{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+		if err := client.UpdateTagsThenPoll(ctx, *id, payload); err != nil {
+			return fmt.Errorf("updating tags for %s: %+v", id, err)
+		}
+
+		return resourceVirtualNetworkGatewayRead(d, meta)
+	}
+
 	existing, err := client.Get(ctx, *id)
 	if err != nil {
 		return err
@@ -1498,6 +1525,50 @@ func hashVirtualNetworkGatewayRevokedCert(v interface{}) int {
 	return pluginsdk.HashString(buf.String())
 }
 
+func virtualNetworkGatewaySkuFamily(sku string) string {
+	switch {
+	case strings.HasPrefix(sku, "ErGw"):
+		return "ErGwAZ"
+	case strings.HasPrefix(sku, "VpnGw") && strings.HasSuffix(sku, "AZ"):
+		return "VpnGwAZ"
+	case strings.HasPrefix(sku, "VpnGw"):
+		return "VpnGw"
+	}
+
+	// Basic, Standard, HighPerformance and UltraPerformance
+	return "Legacy"
+}
+
+func virtualNetworkGatewaySkuCanBeResized(old, new string) bool {
+	oldFamily := virtualNetworkGatewaySkuFamily(old)
+	newFamily := virtualNetworkGatewaySkuFamily(new)
+
+	if oldFamily == newFamily {
+		return true
+	}
+
+	return oldFamily == "VpnGw" && newFamily == "VpnGwAZ"
+}
+
+func virtualNetworkGatewayActiveActiveCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Get("type").(string) != string(virtualnetworkgateways.VirtualNetworkGatewayTypeVpn) || !d.Get("active_active").(bool) {
+		return nil
+	}
+
+	if sku := d.Get("sku").(string); sku == string(virtualnetworkgateways.VirtualNetworkGatewaySkuNameBasic) {
+		return fmt.Errorf("`active_active` is not supported for a Virtual Network Gateway with the `Basic` SKU")
+	}
+
+	// the count is only known once the configuration has been resolved
+	if d.NewValueKnown("ip_configuration") {
+		if ipConfigurations := d.Get("ip_configuration").([]interface{}); len(ipConfigurations) < 2 {
+			return fmt.Errorf("at least two `ip_configuration` blocks must be specified when `active_active` is enabled, got %d", len(ipConfigurations))
+		}
+	}
+
+	return nil
+}
+
 func validateVirtualNetworkGatewayPolicyBasedVpnSku() pluginsdk.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		string(virtualnetworkgateways.VirtualNetworkGatewaySkuTierBasic),
@@ -380,7 +380,10 @@ func resourceVirtualNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
-	if err := client.CreateOrUpdateThenPoll(ctx, id, vnet); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, id, vnet)
+	// the Virtual Network may have been partially created even when this fails, so any cached response is removed regardless
+	meta.(*clients.Client).ResponseCache.Invalidate(id.ID())
+	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -579,7 +582,9 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+	err = client.CreateOrUpdateThenPoll(ctx, *id, *payload)
+	meta.(*clients.Client).ResponseCache.Invalidate(id.ID())
+	if err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
@@ -620,7 +625,9 @@ func resourceVirtualNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.MultipleByName(&routeTableNames, routeTableResourceName)
 	defer locks.UnlockMultipleByName(&routeTableNames, routeTableResourceName)
 
-	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+	err = client.DeleteThenPoll(ctx, *id)
+	meta.(*clients.Client).ResponseCache.Invalidate(id.ID())
+	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
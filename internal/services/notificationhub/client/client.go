@@ -8,12 +8,14 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2017-04-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2017-04-01/notificationhubs"
+	hubs_v2023_09_01 "github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2023-09-01/hubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	HubsClient       *notificationhubs.NotificationHubsClient
-	NamespacesClient *namespaces.NamespacesClient
+	HubsClient             *notificationhubs.NotificationHubsClient
+	HubsClient_v2023_09_01 *hubs_v2023_09_01.HubsClient
+	NamespacesClient       *namespaces.NamespacesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -23,6 +25,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		return nil, fmt.Errorf("building HubsClient client: %+v", err)
 	}
 
+	hubsClient_v2023_09_01, err := hubs_v2023_09_01.NewHubsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building HubsClient_v2023_09_01 client: %+v", err)
+	}
+	o.Configure(hubsClient_v2023_09_01.Client, o.Authorizers.ResourceManager)
+
 	namespacesClient, err := namespaces.NewNamespacesClientWithBaseURI(o.Environment.ResourceManager)
 	o.Configure(namespacesClient.Client, o.Authorizers.ResourceManager)
 	if err != nil {
@@ -30,7 +38,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 
 	return &Client{
-		HubsClient:       hubsClient,
-		NamespacesClient: namespacesClient,
+		HubsClient:             hubsClient,
+		HubsClient_v2023_09_01: hubsClient_v2023_09_01,
+		NamespacesClient:       namespacesClient,
 	}, nil
 }
@@ -10,11 +10,12 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2017-04-01/notificationhubs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2023-09-01/hubs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/migration"
@@ -41,7 +42,7 @@ func resourceNotificationHub() *pluginsdk.Resource {
 		Delete: resourceNotificationHubDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := notificationhubs.ParseNotificationHubID(id)
+			_, err := hubs.ParseNotificationHubID(id)
 			return err
 		}),
 
@@ -75,6 +76,11 @@ func resourceNotificationHub() *pluginsdk.Resource {
 				diff.ForceNew("gcm_credential")
 			}
 
+			oBrowser, nBrowser := diff.GetChange("browser_credential.#")
+			if nBrowser.(int) < oBrowser.(int) {
+				diff.ForceNew("browser_credential")
+			}
+
 			return nil
 		}),
 
@@ -134,6 +140,32 @@ func resourceNotificationHub() *pluginsdk.Resource {
 				},
 			},
 
+			"browser_credential": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subject": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"vapid_private_key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"vapid_public_key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"gcm_credential": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -155,14 +187,14 @@ func resourceNotificationHub() *pluginsdk.Resource {
 }
 
 func resourceNotificationHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs.HubsClient_v2023_09_01
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := notificationhubs.NewNotificationHubID(subscriptionId, d.Get("resource_group_name").(string), d.Get("namespace_name").(string), d.Get("name").(string))
+	id := hubs.NewNotificationHubID(subscriptionId, d.Get("resource_group_name").(string), d.Get("namespace_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id)
+		existing, err := client.NotificationHubsGet(ctx, id)
 		if err != nil {
 			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
@@ -174,16 +206,17 @@ func resourceNotificationHubCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	parameters := notificationhubs.NotificationHubCreateOrUpdateParameters{
-		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Properties: notificationhubs.NotificationHubProperties{
-			ApnsCredential: expandNotificationHubsAPNSCredentials(d.Get("apns_credential").([]interface{})),
-			GcmCredential:  expandNotificationHubsGCMCredentials(d.Get("gcm_credential").([]interface{})),
+	parameters := hubs.NotificationHubResource{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &hubs.NotificationHubProperties{
+			ApnsCredential:    expandNotificationHubsAPNSCredentials(d.Get("apns_credential").([]interface{})),
+			BrowserCredential: expandNotificationHubsBrowserCredentials(d.Get("browser_credential").([]interface{})),
+			GcmCredential:     expandNotificationHubsGCMCredentials(d.Get("gcm_credential").([]interface{})),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+	if _, err := client.NotificationHubsCreateOrUpdate(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -209,9 +242,9 @@ func resourceNotificationHubCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 	return resourceNotificationHubRead(d, meta)
 }
 
-func notificationHubStateRefreshFunc(ctx context.Context, client *notificationhubs.NotificationHubsClient, id notificationhubs.NotificationHubId) pluginsdk.StateRefreshFunc {
+func notificationHubStateRefreshFunc(ctx context.Context, client *hubs.HubsClient, id hubs.NotificationHubId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, id)
+		res, err := client.NotificationHubsGet(ctx, id)
 		statusCode := "dropped connection"
 		if res.HttpResponse != nil {
 			statusCode = strconv.Itoa(res.HttpResponse.StatusCode)
@@ -230,16 +263,16 @@ func notificationHubStateRefreshFunc(ctx context.Context, client *notificationhu
 }
 
 func resourceNotificationHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs.HubsClient_v2023_09_01
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := notificationhubs.ParseNotificationHubID(d.Id())
+	id, err := hubs.ParseNotificationHubID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id)
+	resp, err := client.NotificationHubsGet(ctx, *id)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s was not found - removing from state", *id)
@@ -250,7 +283,7 @@ func resourceNotificationHubRead(d *pluginsdk.ResourceData, meta interface{}) er
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	credentials, err := client.GetPnsCredentials(ctx, *id)
+	credentials, err := client.NotificationHubsGetPnsCredentials(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving credentials for %s: %+v", *id, err)
 	}
@@ -265,6 +298,10 @@ func resourceNotificationHubRead(d *pluginsdk.ResourceData, meta interface{}) er
 			if setErr := d.Set("apns_credential", apns); setErr != nil {
 				return fmt.Errorf("setting `apns_credential`: %+v", setErr)
 			}
+			browser := flattenNotificationHubsBrowserCredentials(props.BrowserCredential)
+			if setErr := d.Set("browser_credential", browser); setErr != nil {
+				return fmt.Errorf("setting `browser_credential`: %+v", setErr)
+			}
 			gcm := flattenNotificationHubsGCMCredentials(props.GcmCredential)
 			if setErr := d.Set("gcm_credential", gcm); setErr != nil {
 				return fmt.Errorf("setting `gcm_credential`: %+v", setErr)
@@ -273,7 +310,7 @@ func resourceNotificationHubRead(d *pluginsdk.ResourceData, meta interface{}) er
 	}
 
 	if model := resp.Model; model != nil {
-		d.Set("location", location.NormalizeNilable(model.Location))
+		d.Set("location", location.Normalize(model.Location))
 
 		return d.Set("tags", tags.Flatten(model.Tags))
 	}
@@ -282,16 +319,16 @@ func resourceNotificationHubRead(d *pluginsdk.ResourceData, meta interface{}) er
 }
 
 func resourceNotificationHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs.HubsClient_v2023_09_01
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := notificationhubs.ParseNotificationHubID(d.Id())
+	id, err := hubs.ParseNotificationHubID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Delete(ctx, *id)
+	resp, err := client.NotificationHubsDelete(ctx, *id)
 	if err != nil {
 		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -301,7 +338,7 @@ func resourceNotificationHubDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
-func expandNotificationHubsAPNSCredentials(inputs []interface{}) *notificationhubs.ApnsCredential {
+func expandNotificationHubsAPNSCredentials(inputs []interface{}) *hubs.ApnsCredential {
 	if len(inputs) == 0 {
 		return nil
 	}
@@ -319,11 +356,11 @@ func expandNotificationHubsAPNSCredentials(inputs []interface{}) *notificationhu
 	}
 	endpoint := applicationEndpoints[applicationMode]
 
-	credentials := notificationhubs.ApnsCredential{
-		Properties: &notificationhubs.ApnsCredentialProperties{
+	credentials := hubs.ApnsCredential{
+		Properties: hubs.ApnsCredentialProperties{
 			AppId:    utils.String(teamId),
 			AppName:  utils.String(bundleId),
-			Endpoint: utils.String(endpoint),
+			Endpoint: endpoint,
 			KeyId:    utils.String(keyId),
 			Token:    utils.String(token),
 		},
@@ -331,69 +368,80 @@ func expandNotificationHubsAPNSCredentials(inputs []interface{}) *notificationhu
 	return &credentials
 }
 
-func flattenNotificationHubsAPNSCredentials(input *notificationhubs.ApnsCredential) []interface{} {
+func flattenNotificationHubsAPNSCredentials(input *hubs.ApnsCredential) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
 
-	output := make(map[string]interface{})
-
-	if props := input.Properties; props != nil {
-		if bundleId := props.AppName; bundleId != nil {
-			output["bundle_id"] = *bundleId
-		}
+	applicationEndpoints := map[string]string{
+		apnsProductionEndpoint: apnsProductionName,
+		apnsSandboxEndpoint:    apnsSandboxName,
+	}
 
-		if endpoint := props.Endpoint; endpoint != nil {
-			applicationEndpoints := map[string]string{
-				apnsProductionEndpoint: apnsProductionName,
-				apnsSandboxEndpoint:    apnsSandboxName,
-			}
-			applicationMode := applicationEndpoints[*endpoint]
-			output["application_mode"] = applicationMode
-		}
+	props := input.Properties
+	return []interface{}{
+		map[string]interface{}{
+			"application_mode": applicationEndpoints[props.Endpoint],
+			"bundle_id":        pointer.From(props.AppName),
+			"key_id":           pointer.From(props.KeyId),
+			"team_id":          pointer.From(props.AppId),
+			"token":            pointer.From(props.Token),
+		},
+	}
+}
 
-		if keyId := props.KeyId; keyId != nil {
-			output["key_id"] = *keyId
-		}
+func expandNotificationHubsGCMCredentials(inputs []interface{}) *hubs.GcmCredential {
+	if len(inputs) == 0 {
+		return nil
+	}
 
-		if teamId := props.AppId; teamId != nil {
-			output["team_id"] = *teamId
-		}
+	input := inputs[0].(map[string]interface{})
+	apiKey := input["api_key"].(string)
+	credentials := hubs.GcmCredential{
+		Properties: hubs.GcmCredentialProperties{
+			GoogleApiKey: apiKey,
+		},
+	}
+	return &credentials
+}
 
-		if token := props.Token; token != nil {
-			output["token"] = *token
-		}
+func flattenNotificationHubsGCMCredentials(input *hubs.GcmCredential) []interface{} {
+	if input == nil {
+		return []interface{}{}
 	}
 
-	return []interface{}{output}
+	return []interface{}{
+		map[string]interface{}{
+			"api_key": input.Properties.GoogleApiKey,
+		},
+	}
 }
 
-func expandNotificationHubsGCMCredentials(inputs []interface{}) *notificationhubs.GcmCredential {
+func expandNotificationHubsBrowserCredentials(inputs []interface{}) *hubs.BrowserCredential {
 	if len(inputs) == 0 {
 		return nil
 	}
 
 	input := inputs[0].(map[string]interface{})
-	apiKey := input["api_key"].(string)
-	credentials := notificationhubs.GcmCredential{
-		Properties: &notificationhubs.GcmCredentialProperties{
-			GoogleApiKey: utils.String(apiKey),
+	return &hubs.BrowserCredential{
+		Properties: hubs.BrowserCredentialProperties{
+			Subject:         input["subject"].(string),
+			VapidPrivateKey: input["vapid_private_key"].(string),
+			VapidPublicKey:  input["vapid_public_key"].(string),
 		},
 	}
-	return &credentials
 }
 
-func flattenNotificationHubsGCMCredentials(input *notificationhubs.GcmCredential) []interface{} {
+func flattenNotificationHubsBrowserCredentials(input *hubs.BrowserCredential) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
-	output := make(map[string]interface{})
-	if props := input.Properties; props != nil {
-		if apiKey := props.GoogleApiKey; apiKey != nil {
-			output["api_key"] = *apiKey
-		}
+	return []interface{}{
+		map[string]interface{}{
+			"subject":           input.Properties.Subject,
+			"vapid_private_key": input.Properties.VapidPrivateKey,
+			"vapid_public_key":  input.Properties.VapidPublicKey,
+		},
 	}
-
-	return []interface{}{output}
 }
@@ -114,6 +114,9 @@ resource "azurerm_management_group_policy_remediation" "test" {
   management_group_id  = azurerm_management_group.test.id
   policy_assignment_id = azurerm_management_group_policy_assignment.test.id
   location_filters     = ["westus"]
+  failure_percentage   = 0.5
+  parallel_deployments = 3
+  resource_count       = 3
 }
 `, r.template(data), data.RandomString)
 }
@@ -4,9 +4,12 @@
 package portal
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -19,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/portal/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -42,6 +46,16 @@ func resourcePortalDashboard() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				// the raw `dashboard_properties` are computed from the `lens` blocks when those are used
+				if d.HasChanges("lens", "metadata") && len(d.Get("lens").([]interface{})) > 0 {
+					return d.SetNewComputed("dashboard_properties")
+				}
+				return nil
+			},
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -57,10 +71,102 @@ func resourcePortalDashboard() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 
 			"dashboard_properties": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validate.DashboardProperties,
-				StateFunc:    utils.NormalizeJson,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validate.DashboardProperties,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppressDashboardPropertiesDiff,
+				ExactlyOneOf:     []string{"dashboard_properties", "lens"},
+			},
+
+			"lens": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"dashboard_properties", "lens"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"part": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"type": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"x": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+
+									"y": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+
+									"row_span": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"col_span": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"input": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"name": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+
+												"value": {
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringIsJSON,
+													StateFunc:    utils.NormalizeJson,
+												},
+
+												"optional": {
+													Type:     pluginsdk.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+											},
+										},
+									},
+
+									"settings": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsJSON,
+										StateFunc:    utils.NormalizeJson,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"metadata": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsJSON,
+				StateFunc:     utils.NormalizeJson,
+				ConflictsWith: []string{"dashboard_properties"},
 			},
 		},
 	}
@@ -91,14 +197,22 @@ func resourcePortalDashboardCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	var dashboardProperties dashboard.DashboardProperties
+	if lenses := d.Get("lens").([]interface{}); len(lenses) > 0 {
+		dashboardProperties, err := expandPortalDashboardLenses(lenses, d.Get("metadata").(string))
+		if err != nil {
+			return err
+		}
+		props.Properties = dashboardProperties
+	} else {
+		var dashboardProperties dashboard.DashboardProperties
 
-	dashboardPropsRaw := d.Get("dashboard_properties").(string)
-	if err := json.Unmarshal([]byte(dashboardPropsRaw), &dashboardProperties); err != nil {
-		return fmt.Errorf("parsing JSON: %+v", err)
-	}
+		dashboardPropsRaw := d.Get("dashboard_properties").(string)
+		if err := json.Unmarshal([]byte(dashboardPropsRaw), &dashboardProperties); err != nil {
+			return fmt.Errorf("parsing JSON: %+v", err)
+		}
 
-	props.Properties = &dashboardProperties
+		props.Properties = &dashboardProperties
+	}
 
 	if _, err := client.CreateOrUpdate(ctx, id, props); err != nil {
 		return fmt.Errorf("creating/updating %s %+v", id, err)
@@ -140,6 +254,27 @@ func resourcePortalDashboardRead(d *pluginsdk.ResourceData, meta interface{}) er
 				return fmt.Errorf("parsing JSON for Dashboard Properties: %+v", err)
 			}
 			d.Set("dashboard_properties", string(v))
+
+			// only populate the typed schema when it's in use, so that the raw JSON remains the source of truth otherwise
+			if len(d.Get("lens").([]interface{})) > 0 {
+				lenses, err := flattenPortalDashboardLenses(props.Lenses)
+				if err != nil {
+					return err
+				}
+				if err := d.Set("lens", lenses); err != nil {
+					return fmt.Errorf("setting `lens`: %+v", err)
+				}
+
+				metadata := ""
+				if props.Metadata != nil && len(*props.Metadata) > 0 {
+					v, err := json.Marshal(props.Metadata)
+					if err != nil {
+						return fmt.Errorf("parsing JSON for Dashboard Metadata: %+v", err)
+					}
+					metadata = string(v)
+				}
+				d.Set("metadata", metadata)
+			}
 		}
 
 		return tags.FlattenAndSet(d, model.Tags)
@@ -167,3 +302,232 @@ func resourcePortalDashboardDelete(d *pluginsdk.ResourceData, meta interface{})
 
 	return nil
 }
+
+// suppressDashboardPropertiesDiff compares the Dashboard Properties once they've been round-tripped through the
+// API model, since the API drops unknown/empty fields and returns the remaining fields in a different order
+func suppressDashboardPropertiesDiff(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldNormalized, err := normalizeDashboardProperties(old)
+	if err != nil {
+		return false
+	}
+
+	newNormalized, err := normalizeDashboardProperties(new)
+	if err != nil {
+		return false
+	}
+
+	return oldNormalized == newNormalized
+}
+
+func normalizeDashboardProperties(input string) (string, error) {
+	var props dashboard.DashboardProperties
+	if err := json.Unmarshal([]byte(input), &props); err != nil {
+		return "", err
+	}
+
+	if props.Metadata != nil && len(*props.Metadata) == 0 {
+		props.Metadata = nil
+	}
+
+	if props.Lenses != nil {
+		for key, lens := range *props.Lenses {
+			if lens.Metadata != nil && len(*lens.Metadata) == 0 {
+				lens.Metadata = nil
+			}
+			for partKey, part := range lens.Parts {
+				if part.Position.Metadata != nil && len(*part.Position.Metadata) == 0 {
+					part.Position.Metadata = nil
+				}
+				lens.Parts[partKey] = part
+			}
+			(*props.Lenses)[key] = lens
+		}
+	}
+
+	v, err := json.Marshal(props)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.NormalizeJson(string(v)), nil
+}
+
+func expandPortalDashboardLenses(input []interface{}, metadataRaw string) (*dashboard.DashboardProperties, error) {
+	lenses := make(map[string]dashboard.DashboardLens)
+	for i, item := range input {
+		parts := make(map[string]dashboard.DashboardParts)
+
+		if item != nil {
+			lens := item.(map[string]interface{})
+			for j, partRaw := range lens["part"].([]interface{}) {
+				part := partRaw.(map[string]interface{})
+
+				inputs := make([]interface{}, 0)
+				for _, inputRaw := range part["input"].([]interface{}) {
+					v := inputRaw.(map[string]interface{})
+					partInput := map[string]interface{}{
+						"name": v["name"].(string),
+					}
+
+					if value := v["value"].(string); value != "" {
+						var parsed interface{}
+						if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+							return nil, fmt.Errorf("parsing JSON for the value of input %q: %+v", v["name"].(string), err)
+						}
+						partInput["value"] = parsed
+					}
+
+					if v["optional"].(bool) {
+						partInput["isOptional"] = true
+					}
+
+					inputs = append(inputs, partInput)
+				}
+
+				metadata := map[string]interface{}{
+					"type":   part["type"].(string),
+					"inputs": inputs,
+				}
+
+				if settings := part["settings"].(string); settings != "" {
+					var parsed interface{}
+					if err := json.Unmarshal([]byte(settings), &parsed); err != nil {
+						return nil, fmt.Errorf("parsing JSON for the settings of part %d in lens %d: %+v", j, i, err)
+					}
+					metadata["settings"] = parsed
+				}
+
+				var partMetadata interface{} = metadata
+				parts[strconv.Itoa(j)] = dashboard.DashboardParts{
+					Metadata: &partMetadata,
+					Position: dashboard.DashboardPartsPosition{
+						X:       int64(part["x"].(int)),
+						Y:       int64(part["y"].(int)),
+						RowSpan: int64(part["row_span"].(int)),
+						ColSpan: int64(part["col_span"].(int)),
+					},
+				}
+			}
+		}
+
+		lenses[strconv.Itoa(i)] = dashboard.DashboardLens{
+			Order: int64(i),
+			Parts: parts,
+		}
+	}
+
+	output := &dashboard.DashboardProperties{
+		Lenses: &lenses,
+	}
+
+	if metadataRaw != "" {
+		metadata := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(metadataRaw), &metadata); err != nil {
+			return nil, fmt.Errorf("parsing JSON for `metadata`: %+v", err)
+		}
+		output.Metadata = &metadata
+	}
+
+	return output, nil
+}
+
+func flattenPortalDashboardLenses(input *map[string]dashboard.DashboardLens) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	lenses := make([]dashboard.DashboardLens, 0)
+	for _, lens := range *input {
+		lenses = append(lenses, lens)
+	}
+	sort.Slice(lenses, func(i, j int) bool {
+		return lenses[i].Order < lenses[j].Order
+	})
+
+	output := make([]interface{}, 0)
+	for _, lens := range lenses {
+		partKeys := make([]string, 0)
+		for key := range lens.Parts {
+			partKeys = append(partKeys, key)
+		}
+		sort.Slice(partKeys, func(i, j int) bool {
+			a, errA := strconv.Atoi(partKeys[i])
+			b, errB := strconv.Atoi(partKeys[j])
+			if errA != nil || errB != nil {
+				return partKeys[i] < partKeys[j]
+			}
+			return a < b
+		})
+
+		parts := make([]interface{}, 0)
+		for _, key := range partKeys {
+			part := lens.Parts[key]
+
+			partType := ""
+			settings := ""
+			inputs := make([]interface{}, 0)
+			if part.Metadata != nil {
+				if metadata, ok := (*part.Metadata).(map[string]interface{}); ok {
+					if v, ok := metadata["type"].(string); ok {
+						partType = v
+					}
+
+					if v, ok := metadata["settings"]; ok && v != nil {
+						b, err := json.Marshal(v)
+						if err != nil {
+							return nil, fmt.Errorf("parsing JSON for the settings of part %q: %+v", key, err)
+						}
+						settings = string(b)
+					}
+
+					if v, ok := metadata["inputs"].([]interface{}); ok {
+						for _, inputRaw := range v {
+							partInput, ok := inputRaw.(map[string]interface{})
+							if !ok {
+								continue
+							}
+
+							name, _ := partInput["name"].(string)
+							optional, _ := partInput["isOptional"].(bool)
+
+							value := ""
+							if v, ok := partInput["value"]; ok && v != nil {
+								b, err := json.Marshal(v)
+								if err != nil {
+									return nil, fmt.Errorf("parsing JSON for the value of input %q: %+v", name, err)
+								}
+								value = string(b)
+							}
+
+							inputs = append(inputs, map[string]interface{}{
+								"name":     name,
+								"value":    value,
+								"optional": optional,
+							})
+						}
+					}
+				}
+			}
+
+			parts = append(parts, map[string]interface{}{
+				"type":     partType,
+				"x":        int(part.Position.X),
+				"y":        int(part.Position.Y),
+				"row_span": int(part.Position.RowSpan),
+				"col_span": int(part.Position.ColSpan),
+				"input":    inputs,
+				"settings": settings,
+			})
+		}
+
+		output = append(output, map[string]interface{}{
+			"part": parts,
+		})
+	}
+
+	return output, nil
+}
@@ -60,6 +60,28 @@ func TestAccPortalDashboard_hiddenTitle(t *testing.T) {
 	})
 }
 
+func TestAccPortalDashboard_lens(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_portal_dashboard", "test")
+	r := PortalDashboardResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.lens(data, "## This is only a test :)"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dashboard_properties").Exists(),
+			),
+		},
+		data.ImportStep("lens", "metadata"),
+		{
+			Config: r.lens(data, "## This is an updated test"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("lens", "metadata"),
+	})
+}
+
 func (PortalDashboardResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := dashboard.ParseDashboardID(state.ID)
 	if err != nil {
@@ -207,3 +229,70 @@ DASH
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (PortalDashboardResource) lens(data acceptance.TestData, content string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_portal_dashboard" "test" {
+  name                = "my-test-dashboard"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  lens {
+    part {
+      type     = "Extension/HubsExtension/PartType/MarkdownPart"
+      x        = 0
+      y        = 0
+      row_span = 2
+      col_span = 3
+
+      settings = jsonencode({
+        content = {
+          settings = {
+            content  = "%s"
+            subtitle = ""
+            title    = "Test MD Tile"
+          }
+        }
+      })
+    }
+
+    part {
+      type     = "Extension/HubsExtension/PartType/ClockPart"
+      x        = 3
+      y        = 0
+      row_span = 2
+      col_span = 2
+
+      input {
+        name     = "timezoneId"
+        value    = jsonencode("UTC")
+        optional = true
+      }
+    }
+  }
+
+  metadata = jsonencode({
+    model = {
+      timeRange = {
+        value = {
+          relative = {
+            duration = 24
+            timeUnit = 1
+          }
+        }
+        type = "MsPortalFx.Composition.Configuration.ValueTypes.TimeRange"
+      }
+    }
+  })
+}
+`, data.RandomInteger, data.Locations.Primary, content)
+}
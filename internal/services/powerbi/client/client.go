@@ -6,15 +6,23 @@ package client
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/powerbidedicated/2021-01-01/autoscalevcores"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/powerbidedicated/2021-01-01/capacities"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	CapacityClient *capacities.CapacitiesClient
+	AutoScaleVCoresClient *autoscalevcores.AutoScaleVCoresClient
+	CapacityClient        *capacities.CapacitiesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	autoScaleVCoresClient, err := autoscalevcores.NewAutoScaleVCoresClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building PowerBI Dedicated Auto Scale VCores client: %+v", err)
+	}
+	o.Configure(autoScaleVCoresClient.Client, o.Authorizers.ResourceManager)
+
 	capacityClient, err := capacities.NewCapacitiesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building PowerBI Dedicated Capacity client: %+v", err)
@@ -22,6 +30,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(capacityClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
-		CapacityClient: capacityClient,
+		AutoScaleVCoresClient: autoScaleVCoresClient,
+		CapacityClient:        capacityClient,
 	}, nil
 }
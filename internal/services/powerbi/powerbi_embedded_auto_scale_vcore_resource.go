@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package powerbi
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/powerbidedicated/2021-01-01/autoscalevcores"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/powerbi/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePowerBIEmbeddedAutoScaleVCore() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePowerBIEmbeddedAutoScaleVCoreCreate,
+		Read:   resourcePowerBIEmbeddedAutoScaleVCoreRead,
+		Update: resourcePowerBIEmbeddedAutoScaleVCoreUpdate,
+		Delete: resourcePowerBIEmbeddedAutoScaleVCoreDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := autoscalevcores.ParseAutoScaleVCoreID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.EmbeddedName,
+			},
+
+			"location": commonschema.Location(),
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"capacity_limit": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"capacity_object_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourcePowerBIEmbeddedAutoScaleVCoreCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PowerBI.AutoScaleVCoresClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := autoscalevcores.NewAutoScaleVCoreID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_powerbi_embedded_auto_scale_vcore", id.ID())
+	}
+
+	parameters := autoscalevcores.AutoScaleVCore{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &autoscalevcores.AutoScaleVCoreProperties{
+			CapacityLimit: pointer.To(int64(d.Get("capacity_limit").(int))),
+		},
+		Sku: autoscalevcores.AutoScaleVCoreSku{
+			Name:     string(autoscalevcores.VCoreSkuTierAutoScale),
+			Tier:     pointer.To(autoscalevcores.VCoreSkuTierAutoScale),
+			Capacity: pointer.To(int64(0)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("capacity_object_id").(string); v != "" {
+		parameters.Properties.CapacityObjectId = pointer.To(v)
+	}
+
+	if _, err := client.Create(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePowerBIEmbeddedAutoScaleVCoreRead(d, meta)
+}
+
+func resourcePowerBIEmbeddedAutoScaleVCoreRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PowerBI.AutoScaleVCoresClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := autoscalevcores.ParseAutoScaleVCoreID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.AutoScaleVCoreName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if props := model.Properties; props != nil {
+			d.Set("capacity_limit", int(pointer.From(props.CapacityLimit)))
+			d.Set("capacity_object_id", pointer.From(props.CapacityObjectId))
+		}
+
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourcePowerBIEmbeddedAutoScaleVCoreUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PowerBI.AutoScaleVCoresClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := autoscalevcores.ParseAutoScaleVCoreID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := autoscalevcores.AutoScaleVCoreUpdateParameters{}
+
+	if d.HasChange("capacity_limit") {
+		parameters.Properties = &autoscalevcores.AutoScaleVCoreMutableProperties{
+			CapacityLimit: pointer.To(int64(d.Get("capacity_limit").(int))),
+		}
+	}
+
+	if d.HasChange("tags") {
+		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if _, err := client.Update(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourcePowerBIEmbeddedAutoScaleVCoreRead(d, meta)
+}
+
+func resourcePowerBIEmbeddedAutoScaleVCoreDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PowerBI.AutoScaleVCoresClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := autoscalevcores.ParseAutoScaleVCoreID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package powerbi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/powerbidedicated/2021-01-01/autoscalevcores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PowerBIEmbeddedAutoScaleVCoreResource struct{}
+
+func TestAccPowerBIEmbeddedAutoScaleVCore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_powerbi_embedded_auto_scale_vcore", "test")
+	r := PowerBIEmbeddedAutoScaleVCoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPowerBIEmbeddedAutoScaleVCore_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_powerbi_embedded_auto_scale_vcore", "test")
+	r := PowerBIEmbeddedAutoScaleVCoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("capacity_limit").HasValue("4"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPowerBIEmbeddedAutoScaleVCore_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_powerbi_embedded_auto_scale_vcore", "test")
+	r := PowerBIEmbeddedAutoScaleVCoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_powerbi_embedded_auto_scale_vcore"),
+		},
+	})
+}
+
+func (PowerBIEmbeddedAutoScaleVCoreResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := autoscalevcores.ParseAutoScaleVCoreID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PowerBI.AutoScaleVCoresClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (PowerBIEmbeddedAutoScaleVCoreResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-powerbi-%[1]d"
+  location = "%[2]s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r PowerBIEmbeddedAutoScaleVCoreResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_powerbi_embedded_auto_scale_vcore" "test" {
+  name                = "acctestvcore%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  capacity_limit      = 2
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PowerBIEmbeddedAutoScaleVCoreResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_powerbi_embedded_auto_scale_vcore" "test" {
+  name                = "acctestvcore%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  capacity_limit      = 4
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PowerBIEmbeddedAutoScaleVCoreResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_powerbi_embedded_auto_scale_vcore" "import" {
+  name                = azurerm_powerbi_embedded_auto_scale_vcore.test.name
+  location            = azurerm_powerbi_embedded_auto_scale_vcore.test.location
+  resource_group_name = azurerm_powerbi_embedded_auto_scale_vcore.test.resource_group_name
+  capacity_limit      = azurerm_powerbi_embedded_auto_scale_vcore.test.capacity_limit
+}
+`, r.basic(data))
+}
@@ -87,6 +87,12 @@ func resourcePowerBIEmbedded() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"paused": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -130,6 +136,12 @@ func resourcePowerBIEmbeddedCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
+	if d.Get("paused").(bool) {
+		if err := client.SuspendThenPoll(ctx, id); err != nil {
+			return fmt.Errorf("suspending %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourcePowerBIEmbeddedRead(d, meta)
 }
@@ -174,6 +186,12 @@ func resourcePowerBIEmbeddedRead(d *pluginsdk.ResourceData, meta interface{}) er
 				mode = string(*props.Mode)
 			}
 			d.Set("mode", mode)
+
+			paused := false
+			if props.State != nil {
+				paused = *props.State == capacities.StatePaused || *props.State == capacities.StateSuspended
+			}
+			d.Set("paused", paused)
 		}
 
 		d.Set("sku_name", model.Sku.Name)
@@ -196,6 +214,15 @@ func resourcePowerBIEmbeddedUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
+	paused := d.Get("paused").(bool)
+
+	// the capacity is resumed prior to any other changes being made, and suspended once they've been applied
+	if d.HasChange("paused") && !paused {
+		if err := client.ResumeThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("resuming %s: %+v", *id, err)
+		}
+	}
+
 	parameters := capacities.DedicatedCapacityUpdateParameters{}
 
 	if d.HasChange("administrators") || d.HasChange("mode") {
@@ -220,8 +247,16 @@ func resourcePowerBIEmbeddedUpdate(d *pluginsdk.ResourceData, meta interface{})
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
-	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
-		return fmt.Errorf("updating %s: %+v", *id, err)
+	if d.HasChanges("administrators", "mode", "sku_name", "tags") {
+		if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	if d.HasChange("paused") && paused {
+		if err := client.SuspendThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("suspending %s: %+v", *id, err)
+		}
 	}
 
 	return resourcePowerBIEmbeddedRead(d, meta)
@@ -93,6 +93,37 @@ func TestAccPowerBIEmbedded_gen2(t *testing.T) {
 	})
 }
 
+func TestAccPowerBIEmbedded_paused(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_powerbi_embedded", "test")
+	r := PowerBIEmbeddedResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.paused(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("paused").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.paused(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("paused").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccPowerBIEmbedded_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_powerbi_embedded", "test")
 	r := PowerBIEmbeddedResource{}
@@ -187,6 +218,21 @@ resource "azurerm_powerbi_embedded" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r PowerBIEmbeddedResource) paused(data acceptance.TestData, paused bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_powerbi_embedded" "test" {
+  name                = "acctestpowerbi%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "A1"
+  administrators      = [data.azurerm_client_config.test.object_id]
+  paused              = %[3]t
+}
+`, r.template(data), data.RandomInteger, paused)
+}
+
 func (r PowerBIEmbeddedResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
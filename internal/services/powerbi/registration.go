@@ -33,6 +33,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_powerbi_embedded": resourcePowerBIEmbedded(),
+		"azurerm_powerbi_embedded":                  resourcePowerBIEmbedded(),
+		"azurerm_powerbi_embedded_auto_scale_vcore": resourcePowerBIEmbeddedAutoScaleVCore(),
 	}
 }
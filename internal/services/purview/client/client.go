@@ -6,12 +6,14 @@ package client
 import (
 	"fmt"
 
-	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-07-01/account"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-12-01/account"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-12-01/kafkaconfiguration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	AccountsClient *account.AccountClient
+	AccountsClient            *account.AccountClient
+	KafkaConfigurationsClient *kafkaconfiguration.KafkaConfigurationClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -21,7 +23,14 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(accountsClient.Client, o.Authorizers.ResourceManager)
 
+	kafkaConfigurationsClient, err := kafkaconfiguration.NewKafkaConfigurationClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Purview Kafka Configuration client: %+v", err)
+	}
+	o.Configure(kafkaConfigurationsClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
-		AccountsClient: accountsClient,
+		AccountsClient:            accountsClient,
+		KafkaConfigurationsClient: kafkaConfigurationsClient,
 	}, nil
 }
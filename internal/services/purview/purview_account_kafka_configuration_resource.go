@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package purview
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-12-01/account"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-12-01/kafkaconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePurviewAccountKafkaConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePurviewAccountKafkaConfigurationCreateUpdate,
+		Read:   resourcePurviewAccountKafkaConfigurationRead,
+		Update: resourcePurviewAccountKafkaConfigurationCreateUpdate,
+		Delete: resourcePurviewAccountKafkaConfigurationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := kafkaconfiguration.ParseKafkaConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9][-a-zA-Z0-9_]{0,62}$`),
+					"The Kafka Configuration name must be between 1 and 63 characters long, it can contain only letters, numbers, underscores and hyphens, and the first character must be a letter or number."),
+			},
+
+			"purview_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: account.ValidateAccountID,
+			},
+
+			"eventhub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: eventhubs.ValidateEventhubID,
+			},
+
+			"eventhub_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(kafkaconfiguration.EventHubTypeHook),
+					string(kafkaconfiguration.EventHubTypeNotification),
+				}, false),
+			},
+
+			"consumer_group": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"eventhub_partition_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"event_streaming_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"user_assigned_identity_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			},
+		},
+	}
+}
+
+func resourcePurviewAccountKafkaConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Purview.KafkaConfigurationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := account.ParseAccountID(d.Get("purview_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := kafkaconfiguration.NewKafkaConfigurationID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_purview_account_kafka_configuration", id.ID())
+		}
+	}
+
+	eventStreamingState := kafkaconfiguration.EventStreamingStateDisabled
+	if d.Get("event_streaming_enabled").(bool) {
+		eventStreamingState = kafkaconfiguration.EventStreamingStateEnabled
+	}
+
+	credentials := kafkaconfiguration.Credentials{
+		Type: pointer.To(kafkaconfiguration.CredentialsTypeSystemAssigned),
+	}
+	if v := d.Get("user_assigned_identity_id").(string); v != "" {
+		credentials.Type = pointer.To(kafkaconfiguration.CredentialsTypeUserAssigned)
+		credentials.IdentityId = pointer.To(v)
+	}
+
+	payload := kafkaconfiguration.KafkaConfiguration{
+		Properties: &kafkaconfiguration.KafkaConfigurationProperties{
+			Credentials:         &credentials,
+			EventHubResourceId:  pointer.To(d.Get("eventhub_id").(string)),
+			EventHubType:        pointer.To(kafkaconfiguration.EventHubType(d.Get("eventhub_type").(string))),
+			EventStreamingState: pointer.To(eventStreamingState),
+			EventStreamingType:  pointer.To(kafkaconfiguration.EventStreamingTypeAzure),
+		},
+	}
+
+	if v := d.Get("consumer_group").(string); v != "" {
+		payload.Properties.ConsumerGroup = pointer.To(v)
+	}
+
+	if v := d.Get("eventhub_partition_id").(string); v != "" {
+		payload.Properties.EventHubPartitionId = pointer.To(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePurviewAccountKafkaConfigurationRead(d, meta)
+}
+
+func resourcePurviewAccountKafkaConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Purview.KafkaConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kafkaconfiguration.ParseKafkaConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.KafkaConfigurationName)
+	d.Set("purview_account_id", account.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			eventHubId := ""
+			if props.EventHubResourceId != nil {
+				parsed, err := eventhubs.ParseEventhubIDInsensitively(*props.EventHubResourceId)
+				if err != nil {
+					return err
+				}
+				eventHubId = parsed.ID()
+			}
+			d.Set("eventhub_id", eventHubId)
+			d.Set("eventhub_type", string(pointer.From(props.EventHubType)))
+			d.Set("consumer_group", pointer.From(props.ConsumerGroup))
+			d.Set("eventhub_partition_id", pointer.From(props.EventHubPartitionId))
+			d.Set("event_streaming_enabled", pointer.From(props.EventStreamingState) == kafkaconfiguration.EventStreamingStateEnabled)
+
+			userAssignedIdentityId := ""
+			if credentials := props.Credentials; credentials != nil && pointer.From(credentials.Type) == kafkaconfiguration.CredentialsTypeUserAssigned {
+				userAssignedIdentityId = pointer.From(credentials.IdentityId)
+			}
+			d.Set("user_assigned_identity_id", userAssignedIdentityId)
+		}
+	}
+
+	return nil
+}
+
+func resourcePurviewAccountKafkaConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Purview.KafkaConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kafkaconfiguration.ParseKafkaConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
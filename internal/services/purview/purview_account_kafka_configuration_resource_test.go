@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package purview_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-12-01/kafkaconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PurviewAccountKafkaConfigurationResource struct{}
+
+func TestAccPurviewAccountKafkaConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_account_kafka_configuration", "test")
+	r := PurviewAccountKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPurviewAccountKafkaConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_account_kafka_configuration", "test")
+	r := PurviewAccountKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPurviewAccountKafkaConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_account_kafka_configuration", "test")
+	r := PurviewAccountKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r PurviewAccountKafkaConfigurationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := kafkaconfiguration.ParseKafkaConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Purview.KafkaConfigurationsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r PurviewAccountKafkaConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_account_kafka_configuration" "test" {
+  name               = "acctestkc%d"
+  purview_account_id = azurerm_purview_account.test.id
+  eventhub_id        = azurerm_eventhub.test.id
+  eventhub_type      = "Notification"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PurviewAccountKafkaConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_account_kafka_configuration" "test" {
+  name                    = "acctestkc%d"
+  purview_account_id      = azurerm_purview_account.test.id
+  eventhub_id             = azurerm_eventhub.test.id
+  eventhub_type           = "Notification"
+  eventhub_partition_id   = "0"
+  event_streaming_enabled = false
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PurviewAccountKafkaConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_account_kafka_configuration" "import" {
+  name               = azurerm_purview_account_kafka_configuration.test.name
+  purview_account_id = azurerm_purview_account_kafka_configuration.test.purview_account_id
+  eventhub_id        = azurerm_purview_account_kafka_configuration.test.eventhub_id
+  eventhub_type      = azurerm_purview_account_kafka_configuration.test.eventhub_type
+}
+`, r.basic(data))
+}
+
+func (r PurviewAccountKafkaConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-purview-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_purview_account" "test" {
+  name                      = "acctestsw%[1]d"
+  resource_group_name       = azurerm_resource_group.test.name
+  location                  = azurerm_resource_group.test.location
+  managed_event_hub_enabled = false
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_eventhub_namespace.test.id
+  role_definition_name = "Azure Event Hubs Data Sender"
+  principal_id         = azurerm_purview_account.test.identity[0].principal_id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package purview
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-12-01/account"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourcePurviewAccountManagedResources() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourcePurviewAccountManagedResourcesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"purview_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: account.ValidateAccountID,
+			},
+
+			"resource_group_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"storage_account_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"event_hub_namespace_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePurviewAccountManagedResourcesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Purview.AccountsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := account.ParseAccountID(d.Get("purview_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("purview_account_id", id.ID())
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		managedResources := pointer.From(model.Properties.ManagedResources)
+		d.Set("resource_group_id", pointer.From(managedResources.ResourceGroup))
+		d.Set("storage_account_id", pointer.From(managedResources.StorageAccount))
+		d.Set("event_hub_namespace_id", pointer.From(managedResources.EventHubNamespace))
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package purview_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PurviewAccountManagedResourcesDataSource struct{}
+
+func TestAccPurviewAccountManagedResourcesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_purview_account_managed_resources", "test")
+	r := PurviewAccountManagedResourcesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource_group_id").Exists(),
+				check.That(data.ResourceName).Key("storage_account_id").Exists(),
+				check.That(data.ResourceName).Key("event_hub_namespace_id").Exists(),
+			),
+		},
+	})
+}
+
+func (PurviewAccountManagedResourcesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_purview_account_managed_resources" "test" {
+  purview_account_id = azurerm_purview_account.test.id
+}
+`, PurviewAccountResource{}.basic(data))
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-07-01/account"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-12-01/account"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -86,6 +86,18 @@ func resourcePurviewAccountCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	}
 	purviewAccount.Properties.PublicNetworkAccess = &publicNetworkAccessEnabled
 
+	managedResourcesPublicNetworkAccess := account.ManagedResourcesPublicNetworkAccessDisabled
+	if d.Get("managed_resources_public_network_access_enabled").(bool) {
+		managedResourcesPublicNetworkAccess = account.ManagedResourcesPublicNetworkAccessEnabled
+	}
+	purviewAccount.Properties.ManagedResourcesPublicNetworkAccess = &managedResourcesPublicNetworkAccess
+
+	managedEventHubState := account.ManagedEventHubStateDisabled
+	if d.Get("managed_event_hub_enabled").(bool) {
+		managedEventHubState = account.ManagedEventHubStateEnabled
+	}
+	purviewAccount.Properties.ManagedEventHubState = &managedEventHubState
+
 	if v, ok := d.GetOk("managed_resource_group_name"); ok {
 		purviewAccount.Properties.ManagedResourceGroupName = utils.String(v.(string))
 	}
@@ -143,6 +155,18 @@ func resourcePurviewAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 			}
 			d.Set("public_network_enabled", publicNetworkAccessEnabled)
 
+			managedResourcesPublicNetworkAccessEnabled := true
+			if props.ManagedResourcesPublicNetworkAccess != nil {
+				managedResourcesPublicNetworkAccessEnabled = *props.ManagedResourcesPublicNetworkAccess != account.ManagedResourcesPublicNetworkAccessDisabled
+			}
+			d.Set("managed_resources_public_network_access_enabled", managedResourcesPublicNetworkAccessEnabled)
+
+			managedEventHubEnabled := true
+			if props.ManagedEventHubState != nil {
+				managedEventHubEnabled = *props.ManagedEventHubState != account.ManagedEventHubStateDisabled
+			}
+			d.Set("managed_event_hub_enabled", managedEventHubEnabled)
+
 			managedResourceGroupName := ""
 			if props.ManagedResourceGroupName != nil {
 				managedResourceGroupName = *props.ManagedResourceGroupName
@@ -245,6 +269,18 @@ func resourcePurviewSchema() map[string]*pluginsdk.Schema {
 			Default:  true,
 		},
 
+		"managed_event_hub_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"managed_resources_public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
 		"managed_resource_group_name": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
@@ -27,12 +27,15 @@ func (r Registration) WebsiteCategories() []string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azurerm_purview_account_managed_resources": dataSourcePurviewAccountManagedResources(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_purview_account": resourcePurviewAccount(),
+		"azurerm_purview_account":                     resourcePurviewAccount(),
+		"azurerm_purview_account_kafka_configuration": resourcePurviewAccountKafkaConfiguration(),
 	}
 }
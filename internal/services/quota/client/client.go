@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2023-02-01/quotainformation"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2023-02-01/usagesinformation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	QuotaInformationClient  *quotainformation.QuotaInformationClient
+	UsagesInformationClient *usagesinformation.UsagesInformationClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	quotaInformationClient, err := quotainformation.NewQuotaInformationClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building QuotaInformation client: %+v", err)
+	}
+
+	o.Configure(quotaInformationClient.Client, o.Authorizers.ResourceManager)
+
+	usagesInformationClient, err := usagesinformation.NewUsagesInformationClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building UsagesInformation client: %+v", err)
+	}
+
+	o.Configure(usagesInformationClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		QuotaInformationClient:  quotaInformationClient,
+		UsagesInformationClient: usagesInformationClient,
+	}, nil
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2023-02-01/quotainformation"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2023-02-01/usagesinformation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type QuotaModel struct {
+	Name             string `tfschema:"name"`
+	ResourceProvider string `tfschema:"resource_provider"`
+	Location         string `tfschema:"location"`
+	Limit            int64  `tfschema:"limit"`
+	DisplayName      string `tfschema:"display_name"`
+	Unit             string `tfschema:"unit"`
+	CurrentUsage     int64  `tfschema:"current_usage"`
+}
+
+type QuotaResource struct{}
+
+var _ sdk.ResourceWithUpdate = QuotaResource{}
+
+func (r QuotaResource) ResourceType() string {
+	return "azurerm_quota"
+}
+
+func (r QuotaResource) ModelObject() interface{} {
+	return &QuotaModel{}
+}
+
+func (r QuotaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return quotainformation.ValidateScopedQuotaID
+}
+
+func (r QuotaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_provider": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": commonschema.Location(),
+
+		"limit": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+}
+
+func (r QuotaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"unit": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"current_usage": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r QuotaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Quota.QuotaInformationClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model QuotaModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scope := fmt.Sprintf("/subscriptions/%s/providers/%s/locations/%s", subscriptionId, model.ResourceProvider, location.Normalize(model.Location))
+			id := quotainformation.NewScopedQuotaID(scope, model.Name)
+
+			// a quota always exists for a supported resource, so there's nothing to import - this instead checks the resource is quota-managed
+			existing, err := client.QuotaGet(ctx, id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found - check the `name` is a quota-managed resource for this Resource Provider", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if err := requestQuotaLimit(ctx, client, id, model.Limit); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r QuotaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Quota.QuotaInformationClient
+			usagesClient := metadata.Client.Quota.UsagesInformationClient
+
+			id, err := quotainformation.ParseScopedQuotaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resourceProvider, locationName, err := parseQuotaScope(id.Scope)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.QuotaGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := QuotaModel{
+				Name:             id.QuotaName,
+				ResourceProvider: resourceProvider,
+				Location:         location.Normalize(locationName),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if limit, ok := props.Limit.(quotainformation.LimitObject); ok {
+						state.Limit = limit.Value
+					}
+					if name := props.Name; name != nil {
+						state.DisplayName = pointer.From(name.LocalizedValue)
+					}
+					state.Unit = pointer.From(props.Unit)
+				}
+			}
+
+			usageId := usagesinformation.NewScopedUsageID(id.Scope, id.QuotaName)
+			usage, err := usagesClient.UsagesGet(ctx, usageId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", usageId, err)
+			}
+			if model := usage.Model; model != nil {
+				if props := model.Properties; props != nil && props.Usages != nil {
+					state.CurrentUsage = props.Usages.Value
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r QuotaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Quota.QuotaInformationClient
+
+			id, err := quotainformation.ParseScopedQuotaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model QuotaModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("limit") {
+				if err := requestQuotaLimit(ctx, client, *id, model.Limit); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r QuotaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := quotainformation.ParseScopedQuotaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a quota can't be removed, only changed - so the current limit is left in place
+			log.Printf("[DEBUG] Removing %s from the state - the current limit will be retained", *id)
+
+			return nil
+		},
+	}
+}
+
+func requestQuotaLimit(ctx context.Context, client *quotainformation.QuotaInformationClient, id quotainformation.ScopedQuotaId, limit int64) error {
+	payload := quotainformation.CurrentQuotaLimitBase{
+		Properties: &quotainformation.QuotaProperties{
+			Limit: quotainformation.LimitObject{
+				Value: limit,
+			},
+			Name: &quotainformation.ResourceName{
+				Value: pointer.To(id.QuotaName),
+			},
+		},
+	}
+
+	// the request is processed asynchronously and is either approved automatically, or rejected when it needs manual review
+	if err := client.QuotaCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("requesting a limit of %d for %s: %+v\n\nQuota requests which can't be approved automatically need to be raised as a Support Request - once approved the new limit can be imported into this resource", limit, id, err)
+	}
+
+	return nil
+}
+
+// parseQuotaScope returns the Resource Provider and Location from a quota scope in the format
+// `/subscriptions/{subscriptionId}/providers/{resourceProvider}/locations/{location}`
+func parseQuotaScope(input string) (string, string, error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 6 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "providers") || !strings.EqualFold(segments[4], "locations") {
+		return "", "", fmt.Errorf("parsing quota scope %q: expected the format `/subscriptions/{subscriptionId}/providers/{resourceProvider}/locations/{location}`", input)
+	}
+
+	return segments[3], segments[5], nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2023-02-01/quotainformation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type QuotaResource struct{}
+
+func TestAccQuota_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_quota", "test")
+	r := QuotaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("limit").HasValue("10"),
+				check.That(data.ResourceName).Key("unit").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccQuota_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_quota", "test")
+	r := QuotaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 12),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("limit").HasValue("12"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r QuotaResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := quotainformation.ParseScopedQuotaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Quota.QuotaInformationClient.QuotaGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r QuotaResource) basic(data acceptance.TestData, limit int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_quota" "test" {
+  name              = "standardDSv3Family"
+  resource_provider = "Microsoft.Compute"
+  location          = "%s"
+  limit             = %d
+}
+`, data.Locations.Primary, limit)
+}
@@ -141,6 +141,17 @@ func resourceRecoveryServicesVault() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"cross_subscription_restore_state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(vaults.CrossSubscriptionRestoreStateDisabled),
+					string(vaults.CrossSubscriptionRestoreStateEnabled),
+					string(vaults.CrossSubscriptionRestoreStatePermanentlyDisabled),
+				}, false),
+			},
+
 			"soft_delete_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -182,6 +193,10 @@ func resourceRecoveryServicesVault() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("immutability", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(string) == string(vaults.ImmutabilityStateLocked)
 			}),
+			// once Cross Subscription Restore has been permanently disabled it can't be enabled again
+			pluginsdk.ForceNewIfChange("cross_subscription_restore_state", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) == string(vaults.CrossSubscriptionRestoreStatePermanentlyDisabled)
+			}),
 		),
 	}
 }
@@ -247,6 +262,10 @@ func resourceRecoveryServicesVaultCreate(d *pluginsdk.ResourceData, meta interfa
 		},
 	}
 
+	if v, ok := d.GetOk("cross_subscription_restore_state"); ok {
+		vault.Properties.RestoreSettings = expandRecoveryServicesVaultRestoreSettings(v.(string))
+	}
+
 	if vaults.SkuName(sku) == vaults.SkuNameRSZero {
 		vault.Sku.Tier = utils.String("Standard")
 	}
@@ -500,6 +519,10 @@ func resourceRecoveryServicesVaultUpdate(d *pluginsdk.ResourceData, meta interfa
 		crossRegionRestoreEnabled = vaults.CrossRegionRestoreEnabled
 	}
 
+	if d.HasChange("cross_subscription_restore_state") {
+		vault.Properties.RestoreSettings = expandRecoveryServicesVaultRestoreSettings(d.Get("cross_subscription_restore_state").(string))
+	}
+
 	if d.HasChanges("storage_mode_type", "cross_region_restore_enabled") {
 		vault.Properties.RedundancySettings = &vaults.VaultPropertiesRedundancySettings{
 			CrossRegionRestore:            &crossRegionRestoreEnabled,
@@ -615,6 +638,12 @@ func resourceRecoveryServicesVaultRead(d *pluginsdk.ResourceData, meta interface
 			}
 			d.Set("cross_region_restore_enabled", crossRegionRestoreEnabled)
 			d.Set("storage_mode_type", string(storageModeType))
+
+			crossSubscriptionRestoreState := ""
+			if prop.RestoreSettings != nil && prop.RestoreSettings.CrossSubscriptionRestoreSettings != nil {
+				crossSubscriptionRestoreState = string(pointer.From(prop.RestoreSettings.CrossSubscriptionRestoreSettings.CrossSubscriptionRestoreState))
+			}
+			d.Set("cross_subscription_restore_state", crossSubscriptionRestoreState)
 		}
 
 		cfg, err := cfgsClient.Get(ctx, cfgId)
@@ -822,6 +851,18 @@ func expandRecoveryServicesVaultSecuritySettings(input interface{}) *vaults.Secu
 	}
 }
 
+func expandRecoveryServicesVaultRestoreSettings(input string) *vaults.RestoreSettings {
+	if input == "" {
+		return nil
+	}
+
+	return &vaults.RestoreSettings{
+		CrossSubscriptionRestoreSettings: &vaults.CrossSubscriptionRestoreSettings{
+			CrossSubscriptionRestoreState: pointer.To(vaults.CrossSubscriptionRestoreState(input)),
+		},
+	}
+}
+
 func expandRecoveryServicesVaultPublicNetworkAccess(input bool) *vaults.PublicNetworkAccess {
 	out := vaults.PublicNetworkAccessDisabled
 	if input {
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+//go:generate go run ../../tools/generator-api-versions/main.go -services=../ -output=./api_versions_gen.go
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type apiVersion struct {
+	service string
+	version string
+}
+
+func dataSourceApiVersions() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceApiVersionsRead,
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_types": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"resources": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"api_versions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"service": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"version": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApiVersionsRead(d *pluginsdk.ResourceData, _ interface{}) error {
+	resourceTypes := make([]string, 0)
+	for _, v := range d.Get("resource_types").([]interface{}) {
+		resourceType := v.(string)
+		if _, ok := resourceApiVersions[resourceType]; !ok {
+			return fmt.Errorf("the resource type %q is not supported by this version of the provider", resourceType)
+		}
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	if len(resourceTypes) == 0 {
+		for k := range resourceApiVersions {
+			resourceTypes = append(resourceTypes, k)
+		}
+		sort.Strings(resourceTypes)
+	}
+
+	d.SetId("apiVersions-" + uuid.New().String())
+	if err := d.Set("resources", flattenApiVersionsResources(resourceTypes)); err != nil {
+		return fmt.Errorf("setting `resources`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenApiVersionsResources(resourceTypes []string) []interface{} {
+	output := make([]interface{}, 0, len(resourceTypes))
+	for _, resourceType := range resourceTypes {
+		versions := make([]interface{}, 0)
+		for _, v := range resourceApiVersions[resourceType] {
+			versions = append(versions, map[string]interface{}{
+				"service": v.service,
+				"version": v.version,
+			})
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_type": resourceType,
+			"api_versions":  versions,
+		})
+	}
+	return output
+}
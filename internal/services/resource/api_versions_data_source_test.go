@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ApiVersionsDataSource struct{}
+
+func TestAccDataSourceApiVersions_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_versions", "test")
+	r := ApiVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resources.#").HasValue("2"),
+				check.That(data.ResourceName).Key("resources.0.resource_type").HasValue("azurerm_public_ip"),
+				check.That(data.ResourceName).Key("resources.0.api_versions.0.service").HasValue("network"),
+				check.That(data.ResourceName).Key("resources.0.api_versions.0.version").Exists(),
+				check.That(data.ResourceName).Key("resources.1.resource_type").HasValue("azurerm_resource_group"),
+			),
+		},
+	})
+}
+
+func (ApiVersionsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_api_versions" "test" {
+  resource_types = ["azurerm_public_ip", "azurerm_resource_group"]
+}
+`
+}
@@ -0,0 +1,3594 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+// resourceApiVersions is the API Versions used by each Resource, based on the SDK packages imported by the files
+// implementing that Resource
+var resourceApiVersions = map[string][]apiVersion{
+	"azurerm_aadb2c_directory": {
+		{service: "aadb2c", version: "2021-04-01-preview"},
+	},
+	"azurerm_active_directory_domain_service": {
+		{service: "aad", version: "2021-05-01"},
+	},
+	"azurerm_active_directory_domain_service_replica_set": {
+		{service: "aad", version: "2021-05-01"},
+	},
+	"azurerm_active_directory_domain_service_trust": {
+		{service: "aad", version: "2021-05-01"},
+	},
+	"azurerm_advanced_threat_protection": {},
+	"azurerm_advisor_configuration": {
+		{service: "advisor", version: "2023-01-01"},
+	},
+	"azurerm_advisor_suppression": {
+		{service: "advisor", version: "2023-01-01"},
+	},
+	"azurerm_analysis_services_server": {
+		{service: "analysisservices", version: "2017-08-01"},
+	},
+	"azurerm_api_connection": {
+		{service: "web", version: "2016-06-01"},
+	},
+	"azurerm_api_management": {
+		{service: "apimanagement", version: "2022-08-01"},
+		{service: "apimanagement", version: "2023-05-01-preview"},
+	},
+	"azurerm_api_management_api": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_api_diagnostic": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_api_operation": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_api_operation_policy": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_api_operation_tag": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_api_policy": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_api_release": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_api_resolver": {
+		{service: "apimanagement", version: "2023-05-01-preview"},
+	},
+	"azurerm_api_management_api_resolver_policy": {
+		{service: "apimanagement", version: "2023-05-01-preview"},
+	},
+	"azurerm_api_management_api_schema": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_api_tag": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_api_tag_description": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_api_version_set": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_authorization": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_authorization_access_policy": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_authorization_provider": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_authorization_server": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_backend": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_certificate": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_custom_domain": {
+		{service: "apimanagement", version: "2023-05-01-preview"},
+	},
+	"azurerm_api_management_diagnostic": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_email_template": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_gateway": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_gateway_api": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_gateway_certificate_authority": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_gateway_host_name_configuration": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_global_schema": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_group": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_group_user": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_identity_provider_aad": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_identity_provider_aadb2c": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_identity_provider_facebook": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_identity_provider_google": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_identity_provider_microsoft": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_identity_provider_twitter": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_logger": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_named_value": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_notification_recipient_email": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_notification_recipient_user": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_openid_connect_provider": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_policy": {
+		{service: "apimanagement", version: "2022-08-01"},
+		{service: "apimanagement", version: "2023-05-01-preview"},
+	},
+	"azurerm_api_management_policy_fragment": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_product": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_product_api": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_product_group": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_product_policy": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_product_tag": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_redis_cache": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_subscription": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_tag": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_user": {
+		{service: "apimanagement", version: "2022-08-01"},
+	},
+	"azurerm_api_management_workspace": {
+		{service: "apimanagement", version: "2023-05-01-preview"},
+	},
+	"azurerm_api_management_workspace_policy": {
+		{service: "apimanagement", version: "2023-05-01-preview"},
+	},
+	"azurerm_app_configuration": {
+		{service: "appconfiguration", version: "2023-03-01"},
+	},
+	"azurerm_app_configuration_feature": {
+		{service: "appconfiguration", version: "2023-03-01"},
+	},
+	"azurerm_app_configuration_key": {
+		{service: "appconfiguration", version: "2023-03-01"},
+	},
+	"azurerm_app_configuration_key_values": {
+		{service: "appconfiguration", version: "2023-03-01"},
+	},
+	"azurerm_app_configuration_snapshot": {
+		{service: "appconfiguration", version: "2023-03-01"},
+	},
+	"azurerm_app_service": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_active_slot": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_certificate": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_certificate_binding": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_certificate_order": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_connection": {
+		{service: "servicelinker", version: "2022-05-01"},
+		{service: "servicelinker", version: "2024-04-01"},
+	},
+	"azurerm_app_service_custom_hostname_binding": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_environment": {
+		{service: "network", version: "2023-11-01"},
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_environment_v3": {
+		{service: "network", version: "2023-11-01"},
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_app_service_hybrid_connection": {
+		{service: "relay", version: "2021-11-01"},
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_managed_certificate": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_plan": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_public_certificate": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_app_service_slot": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_slot_custom_hostname_binding": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_slot_virtual_network_swift_connection": {
+		{service: "network", version: "2023-11-01"},
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_source_control": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_app_service_source_control_slot": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_app_service_source_control_token": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_app_service_virtual_network_swift_connection": {
+		{service: "network", version: "2023-11-01"},
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_application_gateway": {
+		{service: "network", version: "2022-07-01"},
+		{service: "network", version: "2023-09-01"},
+	},
+	"azurerm_application_insights": {
+		{service: "alertsmanagement", version: "2019-06-01"},
+		{service: "applicationinsights", version: "2015-05-01"},
+		{service: "applicationinsights", version: "2020-02-02"},
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_application_insights_analytics_item": {
+		{service: "applicationinsights", version: "2015-05-01"},
+		{service: "applicationinsights", version: "2020-02-02"},
+	},
+	"azurerm_application_insights_api_key": {
+		{service: "applicationinsights", version: "2015-05-01"},
+		{service: "applicationinsights", version: "2020-02-02"},
+	},
+	"azurerm_application_insights_smart_detection_rule": {
+		{service: "applicationinsights", version: "2015-05-01"},
+		{service: "applicationinsights", version: "2020-02-02"},
+	},
+	"azurerm_application_insights_standard_web_test": {
+		{service: "applicationinsights", version: "2020-02-02"},
+		{service: "applicationinsights", version: "2022-06-15"},
+	},
+	"azurerm_application_insights_web_test": {
+		{service: "applicationinsights", version: "2020-02-02"},
+		{service: "applicationinsights", version: "2022-06-15"},
+	},
+	"azurerm_application_insights_workbook": {
+		{service: "applicationinsights", version: "2022-04-01"},
+	},
+	"azurerm_application_insights_workbook_template": {
+		{service: "applicationinsights", version: "2020-11-20"},
+	},
+	"azurerm_application_load_balancer": {
+		{service: "servicenetworking", version: "2023-11-01"},
+	},
+	"azurerm_application_load_balancer_frontend": {
+		{service: "servicenetworking", version: "2023-11-01"},
+	},
+	"azurerm_application_load_balancer_subnet_association": {
+		{service: "servicenetworking", version: "2023-11-01"},
+	},
+	"azurerm_application_security_group": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_arc_esu_license": {
+		{service: "hybridcompute", version: "2024-05-20-preview"},
+	},
+	"azurerm_arc_kubernetes_cluster": {
+		{service: "hybridkubernetes", version: "2024-01-01"},
+	},
+	"azurerm_arc_kubernetes_cluster_extension": {
+		{service: "hybridkubernetes", version: "2024-01-01"},
+		{service: "kubernetesconfiguration", version: "2022-11-01"},
+	},
+	"azurerm_arc_kubernetes_flux_configuration": {
+		{service: "hybridkubernetes", version: "2024-01-01"},
+		{service: "kubernetesconfiguration", version: "2023-05-01"},
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_arc_machine_extension": {
+		{service: "hybridcompute", version: "2022-11-10"},
+	},
+	"azurerm_arc_machine_license_profile": {
+		{service: "hybridcompute", version: "2022-11-10"},
+		{service: "hybridcompute", version: "2024-05-20-preview"},
+	},
+	"azurerm_arc_machine_patch_settings": {
+		{service: "hybridcompute", version: "2022-11-10"},
+	},
+	"azurerm_arc_machine_private_link_scope_association": {
+		{service: "hybridcompute", version: "2022-11-10"},
+	},
+	"azurerm_arc_private_link_scope": {
+		{service: "hybridcompute", version: "2022-11-10"},
+	},
+	"azurerm_arc_resource_bridge_appliance": {
+		{service: "resourceconnector", version: "2022-10-27"},
+	},
+	"azurerm_attestation_provider": {
+		{service: "attestation", version: "2020-10-01"},
+		{service: "attestation", version: "2022-08-01"},
+	},
+	"azurerm_automanage_configuration": {
+		{service: "automanage", version: "2022-05-04"},
+	},
+	"azurerm_automation_account": {
+		{service: "automation", version: "2019-06-01"},
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_certificate": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_connection": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_connection_certificate": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_connection_classic_certificate": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_connection_service_principal": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_connection_type": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_credential": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_dsc_configuration": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_dsc_nodeconfiguration": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_hybrid_runbook_worker": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_hybrid_runbook_worker_group": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_job_schedule": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_module": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_powershell72_module": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_python3_package": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_runbook": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_schedule": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_software_update_configuration": {
+		{service: "automation", version: "2019-06-01"},
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_source_control": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_variable_bool": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_variable_datetime": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_variable_int": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_variable_object": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_variable_string": {
+		{service: "automation", version: "2023-11-01"},
+	},
+	"azurerm_automation_watcher": {
+		{service: "automation", version: "2020-01-13-preview"},
+	},
+	"azurerm_automation_webhook": {
+		{service: "automation", version: "2015-10-31"},
+	},
+	"azurerm_availability_set": {
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_backup_container_storage_account": {
+		{service: "recoveryservices", version: "2021-12-01"},
+		{service: "recoveryservicesbackup", version: "2023-02-01"},
+	},
+	"azurerm_backup_policy_file_share": {
+		{service: "recoveryservicesbackup", version: "2023-02-01"},
+	},
+	"azurerm_backup_policy_vm": {
+		{service: "recoveryservicesbackup", version: "2023-02-01"},
+	},
+	"azurerm_backup_policy_vm_workload": {
+		{service: "recoveryservicesbackup", version: "2023-02-01"},
+	},
+	"azurerm_backup_protected_file_share": {
+		{service: "recoveryservices", version: "2021-12-01"},
+		{service: "recoveryservicesbackup", version: "2023-02-01"},
+	},
+	"azurerm_backup_protected_vm": {
+		{service: "recoveryservices", version: "2021-12-01"},
+		{service: "recoveryservicesbackup", version: "2023-02-01"},
+	},
+	"azurerm_bastion_host": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_batch_account": {
+		{service: "batch", version: "2023-05-01"},
+	},
+	"azurerm_batch_application": {
+		{service: "batch", version: "2023-05-01"},
+	},
+	"azurerm_batch_certificate": {
+		{service: "batch", version: "2023-05-01"},
+	},
+	"azurerm_batch_job": {
+		{service: "batch", version: "2023-05-01"},
+		{service: "batch", version: "2024-02-01"},
+	},
+	"azurerm_batch_pool": {
+		{service: "batch", version: "2024-02-01"},
+	},
+	"azurerm_billing_account_cost_management_export": {
+		{service: "costmanagement", version: "2023-07-01-preview"},
+	},
+	"azurerm_blueprint_assignment": {
+		{service: "blueprints", version: "2018-11-01-preview"},
+	},
+	"azurerm_bot_channel_alexa": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_bot_channel_direct_line_speech": {
+		{service: "botservice", version: "2021-05-01-preview"},
+		{service: "cognitive", version: "2023-05-01"},
+	},
+	"azurerm_bot_channel_directline": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_bot_channel_email": {
+		{service: "botservice", version: "2022-09-15"},
+	},
+	"azurerm_bot_channel_facebook": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_bot_channel_line": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_bot_channel_ms_teams": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_bot_channel_slack": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_bot_channel_sms": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_bot_channel_web_chat": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_bot_channels_registration": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_bot_connection": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_bot_service_azure_bot": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_bot_web_app": {
+		{service: "botservice", version: "2021-05-01-preview"},
+	},
+	"azurerm_capacity_reservation": {
+		{service: "compute", version: "2022-03-01"},
+	},
+	"azurerm_capacity_reservation_group": {
+		{service: "compute", version: "2022-03-01"},
+	},
+	"azurerm_cdn_endpoint": {
+		{service: "cdn", version: "2020-09-01"},
+	},
+	"azurerm_cdn_endpoint_custom_domain": {
+		{service: "cdn", version: "2020-09-01"},
+	},
+	"azurerm_cdn_frontdoor_custom_domain": {
+		{service: "cdn", version: "2021-06-01"},
+		{service: "dns", version: "2018-05-01"},
+	},
+	"azurerm_cdn_frontdoor_custom_domain_association": {},
+	"azurerm_cdn_frontdoor_endpoint": {
+		{service: "cdn", version: "2021-06-01"},
+	},
+	"azurerm_cdn_frontdoor_firewall_policy": {
+		{service: "frontdoor", version: "2020-11-01"},
+	},
+	"azurerm_cdn_frontdoor_origin": {
+		{service: "cdn", version: "2021-06-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_cdn_frontdoor_origin_group": {
+		{service: "cdn", version: "2021-06-01"},
+	},
+	"azurerm_cdn_frontdoor_profile": {
+		{service: "cdn", version: "2021-06-01"},
+	},
+	"azurerm_cdn_frontdoor_profile_migration": {
+		{service: "cdn", version: "2021-06-01"},
+		{service: "frontdoor", version: "2020-05-01"},
+	},
+	"azurerm_cdn_frontdoor_route": {
+		{service: "cdn", version: "2021-06-01"},
+	},
+	"azurerm_cdn_frontdoor_route_disable_link_to_default_domain": {
+		{service: "cdn", version: "2021-06-01"},
+	},
+	"azurerm_cdn_frontdoor_rule": {
+		{service: "cdn", version: "2021-06-01"},
+	},
+	"azurerm_cdn_frontdoor_rule_set": {},
+	"azurerm_cdn_frontdoor_secret": {
+		{service: "cdn", version: "2021-06-01"},
+	},
+	"azurerm_cdn_frontdoor_security_policy": {
+		{service: "cdn", version: "2021-06-01"},
+	},
+	"azurerm_cdn_profile": {
+		{service: "cdn", version: "2020-09-01"},
+	},
+	"azurerm_chaos_studio_capability": {
+		{service: "chaosstudio", version: "2023-11-01"},
+	},
+	"azurerm_chaos_studio_experiment": {
+		{service: "chaosstudio", version: "2023-11-01"},
+	},
+	"azurerm_chaos_studio_target": {
+		{service: "chaosstudio", version: "2023-11-01"},
+	},
+	"azurerm_cognitive_account": {
+		{service: "cognitive", version: "2023-05-01"},
+		{service: "search", version: "2022-09-01"},
+	},
+	"azurerm_cognitive_account_customer_managed_key": {
+		{service: "cognitive", version: "2023-05-01"},
+	},
+	"azurerm_cognitive_account_rai_policy": {
+		{service: "cognitive", version: "2023-05-01"},
+		{service: "cognitive", version: "2023-10-01-preview"},
+	},
+	"azurerm_cognitive_deployment": {
+		{service: "cognitive", version: "2023-05-01"},
+		{service: "cognitive", version: "2023-10-01-preview"},
+	},
+	"azurerm_communication_service": {
+		{service: "communication", version: "2023-03-31"},
+	},
+	"azurerm_confidential_ledger": {
+		{service: "confidentialledger", version: "2022-05-13"},
+	},
+	"azurerm_consumption_budget_management_group": {
+		{service: "consumption", version: "2019-10-01"},
+	},
+	"azurerm_consumption_budget_resource_group": {
+		{service: "consumption", version: "2019-10-01"},
+	},
+	"azurerm_consumption_budget_subscription": {
+		{service: "consumption", version: "2019-10-01"},
+	},
+	"azurerm_container_app": {
+		{service: "containerapps", version: "2023-05-01"},
+		{service: "containerapps", version: "2024-03-01"},
+	},
+	"azurerm_container_app_custom_domain": {
+		{service: "containerapps", version: "2023-05-01"},
+	},
+	"azurerm_container_app_environment": {
+		{service: "containerapps", version: "2024-03-01"},
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_container_app_environment_certificate": {
+		{service: "containerapps", version: "2023-05-01"},
+		{service: "containerapps", version: "2024-03-01"},
+	},
+	"azurerm_container_app_environment_custom_domain": {
+		{service: "containerapps", version: "2024-03-01"},
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_container_app_environment_dapr_component": {
+		{service: "containerapps", version: "2023-05-01"},
+	},
+	"azurerm_container_app_environment_storage": {
+		{service: "containerapps", version: "2023-05-01"},
+	},
+	"azurerm_container_app_job": {
+		{service: "containerapps", version: "2023-05-01"},
+	},
+	"azurerm_container_connected_registry": {
+		{service: "containerregistry", version: "2021-08-01-preview"},
+	},
+	"azurerm_container_group": {
+		{service: "containerinstance", version: "2023-05-01"},
+	},
+	"azurerm_container_registry": {
+		{service: "containerregistry", version: "2021-08-01-preview"},
+		{service: "containerregistry", version: "2023-06-01-preview"},
+	},
+	"azurerm_container_registry_agent_pool": {
+		{service: "containerregistry", version: "2019-06-01-preview"},
+	},
+	"azurerm_container_registry_cache_rule": {
+		{service: "containerregistry", version: "2021-08-01-preview"},
+		{service: "containerregistry", version: "2023-07-01"},
+	},
+	"azurerm_container_registry_scope_map": {
+		{service: "containerregistry", version: "2021-08-01-preview"},
+	},
+	"azurerm_container_registry_task": {
+		{service: "containerregistry", version: "2019-06-01-preview"},
+		{service: "containerregistry", version: "2021-08-01-preview"},
+	},
+	"azurerm_container_registry_task_schedule_run_now": {
+		{service: "containerregistry", version: "2019-06-01-preview"},
+	},
+	"azurerm_container_registry_token": {
+		{service: "containerregistry", version: "2021-08-01-preview"},
+	},
+	"azurerm_container_registry_token_password": {
+		{service: "containerregistry", version: "2021-08-01-preview"},
+	},
+	"azurerm_container_registry_webhook": {
+		{service: "containerregistry", version: "2021-08-01-preview"},
+	},
+	"azurerm_cosmosdb_account": {
+		{service: "cosmos-db", version: "2021-10-15"},
+		{service: "cosmosdb", version: "2024-05-15"},
+	},
+	"azurerm_cosmosdb_cassandra_cluster": {
+		{service: "cosmosdb", version: "2023-04-15"},
+	},
+	"azurerm_cosmosdb_cassandra_datacenter": {
+		{service: "cosmosdb", version: "2023-04-15"},
+	},
+	"azurerm_cosmosdb_cassandra_keyspace": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cosmosdb_cassandra_table": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cosmosdb_gremlin_database": {
+		{service: "cosmosdb", version: "2024-05-15"},
+	},
+	"azurerm_cosmosdb_gremlin_graph": {
+		{service: "cosmosdb", version: "2024-05-15"},
+	},
+	"azurerm_cosmosdb_mongo_collection": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cosmosdb_mongo_database": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cosmosdb_mongo_role_definition": {
+		{service: "cosmosdb", version: "2022-11-15"},
+	},
+	"azurerm_cosmosdb_mongo_user_definition": {
+		{service: "cosmosdb", version: "2022-11-15"},
+	},
+	"azurerm_cosmosdb_notebook_workspace": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cosmosdb_postgresql_coordinator_configuration": {
+		{service: "postgresqlhsc", version: "2022-11-08"},
+	},
+	"azurerm_cosmosdb_postgresql_firewall_rule": {
+		{service: "postgresqlhsc", version: "2022-11-08"},
+	},
+	"azurerm_cosmosdb_postgresql_node_configuration": {
+		{service: "postgresqlhsc", version: "2022-11-08"},
+	},
+	"azurerm_cosmosdb_postgresql_role": {
+		{service: "postgresqlhsc", version: "2022-11-08"},
+	},
+	"azurerm_cosmosdb_sql_container": {
+		{service: "cosmosdb", version: "2024-05-15"},
+	},
+	"azurerm_cosmosdb_sql_database": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cosmosdb_sql_dedicated_gateway": {
+		{service: "cosmosdb", version: "2022-05-15"},
+	},
+	"azurerm_cosmosdb_sql_function": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cosmosdb_sql_role_assignment": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cosmosdb_sql_role_definition": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cosmosdb_sql_stored_procedure": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cosmosdb_sql_trigger": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cosmosdb_table": {
+		{service: "cosmos-db", version: "2021-10-15"},
+	},
+	"azurerm_cost_anomaly_alert": {
+		{service: "costmanagement", version: "2022-06-01-preview"},
+		{service: "costmanagement", version: "2022-10-01"},
+	},
+	"azurerm_cost_management_scheduled_action": {
+		{service: "costmanagement", version: "2022-10-01"},
+	},
+	"azurerm_custom_ip_prefix": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_custom_provider": {
+		{service: "customproviders", version: "2018-09-01-preview"},
+	},
+	"azurerm_dashboard": {
+		{service: "portal", version: "2019-01-01-preview"},
+	},
+	"azurerm_dashboard_grafana": {
+		{service: "dashboard", version: "2023-09-01"},
+	},
+	"azurerm_dashboard_grafana_managed_private_endpoint": {
+		{service: "dashboard", version: "2023-09-01"},
+	},
+	"azurerm_data_factory": {
+		{service: "datafactory", version: "2018-06-01"},
+		{service: "purview", version: "2021-07-01"},
+	},
+	"azurerm_data_factory_credential_service_principal": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_credential_user_managed_identity": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_custom_dataset": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_data_flow": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_azure_blob": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_azure_sql_table": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_binary": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_cosmosdb_sqlapi": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_delimited_text": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_http": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_json": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_mysql": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_parquet": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_postgresql": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_snowflake": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_dataset_sql_server_table": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_flowlet_data_flow": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_integration_runtime_airflow": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_integration_runtime_azure": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_integration_runtime_azure_ssis": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_integration_runtime_managed": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_integration_runtime_self_hosted": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_custom_service": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_azure_blob_storage": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_azure_databricks": {
+		{service: "databricks", version: "2022-04-01-preview"},
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_azure_file_storage": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_azure_function": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_azure_search": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_azure_sql_database": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_azure_table_storage": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_cosmosdb": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_cosmosdb_mongoapi": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_data_lake_storage_gen2": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_key_vault": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_kusto": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_mysql": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_odata": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_odbc": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_postgresql": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_sftp": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_snowflake": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_sql_server": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_synapse": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_linked_service_web": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_managed_private_endpoint": {
+		{service: "datafactory", version: "2018-06-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_data_factory_pipeline": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_trigger_blob_event": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_trigger_custom_event": {
+		{service: "datafactory", version: "2018-06-01"},
+		{service: "eventgrid", version: "2022-06-15"},
+	},
+	"azurerm_data_factory_trigger_schedule": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_factory_trigger_tumbling_window": {
+		{service: "datafactory", version: "2018-06-01"},
+	},
+	"azurerm_data_protection_backup_instance_blob_storage": {
+		{service: "dataprotection", version: "2024-04-01"},
+	},
+	"azurerm_data_protection_backup_instance_disk": {
+		{service: "dataprotection", version: "2024-04-01"},
+	},
+	"azurerm_data_protection_backup_instance_kubernetes_cluster": {
+		{service: "dataprotection", version: "2024-04-01"},
+	},
+	"azurerm_data_protection_backup_instance_postgresql": {
+		{service: "dataprotection", version: "2024-04-01"},
+		{service: "postgresql", version: "2017-12-01"},
+	},
+	"azurerm_data_protection_backup_instance_postgresql_flexible_server": {
+		{service: "dataprotection", version: "2024-04-01"},
+		{service: "postgresql", version: "2023-06-01-preview"},
+	},
+	"azurerm_data_protection_backup_policy_blob_storage": {
+		{service: "dataprotection", version: "2024-04-01"},
+	},
+	"azurerm_data_protection_backup_policy_disk": {
+		{service: "dataprotection", version: "2024-04-01"},
+	},
+	"azurerm_data_protection_backup_policy_kubernetes_cluster": {
+		{service: "dataprotection", version: "2024-04-01"},
+	},
+	"azurerm_data_protection_backup_policy_postgresql": {
+		{service: "dataprotection", version: "2024-04-01"},
+	},
+	"azurerm_data_protection_backup_policy_postgresql_flexible_server": {
+		{service: "dataprotection", version: "2024-04-01"},
+	},
+	"azurerm_data_protection_backup_vault": {
+		{service: "dataprotection", version: "2024-04-01"},
+	},
+	"azurerm_data_protection_resource_guard": {
+		{service: "dataprotection", version: "2024-04-01"},
+	},
+	"azurerm_data_share": {
+		{service: "datashare", version: "2019-11-01"},
+	},
+	"azurerm_data_share_account": {
+		{service: "datashare", version: "2019-11-01"},
+	},
+	"azurerm_data_share_dataset_blob_storage": {
+		{service: "datashare", version: "2019-11-01"},
+	},
+	"azurerm_data_share_dataset_data_lake_gen2": {
+		{service: "datashare", version: "2019-11-01"},
+	},
+	"azurerm_data_share_dataset_kusto_cluster": {
+		{service: "datashare", version: "2019-11-01"},
+	},
+	"azurerm_data_share_dataset_kusto_database": {
+		{service: "datashare", version: "2019-11-01"},
+	},
+	"azurerm_data_share_invitation": {
+		{service: "datashare", version: "2019-11-01"},
+	},
+	"azurerm_data_share_received_share": {
+		{service: "datashare", version: "2019-11-01"},
+	},
+	"azurerm_database_migration_project": {
+		{service: "datamigration", version: "2021-06-30"},
+	},
+	"azurerm_database_migration_service": {
+		{service: "datamigration", version: "2021-06-30"},
+	},
+	"azurerm_databox_edge_device": {
+		{service: "databoxedge", version: "2022-03-01"},
+	},
+	"azurerm_databox_edge_order": {
+		{service: "databoxedge", version: "2022-03-01"},
+	},
+	"azurerm_databricks_access_connector": {
+		{service: "databricks", version: "2022-10-01-preview"},
+	},
+	"azurerm_databricks_virtual_network_peering": {
+		{service: "databricks", version: "2024-05-01"},
+	},
+	"azurerm_databricks_workspace": {
+		{service: "databricks", version: "2022-10-01-preview"},
+		{service: "databricks", version: "2024-05-01"},
+		{service: "machinelearningservices", version: "2024-04-01"},
+		{service: "network", version: "2023-09-01"},
+	},
+	"azurerm_databricks_workspace_customer_managed_key": {
+		{service: "databricks", version: "2024-05-01"},
+	},
+	"azurerm_databricks_workspace_root_dbfs_customer_managed_key": {
+		{service: "databricks", version: "2024-05-01"},
+	},
+	"azurerm_datadog_monitor": {
+		{service: "datadog", version: "2021-03-01"},
+	},
+	"azurerm_datadog_monitor_sso_configuration": {
+		{service: "datadog", version: "2021-03-01"},
+	},
+	"azurerm_datadog_monitor_tag_rule": {
+		{service: "datadog", version: "2021-03-01"},
+	},
+	"azurerm_dedicated_hardware_security_module": {
+		{service: "hardwaresecuritymodules", version: "2021-11-30"},
+	},
+	"azurerm_dedicated_host": {
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_dedicated_host_group": {
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_dev_center": {
+		{service: "devcenter", version: "2023-04-01"},
+	},
+	"azurerm_dev_center_catalog": {
+		{service: "devcenter", version: "2023-04-01"},
+	},
+	"azurerm_dev_center_dev_box_definition": {
+		{service: "devcenter", version: "2023-04-01"},
+	},
+	"azurerm_dev_center_environment_type": {
+		{service: "devcenter", version: "2023-04-01"},
+	},
+	"azurerm_dev_center_gallery": {
+		{service: "devcenter", version: "2023-04-01"},
+	},
+	"azurerm_dev_center_network_connection": {
+		{service: "devcenter", version: "2023-04-01"},
+	},
+	"azurerm_dev_center_project": {
+		{service: "devcenter", version: "2023-04-01"},
+	},
+	"azurerm_dev_center_project_environment_type": {
+		{service: "devcenter", version: "2023-04-01"},
+	},
+	"azurerm_dev_test_global_vm_shutdown_schedule": {
+		{service: "devtestlab", version: "2018-09-15"},
+	},
+	"azurerm_dev_test_lab": {
+		{service: "devtestlab", version: "2018-09-15"},
+	},
+	"azurerm_dev_test_linux_virtual_machine": {
+		{service: "devtestlab", version: "2018-09-15"},
+	},
+	"azurerm_dev_test_policy": {
+		{service: "devtestlab", version: "2018-09-15"},
+	},
+	"azurerm_dev_test_schedule": {
+		{service: "devtestlab", version: "2018-09-15"},
+	},
+	"azurerm_dev_test_virtual_network": {
+		{service: "devtestlab", version: "2018-09-15"},
+	},
+	"azurerm_dev_test_windows_virtual_machine": {
+		{service: "devtestlab", version: "2018-09-15"},
+	},
+	"azurerm_digital_twins_endpoint_eventgrid": {
+		{service: "digitaltwins", version: "2023-01-31"},
+	},
+	"azurerm_digital_twins_endpoint_eventhub": {
+		{service: "digitaltwins", version: "2023-01-31"},
+	},
+	"azurerm_digital_twins_endpoint_servicebus": {
+		{service: "digitaltwins", version: "2023-01-31"},
+	},
+	"azurerm_digital_twins_instance": {
+		{service: "digitaltwins", version: "2023-01-31"},
+	},
+	"azurerm_digital_twins_time_series_database_connection": {
+		{service: "digitaltwins", version: "2023-01-31"},
+		{service: "eventhub", version: "2021-11-01"},
+	},
+	"azurerm_disk_access": {
+		{service: "compute", version: "2022-03-02"},
+	},
+	"azurerm_disk_encryption_set": {
+		{service: "compute", version: "2022-03-02"},
+	},
+	"azurerm_disk_pool": {
+		{service: "storagepool", version: "2021-08-01"},
+	},
+	"azurerm_disk_pool_iscsi_target": {
+		{service: "storagepool", version: "2021-08-01"},
+	},
+	"azurerm_disk_pool_iscsi_target_lun": {
+		{service: "storagepool", version: "2021-08-01"},
+	},
+	"azurerm_disk_pool_managed_disk_attachment": {
+		{service: "storagepool", version: "2021-08-01"},
+	},
+	"azurerm_dns_a_record": {
+		{service: "dns", version: "2018-05-01"},
+	},
+	"azurerm_dns_aaaa_record": {
+		{service: "dns", version: "2018-05-01"},
+	},
+	"azurerm_dns_caa_record": {
+		{service: "dns", version: "2018-05-01"},
+	},
+	"azurerm_dns_cname_record": {
+		{service: "dns", version: "2018-05-01"},
+		{service: "trafficmanager", version: "2022-04-01"},
+	},
+	"azurerm_dns_mx_record": {
+		{service: "dns", version: "2018-05-01"},
+	},
+	"azurerm_dns_ns_record": {
+		{service: "dns", version: "2018-05-01"},
+	},
+	"azurerm_dns_ptr_record": {
+		{service: "dns", version: "2018-05-01"},
+	},
+	"azurerm_dns_srv_record": {
+		{service: "dns", version: "2018-05-01"},
+	},
+	"azurerm_dns_txt_record": {
+		{service: "dns", version: "2018-05-01"},
+	},
+	"azurerm_dns_zone": {
+		{service: "dns", version: "2018-05-01"},
+	},
+	"azurerm_elastic_cloud_elasticsearch": {
+		{service: "elastic", version: "2023-06-01"},
+	},
+	"azurerm_elastic_san": {
+		{service: "elasticsan", version: "2023-01-01"},
+	},
+	"azurerm_elastic_san_volume": {
+		{service: "compute", version: "2022-03-02"},
+		{service: "compute", version: "2023-03-01"},
+		{service: "elasticsan", version: "2023-01-01"},
+	},
+	"azurerm_elastic_san_volume_group": {
+		{service: "elasticsan", version: "2023-01-01"},
+	},
+	"azurerm_email_communication_service": {
+		{service: "communication", version: "2023-03-31"},
+	},
+	"azurerm_email_communication_service_domain": {
+		{service: "communication", version: "2023-03-31"},
+	},
+	"azurerm_email_communication_service_domain_sender_username": {
+		{service: "communication", version: "2023-03-31"},
+	},
+	"azurerm_eventgrid_domain": {
+		{service: "eventgrid", version: "2022-06-15"},
+	},
+	"azurerm_eventgrid_domain_topic": {
+		{service: "eventgrid", version: "2022-06-15"},
+	},
+	"azurerm_eventgrid_event_subscription": {
+		{service: "eventgrid", version: "2022-06-15"},
+	},
+	"azurerm_eventgrid_namespace": {
+		{service: "eventgrid", version: "2023-12-15-preview"},
+	},
+	"azurerm_eventgrid_namespace_client": {
+		{service: "eventgrid", version: "2023-12-15-preview"},
+	},
+	"azurerm_eventgrid_namespace_client_group": {
+		{service: "eventgrid", version: "2023-12-15-preview"},
+	},
+	"azurerm_eventgrid_namespace_permission_binding": {
+		{service: "eventgrid", version: "2023-12-15-preview"},
+	},
+	"azurerm_eventgrid_namespace_topic": {
+		{service: "eventgrid", version: "2023-12-15-preview"},
+	},
+	"azurerm_eventgrid_namespace_topic_space": {
+		{service: "eventgrid", version: "2023-12-15-preview"},
+	},
+	"azurerm_eventgrid_system_topic": {
+		{service: "eventgrid", version: "2022-06-15"},
+	},
+	"azurerm_eventgrid_system_topic_event_subscription": {
+		{service: "eventgrid", version: "2022-06-15"},
+	},
+	"azurerm_eventgrid_topic": {
+		{service: "eventgrid", version: "2022-06-15"},
+	},
+	"azurerm_eventhub": {
+		{service: "eventhub", version: "2022-01-01-preview"},
+		{service: "eventhub", version: "2024-01-01"},
+	},
+	"azurerm_eventhub_authorization_rule": {
+		{service: "eventhub", version: "2021-11-01"},
+		{service: "eventhub", version: "2024-01-01"},
+	},
+	"azurerm_eventhub_cluster": {
+		{service: "eventhub", version: "2021-11-01"},
+	},
+	"azurerm_eventhub_consumer_group": {
+		{service: "eventhub", version: "2021-11-01"},
+	},
+	"azurerm_eventhub_namespace": {
+		{service: "eventhub", version: "2021-11-01"},
+		{service: "eventhub", version: "2022-01-01-preview"},
+	},
+	"azurerm_eventhub_namespace_authorization_rule": {
+		{service: "eventhub", version: "2021-11-01"},
+	},
+	"azurerm_eventhub_namespace_customer_managed_key": {
+		{service: "eventhub", version: "2022-01-01-preview"},
+	},
+	"azurerm_eventhub_namespace_disaster_recovery_config": {
+		{service: "eventhub", version: "2021-11-01"},
+	},
+	"azurerm_eventhub_namespace_schema_group": {
+		{service: "eventhub", version: "2021-11-01"},
+	},
+	"azurerm_express_route_circuit": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_express_route_circuit_authorization": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_express_route_circuit_connection": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_express_route_circuit_peering": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_express_route_connection": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_express_route_gateway": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_express_route_port": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_express_route_port_authorization": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_federated_identity_credential": {
+		{service: "managedidentity", version: "2023-01-31"},
+	},
+	"azurerm_firewall": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_firewall_application_rule_collection": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_firewall_nat_rule_collection": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_firewall_network_rule_collection": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_firewall_policy": {
+		{service: "network", version: "2023-11-01"},
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_firewall_policy_rule_collection_group": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_fluid_relay_server": {
+		{service: "fluidrelay", version: "2022-05-26"},
+	},
+	"azurerm_frontdoor": {
+		{service: "frontdoor", version: "2020-05-01"},
+	},
+	"azurerm_frontdoor_custom_https_configuration": {
+		{service: "frontdoor", version: "2020-05-01"},
+	},
+	"azurerm_frontdoor_firewall_policy": {
+		{service: "frontdoor", version: "2020-04-01"},
+	},
+	"azurerm_frontdoor_rules_engine": {
+		{service: "frontdoor", version: "2020-05-01"},
+	},
+	"azurerm_function_app": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_function_app_active_slot": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_function_app_connection": {
+		{service: "servicelinker", version: "2022-05-01"},
+		{service: "servicelinker", version: "2024-04-01"},
+	},
+	"azurerm_function_app_function": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_function_app_hybrid_connection": {
+		{service: "relay", version: "2021-11-01"},
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_function_app_slot": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_gallery_application": {
+		{service: "compute", version: "2022-03-03"},
+	},
+	"azurerm_gallery_application_version": {
+		{service: "compute", version: "2022-03-03"},
+	},
+	"azurerm_graph_account": {
+		{service: "graphservices", version: "2023-04-13"},
+	},
+	"azurerm_graph_services_account": {
+		{service: "graphservices", version: "2023-04-13"},
+	},
+	"azurerm_hdinsight_hadoop_cluster": {
+		{service: "hdinsight", version: "2021-06-01"},
+	},
+	"azurerm_hdinsight_hbase_cluster": {
+		{service: "hdinsight", version: "2021-06-01"},
+	},
+	"azurerm_hdinsight_interactive_query_cluster": {
+		{service: "hdinsight", version: "2021-06-01"},
+	},
+	"azurerm_hdinsight_kafka_cluster": {
+		{service: "hdinsight", version: "2021-06-01"},
+	},
+	"azurerm_hdinsight_spark_cluster": {
+		{service: "hdinsight", version: "2021-06-01"},
+	},
+	"azurerm_healthbot": {
+		{service: "healthbot", version: "2022-08-08"},
+	},
+	"azurerm_healthcare_dicom_service": {
+		{service: "healthcareapis", version: "2024-03-31"},
+	},
+	"azurerm_healthcare_fhir_service": {
+		{service: "healthcareapis", version: "2022-12-01"},
+		{service: "healthcareapis", version: "2024-03-31"},
+	},
+	"azurerm_healthcare_medtech_service": {
+		{service: "healthcareapis", version: "2022-12-01"},
+		{service: "healthcareapis", version: "2024-03-31"},
+	},
+	"azurerm_healthcare_medtech_service_fhir_destination": {
+		{service: "healthcareapis", version: "2022-12-01"},
+	},
+	"azurerm_healthcare_service": {
+		{service: "healthcareapis", version: "2022-12-01"},
+	},
+	"azurerm_healthcare_workspace": {
+		{service: "healthcareapis", version: "2024-03-31"},
+	},
+	"azurerm_hpc_cache": {
+		{service: "storagecache", version: "2024-03-01"},
+	},
+	"azurerm_hpc_cache_access_policy": {
+		{service: "storagecache", version: "2024-03-01"},
+	},
+	"azurerm_hpc_cache_blob_nfs_target": {
+		{service: "storagecache", version: "2024-03-01"},
+	},
+	"azurerm_hpc_cache_blob_target": {
+		{service: "storagecache", version: "2024-03-01"},
+	},
+	"azurerm_hpc_cache_nfs_target": {
+		{service: "storagecache", version: "2024-03-01"},
+	},
+	"azurerm_image": {
+		{service: "compute", version: "2022-03-01"},
+	},
+	"azurerm_integration_service_environment": {
+		{service: "logic", version: "2019-05-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_iot_security_device_group": {},
+	"azurerm_iot_security_solution": {
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_iot_time_series_insights_access_policy": {
+		{service: "timeseriesinsights", version: "2020-05-15"},
+	},
+	"azurerm_iot_time_series_insights_event_source_eventhub": {
+		{service: "timeseriesinsights", version: "2020-05-15"},
+	},
+	"azurerm_iot_time_series_insights_event_source_iothub": {
+		{service: "timeseriesinsights", version: "2020-05-15"},
+	},
+	"azurerm_iot_time_series_insights_gen2_environment": {
+		{service: "timeseriesinsights", version: "2020-05-15"},
+	},
+	"azurerm_iot_time_series_insights_reference_data_set": {
+		{service: "timeseriesinsights", version: "2020-05-15"},
+	},
+	"azurerm_iot_time_series_insights_standard_environment": {
+		{service: "timeseriesinsights", version: "2020-05-15"},
+	},
+	"azurerm_iotcentral_application": {
+		{service: "iotcentral", version: "2021-11-01-preview"},
+	},
+	"azurerm_iotcentral_application_network_rule_set": {
+		{service: "iotcentral", version: "2021-11-01-preview"},
+	},
+	"azurerm_iotcentral_organization": {
+		{service: "iotcentral", version: "2021-11-01-preview"},
+		{service: "iotcentral", version: "2022-10-31-preview"},
+	},
+	"azurerm_iothub": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_certificate": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_consumer_group": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_device_update_account": {
+		{service: "deviceupdate", version: "2022-10-01"},
+	},
+	"azurerm_iothub_device_update_instance": {
+		{service: "deviceupdate", version: "2022-10-01"},
+	},
+	"azurerm_iothub_dps": {
+		{service: "deviceprovisioningservices", version: "2022-02-05"},
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_dps_certificate": {
+		{service: "deviceprovisioningservices", version: "2022-02-05"},
+	},
+	"azurerm_iothub_dps_shared_access_policy": {
+		{service: "deviceprovisioningservices", version: "2022-02-05"},
+	},
+	"azurerm_iothub_endpoint_cosmosdb_account": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_endpoint_eventhub": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_endpoint_servicebus_queue": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_endpoint_servicebus_topic": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_endpoint_storage_container": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_enrichment": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_fallback_route": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_file_upload": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_route": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_iothub_shared_access_policy": {
+		{service: "iothub", version: "2022-04-30-preview"},
+	},
+	"azurerm_ip_group": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_ip_group_cidr": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_key_vault": {
+		{service: "keyvault", version: "2023-02-01"},
+	},
+	"azurerm_key_vault_access_policy": {
+		{service: "keyvault", version: "2023-02-01"},
+	},
+	"azurerm_key_vault_certificate":          {},
+	"azurerm_key_vault_certificate_contacts": {},
+	"azurerm_key_vault_certificate_issuer":   {},
+	"azurerm_key_vault_key":                  {},
+	"azurerm_key_vault_managed_hardware_security_module": {
+		{service: "keyvault", version: "2023-07-01"},
+	},
+	"azurerm_key_vault_managed_hardware_security_module_key": {
+		{service: "keyvault", version: "2023-07-01"},
+	},
+	"azurerm_key_vault_managed_hardware_security_module_role_assignment": {
+		{service: "authorization", version: "2022-04-01"},
+		{service: "keyvault", version: "2023-07-01"},
+	},
+	"azurerm_key_vault_managed_hardware_security_module_role_definition": {
+		{service: "authorization", version: "2022-04-01"},
+		{service: "keyvault", version: "2023-07-01"},
+	},
+	"azurerm_key_vault_managed_storage_account":                      {},
+	"azurerm_key_vault_managed_storage_account_sas_token_definition": {},
+	"azurerm_key_vault_secret":                                       {},
+	"azurerm_kubernetes_cluster": {
+		{service: "containerservice", version: "2023-09-02-preview"},
+		{service: "dns", version: "2018-05-01"},
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_kubernetes_cluster_extension": {
+		{service: "kubernetesconfiguration", version: "2022-11-01"},
+	},
+	"azurerm_kubernetes_cluster_node_pool": {
+		{service: "compute", version: "2022-03-01"},
+		{service: "containerservice", version: "2023-09-02-preview"},
+		{service: "network", version: "2023-09-01"},
+	},
+	"azurerm_kubernetes_cluster_trusted_access_role_binding": {
+		{service: "containerservice", version: "2023-03-02-preview"},
+	},
+	"azurerm_kubernetes_fleet_manager": {
+		{service: "containerservice", version: "2024-04-01"},
+	},
+	"azurerm_kubernetes_fleet_member": {
+		{service: "containerservice", version: "2024-04-01"},
+	},
+	"azurerm_kubernetes_fleet_update_run": {
+		{service: "containerservice", version: "2024-04-01"},
+	},
+	"azurerm_kubernetes_fleet_update_strategy": {
+		{service: "containerservice", version: "2024-04-01"},
+	},
+	"azurerm_kubernetes_flux_configuration": {
+		{service: "kubernetesconfiguration", version: "2023-05-01"},
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_kusto_attached_database_configuration": {
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_kusto_cluster": {
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_kusto_cluster_customer_managed_key": {
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_kusto_cluster_managed_private_endpoint": {
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_kusto_cluster_principal_assignment": {
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_kusto_cosmosdb_data_connection": {
+		{service: "cosmosdb", version: "2024-05-15"},
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_kusto_database": {
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_kusto_database_principal_assignment": {
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_kusto_eventgrid_data_connection": {
+		{service: "eventgrid", version: "2022-06-15"},
+		{service: "eventhub", version: "2021-11-01"},
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_kusto_eventhub_data_connection": {
+		{service: "eventhub", version: "2021-11-01"},
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_kusto_iothub_data_connection": {
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_kusto_script": {
+		{service: "kusto", version: "2023-08-15"},
+	},
+	"azurerm_lab_service_lab": {
+		{service: "labservices", version: "2022-08-01"},
+	},
+	"azurerm_lab_service_plan": {
+		{service: "labservices", version: "2022-08-01"},
+	},
+	"azurerm_lab_service_schedule": {
+		{service: "labservices", version: "2022-08-01"},
+	},
+	"azurerm_lab_service_user": {
+		{service: "labservices", version: "2022-08-01"},
+	},
+	"azurerm_lb": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_lb_backend_address_pool": {
+		{service: "network", version: "2023-09-01"},
+	},
+	"azurerm_lb_backend_address_pool_address": {
+		{service: "network", version: "2023-09-01"},
+	},
+	"azurerm_lb_nat_pool": {
+		{service: "network", version: "2023-09-01"},
+	},
+	"azurerm_lb_nat_rule": {
+		{service: "network", version: "2023-09-01"},
+	},
+	"azurerm_lb_outbound_rule": {
+		{service: "network", version: "2023-09-01"},
+	},
+	"azurerm_lb_probe": {
+		{service: "network", version: "2023-09-01"},
+	},
+	"azurerm_lb_rule": {
+		{service: "network", version: "2023-09-01"},
+	},
+	"azurerm_lighthouse_assignment": {
+		{service: "managedservices", version: "2022-10-01"},
+	},
+	"azurerm_lighthouse_definition": {
+		{service: "managedservices", version: "2022-10-01"},
+	},
+	"azurerm_linux_function_app": {
+		{service: "web", version: "2022-09-01"},
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_linux_function_app_slot": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_linux_virtual_machine": {
+		{service: "compute", version: "2022-03-01"},
+		{service: "compute", version: "2023-04-02"},
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_linux_virtual_machine_scale_set": {
+		{service: "compute", version: "2022-03-01"},
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_linux_web_app": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_linux_web_app_slot": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_load_test": {
+		{service: "loadtestservice", version: "2022-12-01"},
+	},
+	"azurerm_local_network_gateway": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_log_analytics_cluster": {
+		{service: "operationalinsights", version: "2022-10-01"},
+	},
+	"azurerm_log_analytics_cluster_customer_managed_key": {
+		{service: "operationalinsights", version: "2022-10-01"},
+	},
+	"azurerm_log_analytics_data_export_rule": {
+		{service: "eventhub", version: "2021-11-01"},
+		{service: "eventhub", version: "2022-01-01-preview"},
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_log_analytics_datasource_windows_event": {
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_log_analytics_datasource_windows_performance_counter": {
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_log_analytics_linked_service": {
+		{service: "automation", version: "2022-08-08"},
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "operationalinsights", version: "2022-10-01"},
+	},
+	"azurerm_log_analytics_linked_storage_account": {
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_log_analytics_query_pack": {
+		{service: "operationalinsights", version: "2019-09-01"},
+	},
+	"azurerm_log_analytics_query_pack_query": {
+		{service: "operationalinsights", version: "2019-09-01"},
+	},
+	"azurerm_log_analytics_saved_search": {
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_log_analytics_solution": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "operationsmanagement", version: "2015-11-01-preview"},
+	},
+	"azurerm_log_analytics_storage_insights": {
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_log_analytics_workspace": {
+		{service: "insights", version: "2022-06-01"},
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "operationalinsights", version: "2022-10-01"},
+	},
+	"azurerm_log_analytics_workspace_table": {
+		{service: "operationalinsights", version: "2022-10-01"},
+	},
+	"azurerm_logic_app_action_custom": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_action_http": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_integration_account": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_integration_account_agreement": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_integration_account_assembly": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_integration_account_batch_configuration": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_integration_account_certificate": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_integration_account_map": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_integration_account_partner": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_integration_account_schema": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_integration_account_session": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_standard": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_logic_app_trigger_custom": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_trigger_http_request": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_trigger_recurrence": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logic_app_workflow": {
+		{service: "logic", version: "2019-05-01"},
+	},
+	"azurerm_logz_monitor": {
+		{service: "logz", version: "2020-10-01"},
+	},
+	"azurerm_logz_sub_account": {
+		{service: "logz", version: "2020-10-01"},
+	},
+	"azurerm_logz_sub_account_tag_rule": {
+		{service: "logz", version: "2020-10-01"},
+	},
+	"azurerm_logz_tag_rule": {
+		{service: "logz", version: "2020-10-01"},
+	},
+	"azurerm_machine_learning_compute_cluster": {
+		{service: "machinelearningservices", version: "2024-04-01"},
+	},
+	"azurerm_machine_learning_compute_instance": {
+		{service: "machinelearningservices", version: "2024-04-01"},
+	},
+	"azurerm_machine_learning_datastore_blobstorage": {
+		{service: "machinelearningservices", version: "2024-04-01"},
+	},
+	"azurerm_machine_learning_datastore_datalake_gen2": {
+		{service: "machinelearningservices", version: "2024-04-01"},
+	},
+	"azurerm_machine_learning_datastore_fileshare": {
+		{service: "machinelearningservices", version: "2024-04-01"},
+	},
+	"azurerm_machine_learning_inference_cluster": {
+		{service: "containerservice", version: "2023-09-02-preview"},
+		{service: "machinelearningservices", version: "2024-04-01"},
+	},
+	"azurerm_machine_learning_online_deployment": {
+		{service: "machinelearningservices", version: "2024-04-01"},
+	},
+	"azurerm_machine_learning_online_endpoint": {
+		{service: "machinelearningservices", version: "2024-04-01"},
+	},
+	"azurerm_machine_learning_registry": {
+		{service: "machinelearningservices", version: "2024-04-01"},
+	},
+	"azurerm_machine_learning_synapse_spark": {
+		{service: "machinelearningservices", version: "2024-04-01"},
+	},
+	"azurerm_machine_learning_workspace": {
+		{service: "applicationinsights", version: "2020-02-02"},
+		{service: "containerregistry", version: "2021-08-01-preview"},
+		{service: "machinelearningservices", version: "2024-04-01"},
+	},
+	"azurerm_maintenance_assignment_dedicated_host": {
+		{service: "maintenance", version: "2023-04-01"},
+	},
+	"azurerm_maintenance_assignment_dynamic_scope": {
+		{service: "maintenance", version: "2023-04-01"},
+	},
+	"azurerm_maintenance_assignment_virtual_machine": {
+		{service: "compute", version: "2024-03-01"},
+		{service: "maintenance", version: "2023-04-01"},
+	},
+	"azurerm_maintenance_assignment_virtual_machine_scale_set": {
+		{service: "maintenance", version: "2023-04-01"},
+	},
+	"azurerm_maintenance_configuration": {
+		{service: "maintenance", version: "2023-04-01"},
+	},
+	"azurerm_managed_application": {
+		{service: "managedapplications", version: "2021-07-01"},
+	},
+	"azurerm_managed_application_definition": {
+		{service: "managedapplications", version: "2021-07-01"},
+	},
+	"azurerm_managed_disk": {
+		{service: "compute", version: "2022-03-02"},
+		{service: "compute", version: "2023-04-02"},
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_managed_disk_sas_token": {
+		{service: "compute", version: "2023-04-02"},
+	},
+	"azurerm_managed_lustre_file_system": {
+		{service: "storagecache", version: "2024-03-01"},
+	},
+	"azurerm_management_group": {
+		{service: "resources", version: "2020-05-01"},
+	},
+	"azurerm_management_group_hierarchy_settings": {
+		{service: "resources", version: "2020-05-01"},
+	},
+	"azurerm_management_group_policy_assignment": {
+		{service: "resources", version: "2022-06-01"},
+	},
+	"azurerm_management_group_policy_exemption": {
+		{service: "resources", version: "2021-06-01-preview"},
+	},
+	"azurerm_management_group_policy_remediation": {
+		{service: "policyinsights", version: "2021-10-01"},
+	},
+	"azurerm_management_group_subscription_association": {
+		{service: "resources", version: "2020-05-01"},
+	},
+	"azurerm_management_group_template_deployment": {
+		{service: "resources", version: "2020-06-01"},
+	},
+	"azurerm_management_lock": {
+		{service: "resources", version: "2020-05-01"},
+	},
+	"azurerm_maps_account": {
+		{service: "maps", version: "2023-06-01"},
+	},
+	"azurerm_maps_creator": {
+		{service: "maps", version: "2023-06-01"},
+	},
+	"azurerm_mariadb_configuration": {
+		{service: "mariadb", version: "2018-06-01"},
+	},
+	"azurerm_mariadb_database": {
+		{service: "mariadb", version: "2018-06-01"},
+	},
+	"azurerm_mariadb_firewall_rule": {
+		{service: "mariadb", version: "2018-06-01"},
+	},
+	"azurerm_mariadb_server": {
+		{service: "mariadb", version: "2018-06-01"},
+	},
+	"azurerm_mariadb_virtual_network_rule": {
+		{service: "mariadb", version: "2018-06-01"},
+	},
+	"azurerm_marketplace_agreement": {
+		{service: "marketplaceordering", version: "2015-06-01"},
+	},
+	"azurerm_marketplace_role_assignment": {
+		{service: "authorization", version: "2022-04-01"},
+		{service: "authorization", version: "2022-05-01-preview"},
+	},
+	"azurerm_media_asset": {
+		{service: "media", version: "2022-08-01"},
+	},
+	"azurerm_media_asset_filter": {
+		{service: "media", version: "2022-08-01"},
+	},
+	"azurerm_media_content_key_policy": {
+		{service: "media", version: "2022-08-01"},
+	},
+	"azurerm_media_job": {
+		{service: "media", version: "2022-07-01"},
+	},
+	"azurerm_media_live_event": {
+		{service: "media", version: "2022-08-01"},
+	},
+	"azurerm_media_live_event_output": {
+		{service: "media", version: "2022-08-01"},
+	},
+	"azurerm_media_services_account": {
+		{service: "media", version: "2021-11-01"},
+	},
+	"azurerm_media_services_account_filter": {
+		{service: "media", version: "2022-08-01"},
+	},
+	"azurerm_media_streaming_endpoint": {
+		{service: "media", version: "2022-08-01"},
+	},
+	"azurerm_media_streaming_locator": {
+		{service: "media", version: "2022-08-01"},
+	},
+	"azurerm_media_streaming_policy": {
+		{service: "media", version: "2022-08-01"},
+	},
+	"azurerm_media_transform": {
+		{service: "media", version: "2022-07-01"},
+	},
+	"azurerm_mobile_network": {
+		{service: "mobilenetwork", version: "2022-11-01"},
+	},
+	"azurerm_mobile_network_attached_data_network": {
+		{service: "mobilenetwork", version: "2022-11-01"},
+	},
+	"azurerm_mobile_network_data_network": {
+		{service: "mobilenetwork", version: "2022-11-01"},
+	},
+	"azurerm_mobile_network_packet_core_control_plane": {
+		{service: "databoxedge", version: "2022-03-01"},
+		{service: "mobilenetwork", version: "2022-11-01"},
+		{service: "vmware", version: "2022-05-01"},
+	},
+	"azurerm_mobile_network_packet_core_data_plane": {
+		{service: "mobilenetwork", version: "2022-11-01"},
+	},
+	"azurerm_mobile_network_service": {
+		{service: "mobilenetwork", version: "2022-11-01"},
+	},
+	"azurerm_mobile_network_sim": {
+		{service: "mobilenetwork", version: "2022-11-01"},
+	},
+	"azurerm_mobile_network_sim_group": {
+		{service: "mobilenetwork", version: "2022-11-01"},
+	},
+	"azurerm_mobile_network_sim_policy": {
+		{service: "mobilenetwork", version: "2022-11-01"},
+	},
+	"azurerm_mobile_network_site": {
+		{service: "mobilenetwork", version: "2022-11-01"},
+	},
+	"azurerm_mobile_network_slice": {
+		{service: "mobilenetwork", version: "2022-11-01"},
+	},
+	"azurerm_monitor_aad_diagnostic_setting": {
+		{service: "azureactivedirectory", version: "2017-04-01"},
+		{service: "eventhub", version: "2021-11-01"},
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_monitor_action_group": {
+		{service: "automation", version: "2022-08-08"},
+		{service: "eventhub", version: "2021-11-01"},
+		{service: "insights", version: "2023-01-01"},
+	},
+	"azurerm_monitor_action_rule_action_group": {
+		{service: "alertsmanagement", version: "2019-05-05-preview"},
+	},
+	"azurerm_monitor_action_rule_suppression": {
+		{service: "alertsmanagement", version: "2019-05-05-preview"},
+	},
+	"azurerm_monitor_activity_log_alert": {
+		{service: "insights", version: "2020-10-01"},
+	},
+	"azurerm_monitor_alert_processing_rule_action_group": {
+		{service: "alertsmanagement", version: "2021-08-08"},
+	},
+	"azurerm_monitor_alert_processing_rule_suppression": {
+		{service: "alertsmanagement", version: "2021-08-08"},
+	},
+	"azurerm_monitor_alert_prometheus_rule_group": {
+		{service: "alertsmanagement", version: "2023-03-01"},
+	},
+	"azurerm_monitor_autoscale_setting": {
+		{service: "insights", version: "2022-10-01"},
+	},
+	"azurerm_monitor_data_collection_endpoint": {
+		{service: "insights", version: "2022-06-01"},
+	},
+	"azurerm_monitor_data_collection_rule": {
+		{service: "eventhub", version: "2021-11-01"},
+		{service: "insights", version: "2022-06-01"},
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_monitor_data_collection_rule_association": {
+		{service: "insights", version: "2022-06-01"},
+	},
+	"azurerm_monitor_diagnostic_setting": {
+		{service: "eventhub", version: "2021-11-01"},
+		{service: "insights", version: "2021-05-01-preview"},
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_monitor_log_profile": {
+		{service: "insights", version: "2016-03-01"},
+	},
+	"azurerm_monitor_metric_alert": {
+		{service: "applicationinsights", version: "2020-02-02"},
+		{service: "applicationinsights", version: "2022-06-15"},
+		{service: "insights", version: "2018-03-01"},
+	},
+	"azurerm_monitor_private_link_scope": {
+		{service: "insights", version: "2021-07-01-preview"},
+	},
+	"azurerm_monitor_private_link_scoped_service": {
+		{service: "applicationinsights", version: "2020-02-02"},
+		{service: "insights", version: "2019-10-17-preview"},
+		{service: "insights", version: "2022-06-01"},
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_monitor_scheduled_query_rules_alert": {
+		{service: "insights", version: "2018-04-16"},
+	},
+	"azurerm_monitor_scheduled_query_rules_alert_v2": {
+		{service: "insights", version: "2023-03-15-preview"},
+	},
+	"azurerm_monitor_scheduled_query_rules_log": {
+		{service: "insights", version: "2018-04-16"},
+	},
+	"azurerm_monitor_smart_detector_alert_rule": {
+		{service: "alertsmanagement", version: "2019-06-01"},
+	},
+	"azurerm_monitor_workspace": {
+		{service: "insights", version: "2023-04-03"},
+	},
+	"azurerm_mssql_database": {
+		{service: "maintenance", version: "2023-04-01"},
+		{service: "sql", version: "2023-02-01-preview"},
+	},
+	"azurerm_mssql_database_extended_auditing_policy":               {},
+	"azurerm_mssql_database_vulnerability_assessment_rule_baseline": {},
+	"azurerm_mssql_elasticpool": {
+		{service: "maintenance", version: "2023-04-01"},
+		{service: "sql", version: "2023-02-01-preview"},
+	},
+	"azurerm_mssql_failover_group": {
+		{service: "sql", version: "2023-02-01-preview"},
+	},
+	"azurerm_mssql_firewall_rule":    {},
+	"azurerm_mssql_job_agent":        {},
+	"azurerm_mssql_job_credential":   {},
+	"azurerm_mssql_managed_database": {},
+	"azurerm_mssql_managed_instance": {
+		{service: "maintenance", version: "2023-04-01"},
+	},
+	"azurerm_mssql_managed_instance_active_directory_administrator": {},
+	"azurerm_mssql_managed_instance_failover_group":                 {},
+	"azurerm_mssql_managed_instance_security_alert_policy":          {},
+	"azurerm_mssql_managed_instance_transparent_data_encryption":    {},
+	"azurerm_mssql_managed_instance_vulnerability_assessment":       {},
+	"azurerm_mssql_outbound_firewall_rule":                          {},
+	"azurerm_mssql_server": {
+		{service: "sql", version: "2023-02-01-preview"},
+	},
+	"azurerm_mssql_server_dns_alias":                         {},
+	"azurerm_mssql_server_extended_auditing_policy":          {},
+	"azurerm_mssql_server_microsoft_support_auditing_policy": {},
+	"azurerm_mssql_server_security_alert_policy":             {},
+	"azurerm_mssql_server_transparent_data_encryption":       {},
+	"azurerm_mssql_server_vulnerability_assessment":          {},
+	"azurerm_mssql_virtual_machine": {
+		{service: "compute", version: "2024-03-01"},
+		{service: "sqlvirtualmachine", version: "2023-10-01"},
+	},
+	"azurerm_mssql_virtual_machine_availability_group_listener": {
+		{service: "network", version: "2023-09-01"},
+		{service: "sqlvirtualmachine", version: "2023-10-01"},
+	},
+	"azurerm_mssql_virtual_machine_group": {
+		{service: "sqlvirtualmachine", version: "2023-10-01"},
+	},
+	"azurerm_mssql_virtual_network_rule": {},
+	"azurerm_mysql_active_directory_administrator": {
+		{service: "mysql", version: "2017-12-01"},
+	},
+	"azurerm_mysql_configuration": {
+		{service: "mysql", version: "2017-12-01"},
+	},
+	"azurerm_mysql_database": {
+		{service: "mysql", version: "2017-12-01"},
+	},
+	"azurerm_mysql_firewall_rule": {
+		{service: "mysql", version: "2017-12-01"},
+	},
+	"azurerm_mysql_flexible_database": {
+		{service: "mysql", version: "2022-01-01"},
+	},
+	"azurerm_mysql_flexible_server": {
+		{service: "mysql", version: "2022-01-01"},
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_mysql_flexible_server_active_directory_administrator": {
+		{service: "mysql", version: "2022-01-01"},
+	},
+	"azurerm_mysql_flexible_server_configuration": {
+		{service: "mysql", version: "2022-01-01"},
+	},
+	"azurerm_mysql_flexible_server_firewall_rule": {
+		{service: "mysql", version: "2022-01-01"},
+	},
+	"azurerm_mysql_server": {
+		{service: "mysql", version: "2017-12-01"},
+	},
+	"azurerm_mysql_server_key": {
+		{service: "mysql", version: "2017-12-01"},
+		{service: "mysql", version: "2020-01-01"},
+	},
+	"azurerm_mysql_virtual_network_rule": {
+		{service: "mysql", version: "2017-12-01"},
+	},
+	"azurerm_nat_gateway": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_nat_gateway_public_ip_association": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_nat_gateway_public_ip_prefix_association": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_netapp_account": {
+		{service: "netapp", version: "2023-05-01"},
+	},
+	"azurerm_netapp_account_encryption": {
+		{service: "netapp", version: "2023-05-01"},
+	},
+	"azurerm_netapp_pool": {
+		{service: "netapp", version: "2023-05-01"},
+	},
+	"azurerm_netapp_snapshot": {
+		{service: "netapp", version: "2023-05-01"},
+	},
+	"azurerm_netapp_snapshot_policy": {
+		{service: "netapp", version: "2023-05-01"},
+	},
+	"azurerm_netapp_volume": {
+		{service: "netapp", version: "2023-05-01"},
+	},
+	"azurerm_netapp_volume_group_sap_hana": {
+		{service: "netapp", version: "2023-05-01"},
+	},
+	"azurerm_netapp_volume_quota_rule": {
+		{service: "netapp", version: "2023-05-01"},
+	},
+	"azurerm_network_connection_monitor": {
+		{service: "hybridcompute", version: "2022-11-10"},
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_network_ddos_protection_plan": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_function_azure_traffic_collector": {
+		{service: "networkfunction", version: "2022-11-01"},
+	},
+	"azurerm_network_function_collector_policy": {
+		{service: "networkfunction", version: "2022-11-01"},
+	},
+	"azurerm_network_interface": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_interface_application_gateway_backend_address_pool_association": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_interface_application_security_group_association": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_interface_backend_address_pool_association": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_interface_nat_rule_association": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_interface_security_group_association": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_manager": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_manager_admin_rule": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_manager_admin_rule_collection": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_manager_connectivity_configuration": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_manager_deployment": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_manager_management_group_connection": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_manager_network_group": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_manager_scope_connection": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_manager_security_admin_configuration": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_manager_static_member": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_manager_subscription_connection": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_packet_capture": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_profile": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_security_group": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_security_rule": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_watcher": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_network_watcher_flow_log": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_new_relic_monitor": {
+		{service: "newrelic", version: "2022-07-01"},
+	},
+	"azurerm_new_relic_tag_rule": {
+		{service: "newrelic", version: "2022-07-01"},
+	},
+	"azurerm_nginx_certificate": {
+		{service: "nginx", version: "2024-01-01-preview"},
+	},
+	"azurerm_nginx_configuration": {
+		{service: "nginx", version: "2024-01-01-preview"},
+	},
+	"azurerm_nginx_deployment": {
+		{service: "nginx", version: "2024-01-01-preview"},
+	},
+	"azurerm_notification_hub": {
+		{service: "notificationhubs", version: "2023-09-01"},
+	},
+	"azurerm_notification_hub_authorization_rule": {
+		{service: "notificationhubs", version: "2017-04-01"},
+	},
+	"azurerm_notification_hub_namespace": {
+		{service: "notificationhubs", version: "2017-04-01"},
+	},
+	"azurerm_orbital_contact": {
+		{service: "orbital", version: "2022-11-01"},
+	},
+	"azurerm_orbital_contact_profile": {
+		{service: "orbital", version: "2022-11-01"},
+	},
+	"azurerm_orbital_spacecraft": {
+		{service: "orbital", version: "2022-11-01"},
+	},
+	"azurerm_orchestrated_virtual_machine_scale_set": {
+		{service: "compute", version: "2022-03-01"},
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_palo_alto_local_rulestack": {
+		{service: "paloaltonetworks", version: "2022-08-29"},
+	},
+	"azurerm_palo_alto_local_rulestack_certificate": {
+		{service: "paloaltonetworks", version: "2022-08-29"},
+	},
+	"azurerm_palo_alto_local_rulestack_fqdn_list": {
+		{service: "paloaltonetworks", version: "2022-08-29"},
+	},
+	"azurerm_palo_alto_local_rulestack_outbound_trust_certificate_association": {
+		{service: "paloaltonetworks", version: "2022-08-29"},
+	},
+	"azurerm_palo_alto_local_rulestack_outbound_untrust_certificate_association": {
+		{service: "paloaltonetworks", version: "2022-08-29"},
+	},
+	"azurerm_palo_alto_local_rulestack_prefix_list": {
+		{service: "paloaltonetworks", version: "2022-08-29"},
+	},
+	"azurerm_palo_alto_local_rulestack_rule": {
+		{service: "paloaltonetworks", version: "2022-08-29"},
+	},
+	"azurerm_palo_alto_next_generation_firewall_virtual_hub_local_rulestack": {
+		{service: "paloaltonetworks", version: "2022-08-29"},
+		{service: "paloaltonetworks", version: "2023-09-01"},
+	},
+	"azurerm_palo_alto_next_generation_firewall_virtual_hub_panorama": {
+		{service: "paloaltonetworks", version: "2023-09-01"},
+	},
+	"azurerm_palo_alto_next_generation_firewall_virtual_network_local_rulestack": {
+		{service: "paloaltonetworks", version: "2022-08-29"},
+		{service: "paloaltonetworks", version: "2023-09-01"},
+	},
+	"azurerm_palo_alto_next_generation_firewall_virtual_network_panorama": {
+		{service: "paloaltonetworks", version: "2023-09-01"},
+	},
+	"azurerm_palo_alto_virtual_network_appliance": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_pim_active_role_assignment": {
+		{service: "authorization", version: "2020-10-01"},
+	},
+	"azurerm_pim_eligible_role_assignment": {
+		{service: "authorization", version: "2020-10-01"},
+	},
+	"azurerm_point_to_site_vpn_gateway": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_policy_definition": {
+		{service: "resources", version: "2021-06-01-preview"},
+	},
+	"azurerm_policy_set_definition": {
+		{service: "resources", version: "2021-06-01-preview"},
+	},
+	"azurerm_policy_virtual_machine_configuration_assignment": {
+		{service: "guestconfiguration", version: "2020-06-25"},
+	},
+	"azurerm_portal_dashboard": {
+		{service: "portal", version: "2019-01-01-preview"},
+	},
+	"azurerm_portal_tenant_configuration": {
+		{service: "portal", version: "2019-01-01-preview"},
+	},
+	"azurerm_postgresql_active_directory_administrator": {
+		{service: "postgresql", version: "2017-12-01"},
+	},
+	"azurerm_postgresql_configuration": {
+		{service: "postgresql", version: "2017-12-01"},
+	},
+	"azurerm_postgresql_database": {
+		{service: "postgresql", version: "2017-12-01"},
+	},
+	"azurerm_postgresql_firewall_rule": {
+		{service: "postgresql", version: "2017-12-01"},
+	},
+	"azurerm_postgresql_flexible_server": {
+		{service: "postgresql", version: "2021-06-01"},
+		{service: "postgresql", version: "2023-06-01-preview"},
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_postgresql_flexible_server_active_directory_administrator": {
+		{service: "postgresql", version: "2022-12-01"},
+	},
+	"azurerm_postgresql_flexible_server_configuration": {
+		{service: "postgresql", version: "2021-06-01"},
+	},
+	"azurerm_postgresql_flexible_server_database": {
+		{service: "postgresql", version: "2022-12-01"},
+	},
+	"azurerm_postgresql_flexible_server_firewall_rule": {
+		{service: "postgresql", version: "2022-12-01"},
+	},
+	"azurerm_postgresql_server": {
+		{service: "postgresql", version: "2017-12-01"},
+	},
+	"azurerm_postgresql_server_key": {
+		{service: "postgresql", version: "2020-01-01"},
+	},
+	"azurerm_postgresql_virtual_network_rule": {
+		{service: "postgresql", version: "2017-12-01"},
+	},
+	"azurerm_powerbi_embedded": {
+		{service: "powerbidedicated", version: "2021-01-01"},
+	},
+	"azurerm_powerbi_embedded_auto_scale_vcore": {
+		{service: "powerbidedicated", version: "2021-01-01"},
+	},
+	"azurerm_private_dns_a_record": {
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_private_dns_aaaa_record": {
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_private_dns_cname_record": {
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_private_dns_mx_record": {
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_private_dns_ptr_record": {
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_private_dns_resolver": {
+		{service: "dnsresolver", version: "2022-07-01"},
+	},
+	"azurerm_private_dns_resolver_dns_forwarding_ruleset": {
+		{service: "dnsresolver", version: "2022-07-01"},
+	},
+	"azurerm_private_dns_resolver_forwarding_rule": {
+		{service: "dnsresolver", version: "2022-07-01"},
+	},
+	"azurerm_private_dns_resolver_inbound_endpoint": {
+		{service: "dnsresolver", version: "2022-07-01"},
+	},
+	"azurerm_private_dns_resolver_outbound_endpoint": {
+		{service: "dnsresolver", version: "2022-07-01"},
+	},
+	"azurerm_private_dns_resolver_virtual_network_link": {
+		{service: "dnsresolver", version: "2022-07-01"},
+	},
+	"azurerm_private_dns_srv_record": {
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_private_dns_txt_record": {
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_private_dns_zone": {
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_private_dns_zone_virtual_network_link": {
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_private_endpoint": {
+		{service: "mariadb", version: "2018-06-01"},
+		{service: "mysql", version: "2017-12-01"},
+		{service: "network", version: "2023-11-01"},
+		{service: "postgresql", version: "2017-12-01"},
+		{service: "privatedns", version: "2020-06-01"},
+		{service: "redis", version: "2024-03-01"},
+		{service: "signalr", version: "2023-02-01"},
+	},
+	"azurerm_private_endpoint_application_security_group_association": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_private_endpoint_private_dns_zone_group": {
+		{service: "network", version: "2023-11-01"},
+		{service: "privatedns", version: "2020-06-01"},
+	},
+	"azurerm_private_link_service": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_proximity_placement_group": {
+		{service: "compute", version: "2022-03-01"},
+	},
+	"azurerm_public_ip": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_public_ip_prefix": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_purview_account": {
+		{service: "purview", version: "2021-12-01"},
+	},
+	"azurerm_purview_account_kafka_configuration": {
+		{service: "eventhub", version: "2021-11-01"},
+		{service: "purview", version: "2021-12-01"},
+	},
+	"azurerm_quota": {
+		{service: "quota", version: "2023-02-01"},
+	},
+	"azurerm_recovery_services_vault": {
+		{service: "recoveryservices", version: "2024-01-01"},
+		{service: "recoveryservicesbackup", version: "2023-02-01"},
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_recovery_services_vault_resource_guard_association": {
+		{service: "dataprotection", version: "2024-04-01"},
+		{service: "recoveryservices", version: "2024-01-01"},
+		{service: "recoveryservicesbackup", version: "2023-02-01"},
+	},
+	"azurerm_redhat_openshift_cluster": {
+		{service: "redhatopenshift", version: "2023-09-04"},
+	},
+	"azurerm_redis_cache": {
+		{service: "redis", version: "2024-03-01"},
+	},
+	"azurerm_redis_cache_access_policy": {
+		{service: "redis", version: "2024-03-01"},
+	},
+	"azurerm_redis_cache_access_policy_assignment": {
+		{service: "redis", version: "2024-03-01"},
+	},
+	"azurerm_redis_enterprise_cluster": {
+		{service: "redisenterprise", version: "2023-10-01-preview"},
+	},
+	"azurerm_redis_enterprise_database": {
+		{service: "redisenterprise", version: "2023-07-01"},
+		{service: "redisenterprise", version: "2023-10-01-preview"},
+	},
+	"azurerm_redis_firewall_rule": {
+		{service: "redis", version: "2024-03-01"},
+	},
+	"azurerm_redis_linked_server": {
+		{service: "redis", version: "2024-03-01"},
+	},
+	"azurerm_relay_hybrid_connection": {
+		{service: "relay", version: "2021-11-01"},
+	},
+	"azurerm_relay_hybrid_connection_authorization_rule": {
+		{service: "relay", version: "2021-11-01"},
+	},
+	"azurerm_relay_namespace": {
+		{service: "relay", version: "2021-11-01"},
+	},
+	"azurerm_relay_namespace_authorization_rule": {
+		{service: "relay", version: "2021-11-01"},
+	},
+	"azurerm_resource_deployment_script_azure_cli": {
+		{service: "resources", version: "2020-10-01"},
+	},
+	"azurerm_resource_deployment_script_azure_power_shell": {
+		{service: "resources", version: "2020-10-01"},
+	},
+	"azurerm_resource_group": {
+		{service: "resources", version: "2020-05-01"},
+		{service: "resources", version: "2020-06-01"},
+	},
+	"azurerm_resource_group_cost_management_export": {
+		{service: "costmanagement", version: "2023-07-01-preview"},
+	},
+	"azurerm_resource_group_cost_management_view": {
+		{service: "costmanagement", version: "2022-10-01"},
+	},
+	"azurerm_resource_group_policy_assignment": {
+		{service: "resources", version: "2022-06-01"},
+	},
+	"azurerm_resource_group_policy_exemption": {
+		{service: "resources", version: "2021-06-01-preview"},
+	},
+	"azurerm_resource_group_policy_remediation": {
+		{service: "policyinsights", version: "2021-10-01"},
+	},
+	"azurerm_resource_group_template_deployment": {
+		{service: "resources", version: "2020-06-01"},
+	},
+	"azurerm_resource_management_private_link": {
+		{service: "resources", version: "2020-05-01"},
+	},
+	"azurerm_resource_management_private_link_association": {
+		{service: "resources", version: "2020-05-01"},
+	},
+	"azurerm_resource_policy_assignment": {
+		{service: "resources", version: "2022-06-01"},
+	},
+	"azurerm_resource_policy_exemption": {
+		{service: "resources", version: "2021-06-01-preview"},
+	},
+	"azurerm_resource_policy_remediation": {
+		{service: "policyinsights", version: "2021-10-01"},
+	},
+	"azurerm_resource_provider_registration": {
+		{service: "resources", version: "2021-07-01"},
+		{service: "resources", version: "2022-09-01"},
+	},
+	"azurerm_restore_point_collection": {
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_role_assignment": {
+		{service: "authorization", version: "2020-04-01-preview"},
+		{service: "authorization", version: "2022-05-01-preview"},
+		{service: "resources", version: "2022-12-01"},
+	},
+	"azurerm_role_definition": {
+		{service: "authorization", version: "2022-05-01-preview"},
+	},
+	"azurerm_role_management_policy": {
+		{service: "authorization", version: "2020-10-01"},
+	},
+	"azurerm_route": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_route_filter": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_route_map": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_route_server": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_route_server_bgp_connection": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_route_table": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_search_service": {
+		{service: "search", version: "2023-11-01"},
+	},
+	"azurerm_search_shared_private_link_service": {
+		{service: "search", version: "2023-11-01"},
+	},
+	"azurerm_security_center_assessment": {},
+	"azurerm_security_center_assessment_policy": {
+		{service: "security", version: "2021-06-01"},
+	},
+	"azurerm_security_center_auto_provisioning": {},
+	"azurerm_security_center_automation": {
+		{service: "security", version: "2019-01-01-preview"},
+	},
+	"azurerm_security_center_contact":                                         {},
+	"azurerm_security_center_server_vulnerability_assessment":                 {},
+	"azurerm_security_center_server_vulnerability_assessment_virtual_machine": {},
+	"azurerm_security_center_server_vulnerability_assessments_setting": {
+		{service: "security", version: "2023-05-01"},
+	},
+	"azurerm_security_center_setting": {
+		{service: "security", version: "2022-05-01"},
+	},
+	"azurerm_security_center_storage_defender": {
+		{service: "eventgrid", version: "2022-06-15"},
+		{service: "security", version: "2022-12-01-preview"},
+	},
+	"azurerm_security_center_subscription_pricing": {
+		{service: "security", version: "2023-01-01"},
+	},
+	"azurerm_security_center_workspace": {
+		{service: "operationalinsights", version: "2020-08-01"},
+	},
+	"azurerm_sentinel_alert_rule_anomaly_built_in": {
+		{service: "operationalinsights", version: "2022-10-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_alert_rule_anomaly_duplicate": {
+		{service: "operationalinsights", version: "2022-10-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_alert_rule_fusion": {
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_alert_rule_machine_learning_behavior_analytics": {
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_alert_rule_ms_security_incident": {
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_alert_rule_nrt": {
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_alert_rule_scheduled": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_alert_rule_threat_intelligence": {
+		{service: "operationalinsights", version: "2022-10-01"},
+		{service: "securityinsight", version: "2021-09-01-preview"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_automation_rule": {
+		{service: "logic", version: "2019-05-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_aws_cloud_trail": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_aws_s3": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_azure_active_directory": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_azure_advanced_threat_protection": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_azure_security_center": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_dynamics_365": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_iot": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_microsoft_cloud_app_security": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_microsoft_defender_advanced_threat_protection": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_microsoft_threat_intelligence": {
+		{service: "operationalinsights", version: "2022-10-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_microsoft_threat_protection": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_office_365": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_office_365_project": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_office_atp": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_office_irm": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_office_power_bi": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_threat_intelligence": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_data_connector_threat_intelligence_taxii": {
+		{service: "operationalinsights", version: "2022-10-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_log_analytics_workspace_onboarding": {
+		{service: "operationalinsights", version: "2022-10-01"},
+		{service: "securityinsights", version: "2022-11-01"},
+	},
+	"azurerm_sentinel_metadata": {
+		{service: "operationalinsights", version: "2022-10-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_threat_intelligence_indicator": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-10-01-preview"},
+	},
+	"azurerm_sentinel_watchlist": {
+		{service: "operationalinsights", version: "2020-08-01"},
+		{service: "securityinsights", version: "2022-11-01"},
+	},
+	"azurerm_sentinel_watchlist_item": {
+		{service: "securityinsights", version: "2022-11-01"},
+	},
+	"azurerm_service_fabric_cluster": {
+		{service: "servicefabric", version: "2021-06-01"},
+	},
+	"azurerm_service_fabric_managed_cluster": {
+		{service: "servicefabricmanagedcluster", version: "2021-05-01"},
+	},
+	"azurerm_service_plan": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_servicebus_namespace": {
+		{service: "servicebus", version: "2021-06-01-preview"},
+		{service: "servicebus", version: "2022-10-01-preview"},
+	},
+	"azurerm_servicebus_namespace_authorization_rule": {
+		{service: "servicebus", version: "2021-06-01-preview"},
+		{service: "servicebus", version: "2022-10-01-preview"},
+	},
+	"azurerm_servicebus_namespace_disaster_recovery_config": {
+		{service: "servicebus", version: "2021-06-01-preview"},
+		{service: "servicebus", version: "2022-10-01-preview"},
+	},
+	"azurerm_servicebus_namespace_network_rule_set": {
+		{service: "servicebus", version: "2022-10-01-preview"},
+	},
+	"azurerm_servicebus_queue": {
+		{service: "servicebus", version: "2021-06-01-preview"},
+		{service: "servicebus", version: "2022-10-01-preview"},
+	},
+	"azurerm_servicebus_queue_authorization_rule": {
+		{service: "servicebus", version: "2021-06-01-preview"},
+		{service: "servicebus", version: "2022-10-01-preview"},
+	},
+	"azurerm_servicebus_subscription": {
+		{service: "servicebus", version: "2021-06-01-preview"},
+	},
+	"azurerm_servicebus_subscription_rule": {
+		{service: "servicebus", version: "2021-06-01-preview"},
+	},
+	"azurerm_servicebus_topic": {
+		{service: "servicebus", version: "2021-06-01-preview"},
+		{service: "servicebus", version: "2022-10-01-preview"},
+	},
+	"azurerm_servicebus_topic_authorization_rule": {
+		{service: "servicebus", version: "2021-06-01-preview"},
+		{service: "servicebus", version: "2022-10-01-preview"},
+	},
+	"azurerm_shared_image": {
+		{service: "compute", version: "2022-03-03"},
+	},
+	"azurerm_shared_image_gallery": {
+		{service: "compute", version: "2022-03-03"},
+	},
+	"azurerm_shared_image_version": {
+		{service: "compute", version: "2022-03-01"},
+		{service: "compute", version: "2022-03-03"},
+	},
+	"azurerm_signalr_service": {
+		{service: "signalr", version: "2024-03-01"},
+	},
+	"azurerm_signalr_service_custom_certificate": {
+		{service: "signalr", version: "2024-03-01"},
+	},
+	"azurerm_signalr_service_custom_domain": {
+		{service: "signalr", version: "2024-03-01"},
+	},
+	"azurerm_signalr_service_network_acl": {
+		{service: "network", version: "2023-09-01"},
+		{service: "signalr", version: "2024-03-01"},
+	},
+	"azurerm_signalr_service_replica": {
+		{service: "signalr", version: "2024-03-01"},
+	},
+	"azurerm_signalr_shared_private_link_resource": {
+		{service: "signalr", version: "2024-03-01"},
+	},
+	"azurerm_site_recovery_fabric": {
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_hyperv_network_mapping": {
+		{service: "recoveryservices", version: "2024-01-01"},
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_hyperv_replication_policy": {
+		{service: "recoveryservices", version: "2024-01-01"},
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_hyperv_replication_policy_association": {
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_network_mapping": {
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_protection_container": {
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_protection_container_mapping": {
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_replicated_vm": {
+		{service: "compute", version: "2022-03-01"},
+		{service: "compute", version: "2023-04-02"},
+		{service: "compute", version: "2024-03-01"},
+		{service: "recoveryservices", version: "2018-07-10"},
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_replication_policy": {
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_replication_recovery_plan": {
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_services_vault_hyperv_site": {
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_vmware_replicated_vm": {
+		{service: "compute", version: "2022-03-01"},
+		{service: "migrate", version: "2020-01-01"},
+		{service: "recoveryservices", version: "2018-07-10"},
+		{service: "recoveryservices", version: "2024-01-01"},
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_vmware_replication_policy": {
+		{service: "recoveryservices", version: "2024-01-01"},
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_site_recovery_vmware_replication_policy_association": {
+		{service: "recoveryservices", version: "2024-01-01"},
+		{service: "recoveryservicessiterecovery", version: "2022-10-01"},
+	},
+	"azurerm_snapshot": {
+		{service: "compute", version: "2022-03-02"},
+	},
+	"azurerm_source_control_token": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_spatial_anchors_account": {
+		{service: "mixedreality", version: "2021-01-01"},
+	},
+	"azurerm_spring_cloud_accelerator": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_active_deployment": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_api_portal": {
+		{service: "appplatform", version: "2024-01-01-preview"},
+	},
+	"azurerm_spring_cloud_api_portal_custom_domain": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_app": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_app_cosmosdb_association": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_app_dynamics_application_performance_monitoring": {
+		{service: "appplatform", version: "2024-01-01-preview"},
+	},
+	"azurerm_spring_cloud_app_mysql_association": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+		{service: "mysql", version: "2017-12-01"},
+		{service: "mysql", version: "2022-01-01"},
+	},
+	"azurerm_spring_cloud_app_redis_association": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+		{service: "redis", version: "2024-03-01"},
+	},
+	"azurerm_spring_cloud_application_insights_application_performance_monitoring": {
+		{service: "appplatform", version: "2024-01-01-preview"},
+	},
+	"azurerm_spring_cloud_application_live_view": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_build_deployment": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+		{service: "appplatform", version: "2024-01-01-preview"},
+	},
+	"azurerm_spring_cloud_build_pack_binding": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_builder": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_certificate": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_configuration_service": {
+		{service: "appplatform", version: "2024-01-01-preview"},
+	},
+	"azurerm_spring_cloud_connection": {
+		{service: "servicelinker", version: "2022-05-01"},
+		{service: "servicelinker", version: "2024-04-01"},
+	},
+	"azurerm_spring_cloud_container_deployment": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+		{service: "appplatform", version: "2024-01-01-preview"},
+	},
+	"azurerm_spring_cloud_custom_domain": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_customized_accelerator": {
+		{service: "appplatform", version: "2024-01-01-preview"},
+	},
+	"azurerm_spring_cloud_dev_tool_portal": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_dynatrace_application_performance_monitoring": {
+		{service: "appplatform", version: "2024-01-01-preview"},
+	},
+	"azurerm_spring_cloud_elastic_application_performance_monitoring": {
+		{service: "appplatform", version: "2024-01-01-preview"},
+	},
+	"azurerm_spring_cloud_gateway": {
+		{service: "appplatform", version: "2024-01-01-preview"},
+	},
+	"azurerm_spring_cloud_gateway_custom_domain": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_gateway_route_config": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_java_deployment": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_new_relic_application_performance_monitoring": {
+		{service: "appplatform", version: "2024-01-01-preview"},
+	},
+	"azurerm_spring_cloud_service": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_spring_cloud_storage": {
+		{service: "appplatform", version: "2023-05-01-preview"},
+	},
+	"azurerm_sql_active_directory_administrator": {},
+	"azurerm_sql_database": {
+		{service: "sql", version: "2017-03-01-preview"},
+	},
+	"azurerm_sql_elasticpool": {
+		{service: "sql", version: "2017-03-01-preview"},
+	},
+	"azurerm_sql_failover_group": {
+		{service: "sql", version: "2017-03-01-preview"},
+	},
+	"azurerm_sql_firewall_rule": {
+		{service: "sql", version: "2017-03-01-preview"},
+	},
+	"azurerm_sql_managed_database": {
+		{service: "sql", version: "2018-06-01-preview"},
+	},
+	"azurerm_sql_managed_instance":                                {},
+	"azurerm_sql_managed_instance_active_directory_administrator": {},
+	"azurerm_sql_managed_instance_failover_group":                 {},
+	"azurerm_sql_server": {
+		{service: "sql", version: "2017-03-01-preview"},
+	},
+	"azurerm_sql_virtual_network_rule": {
+		{service: "sql", version: "2017-03-01-preview"},
+	},
+	"azurerm_ssh_public_key": {
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_stack_hci_cluster": {
+		{service: "automanage", version: "2022-05-04"},
+		{service: "azurestackhci", version: "2024-01-01"},
+	},
+	"azurerm_stack_hci_deployment_setting": {
+		{service: "azurestackhci", version: "2024-01-01"},
+		{service: "hybridcompute", version: "2022-11-10"},
+	},
+	"azurerm_stack_hci_logical_network": {
+		{service: "azurestackhci", version: "2024-01-01"},
+		{service: "extendedlocation", version: "2021-08-15"},
+	},
+	"azurerm_stack_hci_marketplace_gallery_image": {
+		{service: "azurestackhci", version: "2024-01-01"},
+		{service: "extendedlocation", version: "2021-08-15"},
+	},
+	"azurerm_static_site": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_static_site_custom_domain": {
+		{service: "web", version: "2021-02-01"},
+	},
+	"azurerm_static_web_app": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_static_web_app_custom_domain": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_static_web_app_function_app_registration": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_storage_account": {
+		{service: "storage", version: "2023-01-01"},
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_storage_account_customer_managed_key": {
+		{service: "keyvault", version: "2023-07-01"},
+		{service: "storage", version: "2023-01-01"},
+	},
+	"azurerm_storage_account_local_user": {
+		{service: "storage", version: "2023-01-01"},
+	},
+	"azurerm_storage_account_network_rules": {
+		{service: "storage", version: "2023-01-01"},
+	},
+	"azurerm_storage_blob": {
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_storage_blob_inventory_policy": {
+		{service: "storage", version: "2023-01-01"},
+	},
+	"azurerm_storage_container": {
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_storage_container_immutability_policy": {
+		{service: "storage", version: "2023-01-01"},
+	},
+	"azurerm_storage_data_lake_gen2_filesystem": {
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_storage_data_lake_gen2_path": {
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_storage_encryption_scope": {
+		{service: "storage", version: "2023-01-01"},
+	},
+	"azurerm_storage_management_policy": {
+		{service: "storage", version: "2023-01-01"},
+	},
+	"azurerm_storage_mover": {
+		{service: "storagemover", version: "2023-03-01"},
+	},
+	"azurerm_storage_mover_agent": {
+		{service: "storagemover", version: "2023-03-01"},
+	},
+	"azurerm_storage_mover_job_definition": {
+		{service: "storagemover", version: "2023-03-01"},
+	},
+	"azurerm_storage_mover_project": {
+		{service: "storagemover", version: "2023-03-01"},
+	},
+	"azurerm_storage_mover_source_endpoint": {
+		{service: "storagemover", version: "2023-03-01"},
+	},
+	"azurerm_storage_mover_target_endpoint": {
+		{service: "storagemover", version: "2023-03-01"},
+	},
+	"azurerm_storage_object_replication": {
+		{service: "storage", version: "2023-01-01"},
+	},
+	"azurerm_storage_queue": {
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_storage_share": {
+		{service: "storage", version: "2023-01-01"},
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_storage_share_directory": {
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_storage_share_file": {
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_storage_sync": {
+		{service: "storagesync", version: "2020-03-01"},
+	},
+	"azurerm_storage_sync_cloud_endpoint": {
+		{service: "storagesync", version: "2020-03-01"},
+	},
+	"azurerm_storage_sync_group": {
+		{service: "storagesync", version: "2020-03-01"},
+	},
+	"azurerm_storage_sync_server_endpoint": {
+		{service: "storagesync", version: "2020-03-01"},
+	},
+	"azurerm_storage_table": {
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_storage_table_entity": {
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_stream_analytics_cluster": {
+		{service: "streamanalytics", version: "2020-03-01"},
+	},
+	"azurerm_stream_analytics_function_javascript_uda": {
+		{service: "streamanalytics", version: "2020-03-01"},
+	},
+	"azurerm_stream_analytics_function_javascript_udf": {
+		{service: "streamanalytics", version: "2020-03-01"},
+	},
+	"azurerm_stream_analytics_job": {
+		{service: "streamanalytics", version: "2020-03-01"},
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_job_schedule": {
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_managed_private_endpoint": {
+		{service: "streamanalytics", version: "2020-03-01"},
+	},
+	"azurerm_stream_analytics_output_blob": {
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_output_cosmosdb": {
+		{service: "streamanalytics", version: "2020-03-01"},
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_output_eventhub": {
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_output_function": {
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_output_mssql": {
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_output_powerbi": {
+		{service: "streamanalytics", version: "2020-03-01"},
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_output_servicebus_queue": {
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_output_servicebus_topic": {
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_output_synapse": {
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_output_table": {
+		{service: "streamanalytics", version: "2021-10-01-preview"},
+	},
+	"azurerm_stream_analytics_reference_input_blob": {
+		{service: "streamanalytics", version: "2020-03-01"},
+	},
+	"azurerm_stream_analytics_reference_input_mssql": {
+		{service: "streamanalytics", version: "2020-03-01"},
+	},
+	"azurerm_stream_analytics_stream_input_blob": {
+		{service: "streamanalytics", version: "2020-03-01"},
+	},
+	"azurerm_stream_analytics_stream_input_eventhub": {
+		{service: "streamanalytics", version: "2020-03-01"},
+	},
+	"azurerm_stream_analytics_stream_input_eventhub_v2": {
+		{service: "streamanalytics", version: "2020-03-01"},
+	},
+	"azurerm_stream_analytics_stream_input_iothub": {
+		{service: "streamanalytics", version: "2020-03-01"},
+	},
+	"azurerm_subnet": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_subnet_nat_gateway_association": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_subnet_network_security_group_association": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_subnet_route_table_association": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_subnet_service_endpoint_storage_policy": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_subscription": {
+		{service: "resources", version: "2022-12-01"},
+		{service: "resources", version: "2023-07-01"},
+		{service: "subscription", version: "2021-10-01"},
+	},
+	"azurerm_subscription_cost_management_export": {
+		{service: "costmanagement", version: "2023-07-01-preview"},
+	},
+	"azurerm_subscription_cost_management_view": {
+		{service: "costmanagement", version: "2022-10-01"},
+	},
+	"azurerm_subscription_policy_assignment": {
+		{service: "resources", version: "2022-06-01"},
+	},
+	"azurerm_subscription_policy_exemption": {
+		{service: "resources", version: "2021-06-01-preview"},
+	},
+	"azurerm_subscription_policy_remediation": {
+		{service: "policyinsights", version: "2021-10-01"},
+	},
+	"azurerm_subscription_template_deployment": {
+		{service: "resources", version: "2020-06-01"},
+	},
+	"azurerm_synapse_firewall_rule":                   {},
+	"azurerm_synapse_integration_runtime_azure":       {},
+	"azurerm_synapse_integration_runtime_self_hosted": {},
+	"azurerm_synapse_linked_service": {
+		{service: "synapse", version: "2021-06-01-preview"},
+	},
+	"azurerm_synapse_managed_private_endpoint": {
+		{service: "synapse", version: "2019-06-01-preview"},
+	},
+	"azurerm_synapse_private_link_hub": {},
+	"azurerm_synapse_role_assignment": {
+		{service: "synapse", version: "2020-08-01-preview"},
+	},
+	"azurerm_synapse_spark_pool": {
+		{service: "synapse", version: "2021-06-01-preview"},
+	},
+	"azurerm_synapse_sql_pool":                                   {},
+	"azurerm_synapse_sql_pool_extended_auditing_policy":          {},
+	"azurerm_synapse_sql_pool_security_alert_policy":             {},
+	"azurerm_synapse_sql_pool_vulnerability_assessment":          {},
+	"azurerm_synapse_sql_pool_vulnerability_assessment_baseline": {},
+	"azurerm_synapse_sql_pool_workload_classifier":               {},
+	"azurerm_synapse_sql_pool_workload_group":                    {},
+	"azurerm_synapse_workspace": {
+		{service: "purview", version: "2021-07-01"},
+	},
+	"azurerm_synapse_workspace_aad_admin":                {},
+	"azurerm_synapse_workspace_extended_auditing_policy": {},
+	"azurerm_synapse_workspace_key":                      {},
+	"azurerm_synapse_workspace_security_alert_policy":    {},
+	"azurerm_synapse_workspace_sql_aad_admin":            {},
+	"azurerm_synapse_workspace_vulnerability_assessment": {},
+	"azurerm_system_center_virtual_machine_manager_availability_set": {
+		{service: "extendedlocation", version: "2021-08-15"},
+		{service: "systemcentervirtualmachinemanager", version: "2023-10-07"},
+	},
+	"azurerm_system_center_virtual_machine_manager_cloud": {
+		{service: "extendedlocation", version: "2021-08-15"},
+		{service: "systemcentervirtualmachinemanager", version: "2023-10-07"},
+	},
+	"azurerm_system_center_virtual_machine_manager_server": {
+		{service: "extendedlocation", version: "2021-08-15"},
+		{service: "systemcentervirtualmachinemanager", version: "2023-10-07"},
+	},
+	"azurerm_system_center_virtual_machine_manager_virtual_machine_template": {
+		{service: "extendedlocation", version: "2021-08-15"},
+		{service: "systemcentervirtualmachinemanager", version: "2023-10-07"},
+	},
+	"azurerm_system_center_virtual_machine_manager_virtual_network": {
+		{service: "extendedlocation", version: "2021-08-15"},
+		{service: "systemcentervirtualmachinemanager", version: "2023-10-07"},
+	},
+	"azurerm_template_deployment": {
+		{service: "resources", version: "2020-06-01"},
+	},
+	"azurerm_template_spec": {
+		{service: "resources", version: "2022-02-01"},
+	},
+	"azurerm_template_spec_version": {
+		{service: "resources", version: "2022-02-01"},
+	},
+	"azurerm_tenant_template_deployment": {
+		{service: "resources", version: "2020-06-01"},
+	},
+	"azurerm_traffic_manager_azure_endpoint": {
+		{service: "trafficmanager", version: "2022-04-01"},
+	},
+	"azurerm_traffic_manager_external_endpoint": {
+		{service: "trafficmanager", version: "2022-04-01"},
+	},
+	"azurerm_traffic_manager_nested_endpoint": {
+		{service: "trafficmanager", version: "2022-04-01"},
+	},
+	"azurerm_traffic_manager_profile": {
+		{service: "trafficmanager", version: "2022-04-01"},
+	},
+	"azurerm_user_assigned_identity": {
+		{service: "managedidentity", version: "2023-01-31"},
+	},
+	"azurerm_video_analyzer": {
+		{service: "videoanalyzer", version: "2021-05-01-preview"},
+	},
+	"azurerm_video_analyzer_edge_module": {
+		{service: "videoanalyzer", version: "2021-05-01-preview"},
+	},
+	"azurerm_virtual_desktop_application": {
+		{service: "desktopvirtualization", version: "2022-02-10-preview"},
+	},
+	"azurerm_virtual_desktop_application_group": {
+		{service: "desktopvirtualization", version: "2022-02-10-preview"},
+	},
+	"azurerm_virtual_desktop_host_pool": {
+		{service: "desktopvirtualization", version: "2022-02-10-preview"},
+	},
+	"azurerm_virtual_desktop_host_pool_registration_info": {
+		{service: "desktopvirtualization", version: "2022-02-10-preview"},
+	},
+	"azurerm_virtual_desktop_scaling_plan": {
+		{service: "desktopvirtualization", version: "2022-02-10-preview"},
+	},
+	"azurerm_virtual_desktop_scaling_plan_host_pool_association": {
+		{service: "desktopvirtualization", version: "2022-02-10-preview"},
+	},
+	"azurerm_virtual_desktop_workspace": {
+		{service: "desktopvirtualization", version: "2022-02-10-preview"},
+	},
+	"azurerm_virtual_desktop_workspace_application_group_association": {
+		{service: "desktopvirtualization", version: "2022-02-10-preview"},
+	},
+	"azurerm_virtual_hub": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_hub_bgp_connection": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_hub_connection": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_hub_ip": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_hub_route_table": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_hub_route_table_route": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_hub_routing_intent": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_hub_security_partner_provider": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_machine": {
+		{service: "compute", version: "2023-04-02"},
+		{service: "compute", version: "2024-03-01"},
+		{service: "network", version: "2023-11-01"},
+		{service: "storage", version: "2023-11-03"},
+	},
+	"azurerm_virtual_machine_automanage_configuration_assignment": {
+		{service: "automanage", version: "2022-05-04"},
+	},
+	"azurerm_virtual_machine_data_disk_attachment": {
+		{service: "compute", version: "2023-04-02"},
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_virtual_machine_extension": {
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_virtual_machine_gallery_application_assignment": {
+		{service: "compute", version: "2022-03-03"},
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_virtual_machine_implicit_data_disk_from_source": {
+		{service: "compute", version: "2022-03-02"},
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_virtual_machine_packet_capture": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_machine_restore_point": {
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_virtual_machine_restore_point_collection": {
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_virtual_machine_run_command": {
+		{service: "compute", version: "2023-03-01"},
+	},
+	"azurerm_virtual_machine_scale_set": {
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_virtual_machine_scale_set_extension": {
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_virtual_machine_scale_set_packet_capture": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_network": {
+		{service: "network", version: "2023-09-01"},
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_network_dns_servers": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_network_gateway": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_network_gateway_connection": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_network_gateway_nat_rule": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_network_peering": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_virtual_wan": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_vmware_cluster": {
+		{service: "vmware", version: "2022-05-01"},
+	},
+	"azurerm_vmware_express_route_authorization": {
+		{service: "vmware", version: "2022-05-01"},
+	},
+	"azurerm_vmware_hcx_enterprise_site": {
+		{service: "vmware", version: "2022-05-01"},
+	},
+	"azurerm_vmware_netapp_volume_attachment": {
+		{service: "vmware", version: "2022-05-01"},
+	},
+	"azurerm_vmware_private_cloud": {
+		{service: "vmware", version: "2022-05-01"},
+	},
+	"azurerm_vmware_vm_host_placement_policy": {
+		{service: "vmware", version: "2022-05-01"},
+	},
+	"azurerm_vmware_vm_vm_placement_policy": {
+		{service: "vmware", version: "2022-05-01"},
+	},
+	"azurerm_voice_services_communications_gateway": {
+		{service: "voiceservices", version: "2023-04-03"},
+	},
+	"azurerm_voice_services_communications_gateway_test_line": {
+		{service: "voiceservices", version: "2023-04-03"},
+	},
+	"azurerm_vpn_gateway": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_vpn_gateway_connection": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_vpn_gateway_nat_rule": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_vpn_server_configuration": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_vpn_server_configuration_policy_group": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_vpn_site": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_wait_for_propagation": {},
+	"azurerm_web_app_active_slot": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_web_app_hybrid_connection": {
+		{service: "relay", version: "2021-11-01"},
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_web_application_firewall_policy": {
+		{service: "network", version: "2023-11-01"},
+	},
+	"azurerm_web_pubsub": {
+		{service: "webpubsub", version: "2024-03-01"},
+	},
+	"azurerm_web_pubsub_custom_certificate": {
+		{service: "webpubsub", version: "2024-03-01"},
+	},
+	"azurerm_web_pubsub_custom_domain": {
+		{service: "webpubsub", version: "2024-03-01"},
+	},
+	"azurerm_web_pubsub_hub": {
+		{service: "webpubsub", version: "2024-03-01"},
+	},
+	"azurerm_web_pubsub_network_acl": {
+		{service: "network", version: "2023-09-01"},
+		{service: "webpubsub", version: "2024-03-01"},
+	},
+	"azurerm_web_pubsub_replica": {
+		{service: "webpubsub", version: "2024-03-01"},
+	},
+	"azurerm_web_pubsub_shared_private_link_resource": {
+		{service: "webpubsub", version: "2024-03-01"},
+	},
+	"azurerm_windows_function_app": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_windows_function_app_slot": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_windows_virtual_machine": {
+		{service: "compute", version: "2022-03-01"},
+		{service: "compute", version: "2023-04-02"},
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_windows_virtual_machine_scale_set": {
+		{service: "compute", version: "2022-03-01"},
+		{service: "compute", version: "2024-03-01"},
+	},
+	"azurerm_windows_web_app": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_windows_web_app_slot": {
+		{service: "web", version: "2023-01-01"},
+	},
+	"azurerm_workloads_sap_discovery_virtual_instance": {
+		{service: "workloads", version: "2023-04-01"},
+	},
+	"azurerm_workloads_sap_single_node_virtual_instance": {
+		{service: "workloads", version: "2023-04-01"},
+	},
+	"azurerm_workloads_sap_three_tier_virtual_instance": {
+		{service: "workloads", version: "2023-04-01"},
+	},
+}
@@ -7,14 +7,17 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	resourcegraph "github.com/hashicorp/go-azure-sdk/resource-manager/resourcegraph/2024-04-01/resources"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/privatelinkassociation"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/resourcemanagementprivatelink"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2021-07-01/features"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecversions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	genericresources "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -22,11 +25,15 @@ import (
 type Client struct {
 	DeploymentScriptsClient             *deploymentscripts.DeploymentScriptsClient
 	FeaturesClient                      *features.FeaturesClient
+	GenericResourcesClient              *genericresources.ResourcesClient
 	LocksClient                         *managementlocks.ManagementLocksClient
 	PrivateLinkAssociationClient        *privatelinkassociation.PrivateLinkAssociationClient
+	ResourceGraphClient                 *resourcegraph.ResourcesClient
+	ResourceGraphRefreshCache           *ResourceGraphRefreshCache
 	ResourceGroupsClient                *resourcegroups.ResourceGroupsClient
 	ResourceManagementPrivateLinkClient *resourcemanagementprivatelink.ResourceManagementPrivateLinkClient
 	ResourceProvidersClient             *providers.ProvidersClient
+	TemplateSpecsClient                 *templatespecs.TemplateSpecsClient
 	TemplateSpecsVersionsClient         *templatespecversions.TemplateSpecVersionsClient
 	TagsClient                          *tags.TagsClient
 
@@ -53,6 +60,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(featuresClient.Client, o.Authorizers.ResourceManager)
 
+	genericResourcesClient, err := genericresources.NewResourcesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Resources client: %+v", err)
+	}
+	o.Configure(genericResourcesClient.Client, o.Authorizers.ResourceManager)
+
+	resourceGraphClient, err := resourcegraph.NewResourcesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ResourceGraph client: %+v", err)
+	}
+	o.Configure(resourceGraphClient.Client, o.Authorizers.ResourceManager)
+
 	resourceGroupsClient, err := resourcegroups.NewResourceGroupsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Features client: %+v", err)
@@ -83,6 +102,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(resourceProvidersClient.Client, o.Authorizers.ResourceManager)
 
+	templateSpecsClient, err := templatespecs.NewTemplateSpecsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building TemplateSpecs client: %+v", err)
+	}
+	o.Configure(templateSpecsClient.Client, o.Authorizers.ResourceManager)
+
 	templateSpecsVersionsClient, err := templatespecversions.NewTemplateSpecVersionsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building TemplateSpecVersions client: %+v", err)
@@ -103,16 +128,26 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	resourcesClient := resources.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&resourcesClient.Client, o.ResourceManagerAuthorizer)
 
+	// the Resource Graph Refresh Cache is only used when opted into via the `resource_graph_refresh` features block
+	var resourceGraphRefreshCache *ResourceGraphRefreshCache
+	if o.Features.ResourceGraphRefresh.Enabled {
+		resourceGraphRefreshCache = NewResourceGraphRefreshCache(resourceGraphClient, o.SubscriptionId, o.Features.ResourceGraphRefresh.LookbackHours)
+	}
+
 	return &Client{
 		// These come from `hashicorp/go-azure-sdk`
 		DeploymentsClient:                   &deploymentsClient,
 		DeploymentScriptsClient:             deploymentScriptsClient,
 		FeaturesClient:                      featuresClient,
+		GenericResourcesClient:              genericResourcesClient,
 		LocksClient:                         locksClient,
 		PrivateLinkAssociationClient:        privateLinkAssociationClient,
 		ResourceManagementPrivateLinkClient: resourceManagementPrivateLinkClient,
+		ResourceGraphClient:                 resourceGraphClient,
+		ResourceGraphRefreshCache:           resourceGraphRefreshCache,
 		ResourceGroupsClient:                resourceGroupsClient,
 		ResourceProvidersClient:             resourceProvidersClient,
+		TemplateSpecsClient:                 templateSpecsClient,
 		TemplateSpecsVersionsClient:         templateSpecsVersionsClient,
 		TagsClient:                          tagsClient,
 
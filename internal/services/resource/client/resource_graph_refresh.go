@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	resourcegraph "github.com/hashicorp/go-azure-sdk/resource-manager/resourcegraph/2024-04-01/resources"
+)
+
+// changeTimeMargin is subtracted from the time a resource was last read, to account for any clock skew between the
+// machine running Terraform and the timestamps of the changes recorded by Resource Graph
+const changeTimeMargin = 5 * time.Minute
+
+// ResourceGraphRefreshCache determines which resources haven't changed since they were last read using Azure Resource
+// Graph, which allows the Read of these resources to be skipped during a refresh.
+//
+// Resource Graph is queried once (on first use) for both the resources which exist within the Subscription and the
+// time each resource last changed within the lookback period - a resource is only considered unchanged when it exists
+// and neither it nor any of its child resources have changed since it was last read. Since changes are only known
+// within the lookback period, a resource which was last read before the lookback period is always considered changed.
+type ResourceGraphRefreshCache struct {
+	client         *resourcegraph.ResourcesClient
+	subscriptionId string
+	lookbackHours  int
+
+	once        sync.Once
+	populated   bool
+	windowStart time.Time
+	existing    map[string]struct{}
+	lastChanged map[string]time.Time
+}
+
+func NewResourceGraphRefreshCache(client *resourcegraph.ResourcesClient, subscriptionId string, lookbackHours int) *ResourceGraphRefreshCache {
+	return &ResourceGraphRefreshCache{
+		client:         client,
+		subscriptionId: subscriptionId,
+		lookbackHours:  lookbackHours,
+	}
+}
+
+// IsUnchangedSince returns whether the resource with the specified ID exists and hasn't changed since `lastRead`
+func (c *ResourceGraphRefreshCache) IsUnchangedSince(ctx context.Context, id string, lastRead time.Time) bool {
+	c.once.Do(func() {
+		windowStart := time.Now().Add(-time.Duration(c.lookbackHours) * time.Hour)
+		existing, lastChanged, err := c.populate(ctx)
+		if err != nil {
+			// falling back to retrieving every resource is slower, but otherwise harmless
+			log.Printf("[WARN] determining the unchanged resources using Resource Graph, all resources will be refreshed: %+v", err)
+			return
+		}
+
+		c.windowStart = windowStart
+		c.existing = existing
+		c.lastChanged = lastChanged
+		c.populated = true
+	})
+
+	if !c.populated {
+		return false
+	}
+
+	return c.isUnchangedSince(id, lastRead)
+}
+
+func (c *ResourceGraphRefreshCache) isUnchangedSince(id string, lastRead time.Time) bool {
+	since := lastRead.Add(-changeTimeMargin)
+
+	// changes made before the lookback period aren't known, so the resource has to be read
+	if !since.After(c.windowStart) {
+		return false
+	}
+
+	id = strings.ToLower(id)
+	if _, ok := c.existing[id]; !ok {
+		return false
+	}
+
+	if changed, ok := c.lastChanged[id]; ok && !changed.Before(since) {
+		return false
+	}
+
+	return true
+}
+
+func (c *ResourceGraphRefreshCache) populate(ctx context.Context) (map[string]struct{}, map[string]time.Time, error) {
+	existingRows, err := c.queryRows(ctx, "Resources | project id = tolower(id)")
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving the existing resources: %+v", err)
+	}
+
+	existing := make(map[string]struct{}, len(existingRows))
+	for _, row := range existingRows {
+		if id, ok := row["id"].(string); ok && id != "" {
+			existing[strings.ToLower(id)] = struct{}{}
+		}
+	}
+
+	changedQuery := fmt.Sprintf(`ResourceChanges
+| extend changeTime = todatetime(properties.changeAttributes.timestamp), targetResourceId = tolower(tostring(properties.targetResourceId))
+| where changeTime > ago(%dh)
+| summarize changeTime = max(changeTime) by targetResourceId
+| project id = targetResourceId, changeTime`, c.lookbackHours)
+	changedRows, err := c.queryRows(ctx, changedQuery)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving the changed resources: %+v", err)
+	}
+
+	changes := make(map[string]time.Time, len(changedRows))
+	for _, row := range changedRows {
+		id, ok := row["id"].(string)
+		if !ok || id == "" {
+			continue
+		}
+		v, ok := row["changeTime"].(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("expected `changeTime` to be a string for %q but got %T", id, row["changeTime"])
+		}
+		changeTime, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing `changeTime` %q for %q: %+v", v, id, err)
+		}
+		changes[id] = changeTime
+	}
+
+	lastChanged := lastChangedResourceIds(changes)
+	log.Printf("[DEBUG] Resource Graph reported %d existing resources, of which %d have changed within the last %d hours", len(existing), len(lastChanged), c.lookbackHours)
+	return existing, lastChanged, nil
+}
+
+// lastChangedResourceIds returns the time at which each (lower-cased) resource ID within `changes`, or any of its
+// child resources, last changed
+func lastChangedResourceIds(changes map[string]time.Time) map[string]time.Time {
+	// a change to a child resource (e.g. a Subnet) can also change the parent resource (e.g. the Virtual Network which
+	// contains the Subnet) - so each parent of a changed resource is considered changed at the same time
+	lastChanged := make(map[string]time.Time)
+	for id, changeTime := range changes {
+		id = strings.ToLower(id)
+		for id != "" {
+			if existing, ok := lastChanged[id]; !ok || changeTime.After(existing) {
+				lastChanged[id] = changeTime
+			}
+			i := strings.LastIndex(id, "/")
+			if i < 0 {
+				break
+			}
+			id = id[:i]
+		}
+	}
+
+	return lastChanged
+}
+
+func (c *ResourceGraphRefreshCache) queryRows(ctx context.Context, query string) ([]map[string]interface{}, error) {
+	rows := make([]map[string]interface{}, 0)
+
+	request := resourcegraph.QueryRequest{
+		Query:         query,
+		Subscriptions: pointer.To([]string{c.subscriptionId}),
+		Options: &resourcegraph.QueryRequestOptions{
+			ResultFormat: pointer.To(resourcegraph.ResultFormatObjectArray),
+			Top:          pointer.To(int64(1000)),
+		},
+	}
+	for {
+		resp, err := c.client.Resources(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Model == nil {
+			return nil, fmt.Errorf("`model` was nil")
+		}
+
+		data, ok := resp.Model.Data.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected `data` to be a list but got %T", resp.Model.Data)
+		}
+		for _, row := range data {
+			if values, ok := row.(map[string]interface{}); ok {
+				rows = append(rows, values)
+			}
+		}
+
+		if pointer.From(resp.Model.SkipToken) == "" {
+			break
+		}
+		request.Options.SkipToken = resp.Model.SkipToken
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLastChangedResourceIds(t *testing.T) {
+	earlier := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	later := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	changes := map[string]time.Time{
+		// a change to a child resource should mark the parent resource as changed
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example/providers/microsoft.network/virtualnetworks/network1/subnets/subnet1": later,
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1":                 earlier,
+	}
+
+	actual := lastChangedResourceIds(changes)
+
+	expected := map[string]time.Time{
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example/providers/microsoft.network/virtualnetworks/network1/subnets/subnet1": later,
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example/providers/microsoft.network/virtualnetworks/network1/subnets":         later,
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example/providers/microsoft.network/virtualnetworks/network1":                 later,
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example/providers/microsoft.network/virtualnetworks":                          later,
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example/providers/microsoft.network":                                          later,
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example/providers":                                                            later,
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example":                                                                      later,
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups":                                                                              later,
+		"/subscriptions/12345678-1234-9876-4563-123456789012":                                                                                             later,
+		"/subscriptions": later,
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestResourceGraphRefreshCacheIsUnchangedSince(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	network1 := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1"
+	network2 := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network2"
+	account1 := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/account1"
+
+	cache := ResourceGraphRefreshCache{
+		windowStart: now.Add(-24 * time.Hour),
+		existing: map[string]struct{}{
+			"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example/providers/microsoft.network/virtualnetworks/network1": {},
+			"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example/providers/microsoft.network/virtualnetworks/network2": {},
+		},
+		lastChanged: map[string]time.Time{
+			"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example/providers/microsoft.network/virtualnetworks/network1": now.Add(-2 * time.Hour),
+		},
+	}
+
+	testData := []struct {
+		name     string
+		id       string
+		lastRead time.Time
+		expected bool
+	}{
+		{
+			name:     "changed after the last read",
+			id:       network1,
+			lastRead: now.Add(-3 * time.Hour),
+			expected: false,
+		},
+		{
+			name:     "changed within the margin of the last read",
+			id:       network1,
+			lastRead: now.Add(-2 * time.Hour).Add(time.Minute),
+			expected: false,
+		},
+		{
+			name:     "changed before the last read",
+			id:       network1,
+			lastRead: now.Add(-1 * time.Hour),
+			expected: true,
+		},
+		{
+			name:     "not changed within the lookback period",
+			id:       network2,
+			lastRead: now.Add(-12 * time.Hour),
+			expected: true,
+		},
+		{
+			name:     "last read before the lookback period",
+			id:       network2,
+			lastRead: now.Add(-48 * time.Hour),
+			expected: false,
+		},
+		{
+			name:     "no longer exists",
+			id:       account1,
+			lastRead: now.Add(-1 * time.Hour),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := cache.isUnchangedSince(v.id, v.lastRead); actual != v.expected {
+			t.Fatalf("expected %t but got %t for %q", v.expected, actual, v.name)
+		}
+	}
+}
@@ -23,6 +23,7 @@ func resourceManagementLock() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceManagementLockCreate,
 		Read:   resourceManagementLockRead,
+		Update: resourceManagementLockUpdate,
 		Delete: resourceManagementLockDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -33,6 +34,7 @@ func resourceManagementLock() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -63,9 +65,17 @@ func resourceManagementLock() *pluginsdk.Resource {
 			"notes": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 512),
 			},
+
+			"owner_application_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
 		},
 	}
 }
@@ -91,8 +101,9 @@ func resourceManagementLockCreate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	payload := managementlocks.ManagementLockObject{
 		Properties: managementlocks.ManagementLockProperties{
-			Level: managementlocks.LockLevel(d.Get("lock_level").(string)),
-			Notes: utils.String(d.Get("notes").(string)),
+			Level:  managementlocks.LockLevel(d.Get("lock_level").(string)),
+			Notes:  utils.String(d.Get("notes").(string)),
+			Owners: expandManagementLockOwners(d.Get("owner_application_ids").(*pluginsdk.Set).List()),
 		},
 	}
 
@@ -148,11 +159,40 @@ func resourceManagementLockRead(d *pluginsdk.ResourceData, meta interface{}) err
 	if model := resp.Model; model != nil {
 		d.Set("lock_level", string(model.Properties.Level))
 		d.Set("notes", model.Properties.Notes)
+
+		if err := d.Set("owner_application_ids", flattenManagementLockOwners(model.Properties.Owners)); err != nil {
+			return fmt.Errorf("setting `owner_application_ids`: %+v", err)
+		}
 	}
 
 	return nil
 }
 
+func resourceManagementLockUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.LocksClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := managementlocks.ParseScopedLockID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	payload := managementlocks.ManagementLockObject{
+		Properties: managementlocks.ManagementLockProperties{
+			Level:  managementlocks.LockLevel(d.Get("lock_level").(string)),
+			Notes:  utils.String(d.Get("notes").(string)),
+			Owners: expandManagementLockOwners(d.Get("owner_application_ids").(*pluginsdk.Set).List()),
+		},
+	}
+
+	if _, err := client.CreateOrUpdateByScope(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceManagementLockRead(d, meta)
+}
+
 func resourceManagementLockDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.LocksClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -206,3 +246,27 @@ func managementLockStateRefreshFunc(ctx context.Context, client *managementlocks
 		return "OK", "OK", nil
 	}
 }
+
+func expandManagementLockOwners(input []interface{}) *[]managementlocks.ManagementLockOwner {
+	owners := make([]managementlocks.ManagementLockOwner, 0)
+	for _, v := range input {
+		owners = append(owners, managementlocks.ManagementLockOwner{
+			ApplicationId: utils.String(v.(string)),
+		})
+	}
+	return &owners
+}
+
+func flattenManagementLockOwners(input *[]managementlocks.ManagementLockOwner) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.ApplicationId != nil {
+			output = append(output, *v.ApplicationId)
+		}
+	}
+	return output
+}
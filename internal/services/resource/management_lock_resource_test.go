@@ -94,6 +94,43 @@ func TestAccManagementLock_resourceGroupCanNotDeleteComplete(t *testing.T) {
 	})
 }
 
+func TestAccManagementLock_resourceGroupCanNotDeleteUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_lock", "test")
+	r := ManagementLockResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceGroupCanNotDeleteBasic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceGroupCanNotDeleteComplete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceGroupCanNotDeleteOwners(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owner_application_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceGroupCanNotDeleteBasic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccManagementLock_publicIPReadOnlyBasic(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_management_lock", "test")
 	r := ManagementLockResource{}
@@ -268,6 +305,29 @@ resource "azurerm_management_lock" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (ManagementLockResource) resourceGroupCanNotDeleteOwners(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_management_lock" "test" {
+  name                  = "acctestlock-%d"
+  scope                 = azurerm_resource_group.test.id
+  lock_level            = "CanNotDelete"
+  notes                 = "Owned by the Platform team"
+  owner_application_ids = [data.azurerm_client_config.current.client_id]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (ManagementLockResource) publicIPReadOnlyBasic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
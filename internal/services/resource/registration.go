@@ -33,6 +33,7 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_api_versions":                         dataSourceApiVersions(),
 		"azurerm_resources":                            dataSourceResources(),
 		"azurerm_resource_group":                       dataSourceResourceGroup(),
 		"azurerm_template_spec_version":                dataSourceTemplateSpecVersion(),
@@ -74,5 +75,8 @@ func (r Registration) Resources() []sdk.Resource {
 		ResourceManagementPrivateLinkResource{},
 		ResourceDeploymentScriptAzurePowerShellResource{},
 		ResourceDeploymentScriptAzureCliResource{},
+		TemplateSpecResource{},
+		TemplateSpecVersionResource{},
+		WaitForPropagationResource{},
 	}
 }
@@ -4,6 +4,7 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sort"
@@ -12,8 +13,10 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
@@ -54,6 +57,12 @@ func resourceResourceGroup() *pluginsdk.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			// when omitted this falls back to the `prevent_deletion_if_contains_resources` feature in the Provider block
+			"prevent_deletion_if_contains_resources": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -188,8 +197,15 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
+	preventDeletionIfContainsResources := meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources
+	if raw := d.GetRawState(); !raw.IsNull() {
+		if v := raw.AsValueMap()["prevent_deletion_if_contains_resources"]; !v.IsNull() {
+			preventDeletionIfContainsResources = v.True()
+		}
+	}
+
 	// conditionally check for nested resources and error if they exist
-	if meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources {
+	if preventDeletionIfContainsResources {
 		resourceClient := meta.(*clients.Client).Resource.ResourcesClient
 		// Resource groups sometimes hold on to resource information after the resources have been deleted. We'll retry this check to account for that eventual consistency.
 		err = pluginsdk.Retry(10*time.Minute, func() *pluginsdk.RetryError {
@@ -220,19 +236,99 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
+	// conditionally remove any Management Locks owned by the authenticated Application, which would otherwise block the deletion
+	removedLocks := make([]managementlocks.ManagementLockObject, 0)
+	if meta.(*clients.Client).Features.ResourceGroup.RemoveOwnedManagementLocksDuringDeletion {
+		locksClient := meta.(*clients.Client).Resource.LocksClient
+		resourceGroupId := commonids.NewResourceGroupID(id.SubscriptionId, id.ResourceGroup)
+		removedLocks, err = removeOwnedManagementLocks(ctx, locksClient, resourceGroupId, meta.(*clients.Client).Account.ClientId)
+		if err != nil {
+			return err
+		}
+	}
+
 	deleteFuture, err := client.Delete(ctx, id.ResourceGroup, "")
 	if err != nil {
-		return fmt.Errorf("deleting %s: %+v", *id, err)
+		return restoreManagementLocksAfterError(ctx, meta.(*clients.Client).Resource.LocksClient, removedLocks, fmt.Errorf("deleting %s: %+v", *id, err))
 	}
 
 	err = deleteFuture.WaitForCompletionRef(ctx, client.Client)
 	if err != nil {
-		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+		return restoreManagementLocksAfterError(ctx, meta.(*clients.Client).Resource.LocksClient, removedLocks, fmt.Errorf("waiting for the deletion of %s: %+v", *id, err))
 	}
 
 	return nil
 }
 
+// removeOwnedManagementLocks removes the Management Locks within the Resource Group which are owned by the specified
+// Application ID, returning the removed Management Locks so that these can be restored should the deletion fail
+func removeOwnedManagementLocks(ctx context.Context, client *managementlocks.ManagementLocksClient, id commonids.ResourceGroupId, applicationId string) ([]managementlocks.ManagementLockObject, error) {
+	removed := make([]managementlocks.ManagementLockObject, 0)
+
+	resp, err := client.ListAtResourceGroupLevelComplete(ctx, id, managementlocks.DefaultListAtResourceGroupLevelOperationOptions())
+	if err != nil {
+		return removed, fmt.Errorf("listing Management Locks within %s: %+v", id, err)
+	}
+
+	for _, item := range resp.Items {
+		if item.Id == nil || !strings.HasPrefix(strings.ToLower(*item.Id), strings.ToLower(id.ID())+"/") {
+			// Management Locks inherited from the Subscription aren't owned by this Resource Group
+			continue
+		}
+
+		if !managementLockIsOwnedBy(item, applicationId) {
+			continue
+		}
+
+		lockId, err := managementlocks.ParseScopedLockIDInsensitively(*item.Id)
+		if err != nil {
+			return removed, err
+		}
+
+		log.Printf("[DEBUG] Temporarily removing %s prior to deleting %s", *lockId, id)
+		if _, err := client.DeleteByScope(ctx, *lockId); err != nil {
+			return removed, restoreManagementLocksAfterError(ctx, client, removed, fmt.Errorf("removing %s: %+v", *lockId, err))
+		}
+		removed = append(removed, item)
+	}
+
+	return removed, nil
+}
+
+// restoreManagementLocksAfterError recreates the Management Locks removed by removeOwnedManagementLocks, returning the
+// original error along with any errors encountered whilst recreating these
+func restoreManagementLocksAfterError(ctx context.Context, client *managementlocks.ManagementLocksClient, locks []managementlocks.ManagementLockObject, original error) error {
+	for _, item := range locks {
+		lockId, err := managementlocks.ParseScopedLockIDInsensitively(pointer.From(item.Id))
+		if err != nil {
+			return fmt.Errorf("%+v\n\nadditionally parsing the removed Management Lock %q: %+v", original, pointer.From(item.Id), err)
+		}
+
+		payload := managementlocks.ManagementLockObject{
+			Properties: item.Properties,
+		}
+		if _, err := client.CreateOrUpdateByScope(ctx, *lockId, payload); err != nil {
+			return fmt.Errorf("%+v\n\nadditionally restoring the removed %s: %+v", original, *lockId, err)
+		}
+	}
+
+	return original
+}
+
+func managementLockIsOwnedBy(input managementlocks.ManagementLockObject, applicationId string) bool {
+	if input.Properties.Owners == nil || applicationId == "" {
+		return false
+	}
+
+	for _, owner := range *input.Properties.Owners {
+		if strings.EqualFold(pointer.From(owner.ApplicationId), applicationId) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func resourceGroupContainsItemsError(name string, nestedResourceIds []string) error {
 	formattedResourceUris := make([]string, 0)
 	for _, id := range nestedResourceIds {
@@ -262,7 +358,10 @@ provider "azurerm" {
   }
 }
 
-When that feature flag is set, Terraform will skip checking for any Resources within the Resource Group and
+Alternatively this behaviour can be disabled for a single Resource Group by setting the
+'prevent_deletion_if_contains_resources' argument on the 'azurerm_resource_group' resource to 'false'.
+
+When this is disabled, Terraform will skip checking for any Resources within the Resource Group and
 delete this using the Azure API directly (which will clear up any nested resources).
 
 More information on the 'features' block can be found in the documentation:
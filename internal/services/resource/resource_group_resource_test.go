@@ -123,6 +123,38 @@ func TestAccResourceGroup_withNestedItemsAndFeatureFlag(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_withNestedItemsAndResourceOverride(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	r := ResourceGroupResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withResourceOverride(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.createNetworkOutsideTerraform(fmt.Sprintf("acctestvnet-%d", data.RandomInteger))),
+			),
+		},
+		data.ImportStep("prevent_deletion_if_contains_resources"),
+		{
+			// attempting to delete this with the vnet should error
+			Config:      r.withResourceOverride(data, true),
+			Destroy:     true,
+			ExpectError: regexp.MustCompile("This feature is intended to avoid the unintentional destruction"),
+		},
+		{
+			// the argument on the resource takes precedence over the feature flag in the provider block
+			Config: r.withResourceOverride(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:  r.withResourceOverride(data, false),
+			Destroy: true,
+		},
+	})
+}
+
 func (t ResourceGroupResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	// NOTE: Due to the Resource Group resource still using the old Azure SDK and sourcing the Resource Group ID
 	// from the Azure API, we need to support both `resourceGroups` and the legacy `resourcegroups` value here
@@ -239,6 +271,24 @@ resource "azurerm_resource_group" "test" {
 `, featureFlagEnabled, data.RandomInteger, data.Locations.Primary)
 }
 
+func (t ResourceGroupResource) withResourceOverride(data acceptance.TestData, preventDeletion bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    resource_group {
+      prevent_deletion_if_contains_resources = %t
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name                                   = "acctestRG-%d"
+  location                               = "%s"
+  prevent_deletion_if_contains_resources = %t
+}
+`, !preventDeletion, data.RandomInteger, data.Locations.Primary, preventDeletion)
+}
+
 func (t ResourceGroupResource) withTagsConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
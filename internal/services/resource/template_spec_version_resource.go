@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = TemplateSpecVersionResource{}
+
+type TemplateSpecVersionResource struct{}
+
+type TemplateSpecVersionResourceModel struct {
+	Name                 string                            `tfschema:"name"`
+	TemplateSpecId       string                            `tfschema:"template_spec_id"`
+	Location             string                            `tfschema:"location"`
+	TemplateBody         string                            `tfschema:"template_body"`
+	UiFormDefinitionBody string                            `tfschema:"ui_form_definition_body"`
+	LinkedTemplates      []TemplateSpecLinkedTemplateModel `tfschema:"linked_template"`
+	Description          string                            `tfschema:"description"`
+	Tags                 map[string]string                 `tfschema:"tags"`
+}
+
+type TemplateSpecLinkedTemplateModel struct {
+	Path         string `tfschema:"path"`
+	TemplateBody string `tfschema:"template_body"`
+}
+
+func (r TemplateSpecVersionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.TemplateSpecVersionName,
+		},
+
+		"template_spec_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: templatespecversions.ValidateTemplateSpecID,
+		},
+
+		"location": commonschema.Location(),
+
+		"template_body": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"ui_form_definition_body": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"linked_template": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"path": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"template_body": {
+						Type:             pluginsdk.TypeString,
+						Required:         true,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 4096),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r TemplateSpecVersionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r TemplateSpecVersionResource) ModelObject() interface{} {
+	return &TemplateSpecVersionResourceModel{}
+}
+
+func (r TemplateSpecVersionResource) ResourceType() string {
+	return "azurerm_template_spec_version"
+}
+
+func (r TemplateSpecVersionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return templatespecversions.ValidateTemplateSpecVersionID
+}
+
+func (r TemplateSpecVersionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsVersionsClient
+
+			var model TemplateSpecVersionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			templateSpecId, err := templatespecversions.ParseTemplateSpecID(model.TemplateSpecId)
+			if err != nil {
+				return err
+			}
+
+			id := templatespecversions.NewTemplateSpecVersionID(templateSpecId.SubscriptionId, templateSpecId.ResourceGroupName, templateSpecId.TemplateSpecName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandTemplateSpecVersionProperties(model)
+			if err != nil {
+				return err
+			}
+
+			payload := templatespecversions.TemplateSpecVersion{
+				Location:   location.Normalize(model.Location),
+				Properties: *properties,
+				Tags:       pointer.To(model.Tags),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r TemplateSpecVersionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsVersionsClient
+
+			id, err := templatespecversions.ParseTemplateSpecVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := TemplateSpecVersionResourceModel{
+				Name:           id.VersionName,
+				TemplateSpecId: templatespecversions.NewTemplateSpecID(id.SubscriptionId, id.ResourceGroupName, id.TemplateSpecName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				props := model.Properties
+				state.Description = pointer.From(props.Description)
+
+				templateBody, err := flattenTemplateDeploymentBody(props.MainTemplate)
+				if err != nil {
+					return fmt.Errorf("flattening `template_body`: %+v", err)
+				}
+				state.TemplateBody = pointer.From(templateBody)
+
+				if props.UiFormDefinition != nil {
+					uiFormDefinitionBody, err := flattenTemplateDeploymentBody(props.UiFormDefinition)
+					if err != nil {
+						return fmt.Errorf("flattening `ui_form_definition_body`: %+v", err)
+					}
+					state.UiFormDefinitionBody = pointer.From(uiFormDefinitionBody)
+				}
+
+				linkedTemplates, err := flattenTemplateSpecLinkedTemplates(props.LinkedTemplates)
+				if err != nil {
+					return fmt.Errorf("flattening `linked_template`: %+v", err)
+				}
+				state.LinkedTemplates = linkedTemplates
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r TemplateSpecVersionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsVersionsClient
+
+			id, err := templatespecversions.ParseTemplateSpecVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model TemplateSpecVersionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties, err := expandTemplateSpecVersionProperties(model)
+			if err != nil {
+				return err
+			}
+
+			// the Template, UI Form Definition and Linked Templates are only updatable using a PUT
+			payload := templatespecversions.TemplateSpecVersion{
+				Location:   location.Normalize(model.Location),
+				Properties: *properties,
+				Tags:       pointer.To(model.Tags),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r TemplateSpecVersionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsVersionsClient
+
+			id, err := templatespecversions.ParseTemplateSpecVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandTemplateSpecVersionProperties(input TemplateSpecVersionResourceModel) (*templatespecversions.TemplateSpecVersionProperties, error) {
+	mainTemplate, err := expandTemplateSpecVersionBody(input.TemplateBody)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `template_body`: %+v", err)
+	}
+
+	properties := templatespecversions.TemplateSpecVersionProperties{
+		MainTemplate: mainTemplate,
+	}
+
+	if input.Description != "" {
+		properties.Description = pointer.To(input.Description)
+	}
+
+	if input.UiFormDefinitionBody != "" {
+		uiFormDefinition, err := expandTemplateSpecVersionBody(input.UiFormDefinitionBody)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `ui_form_definition_body`: %+v", err)
+		}
+		properties.UiFormDefinition = uiFormDefinition
+	}
+
+	linkedTemplates := make([]templatespecversions.LinkedTemplateArtifact, 0)
+	for _, v := range input.LinkedTemplates {
+		template, err := expandTemplateSpecVersionBody(v.TemplateBody)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `template_body` for the linked template %q: %+v", v.Path, err)
+		}
+
+		linkedTemplates = append(linkedTemplates, templatespecversions.LinkedTemplateArtifact{
+			Path:     v.Path,
+			Template: *template,
+		})
+	}
+	properties.LinkedTemplates = &linkedTemplates
+
+	return &properties, nil
+}
+
+func expandTemplateSpecVersionBody(input string) (*interface{}, error) {
+	var output interface{}
+	if err := json.Unmarshal([]byte(input), &output); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func flattenTemplateSpecLinkedTemplates(input *[]templatespecversions.LinkedTemplateArtifact) ([]TemplateSpecLinkedTemplateModel, error) {
+	output := make([]TemplateSpecLinkedTemplateModel, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range *input {
+		templateBody, err := flattenTemplateDeploymentBody(v.Template)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, TemplateSpecLinkedTemplateModel{
+			Path:         v.Path,
+			TemplateBody: pointer.From(templateBody),
+		})
+	}
+
+	return output, nil
+}
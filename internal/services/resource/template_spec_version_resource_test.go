@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type TemplateSpecVersionResource struct{}
+
+func TestAccTemplateSpecVersion_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTemplateSpecVersion_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccTemplateSpecVersion_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTemplateSpecVersion_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_template_spec_version", "test")
+	r := TemplateSpecVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r TemplateSpecVersionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := templatespecversions.ParseTemplateSpecVersionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.TemplateSpecsVersionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r TemplateSpecVersionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-tsv-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_template_spec" "test" {
+  name                = "acctest-ts-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r TemplateSpecVersionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "test" {
+  name             = "v1.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+  location         = azurerm_template_spec.test.location
+
+  template_body = jsonencode({
+    "$schema"      = "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#"
+    contentVersion = "1.0.0.0"
+    resources      = []
+  })
+}
+`, r.template(data))
+}
+
+func (r TemplateSpecVersionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "import" {
+  name             = azurerm_template_spec_version.test.name
+  template_spec_id = azurerm_template_spec_version.test.template_spec_id
+  location         = azurerm_template_spec_version.test.location
+  template_body    = azurerm_template_spec_version.test.template_body
+}
+`, r.basic(data))
+}
+
+func (r TemplateSpecVersionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_template_spec_version" "test" {
+  name             = "v1.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+  location         = azurerm_template_spec.test.location
+  description      = "The first release"
+
+  template_body = jsonencode({
+    "$schema"      = "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#"
+    contentVersion = "1.0.0.0"
+    parameters = {
+      storageAccountType = {
+        type         = "string"
+        defaultValue = "Standard_LRS"
+      }
+    }
+    resources = [
+      {
+        type       = "Microsoft.Resources/deployments"
+        apiVersion = "2022-09-01"
+        name       = "nested"
+        properties = {
+          mode = "Incremental"
+          templateLink = {
+            relativePath = "artifacts/nested.json"
+          }
+        }
+      }
+    ]
+  })
+
+  linked_template {
+    path = "artifacts/nested.json"
+    template_body = jsonencode({
+      "$schema"      = "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#"
+      contentVersion = "1.0.0.0"
+      resources      = []
+    })
+  }
+
+  ui_form_definition_body = jsonencode({
+    "$schema" = "https://schema.management.azure.com/schemas/2021-09-09/uiFormDefinition.schema.json"
+    view = {
+      kind = "Form"
+      properties = {
+        title = "Example"
+        steps = [
+          {
+            name  = "basics"
+            label = "Basics"
+            elements = [
+              {
+                name = "resourceScope"
+                type = "Microsoft.Common.ResourceScope"
+              }
+            ]
+          }
+        ]
+      }
+      outputs = {
+        parameters = {
+          storageAccountType = "Standard_LRS"
+        }
+      }
+    }
+  })
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithCustomImporter = WaitForPropagationResource{}
+
+type WaitForPropagationResource struct{}
+
+type WaitForPropagationModel struct {
+	DnsRecord            []WaitForPropagationDnsRecordModel `tfschema:"dns_record"`
+	ConsecutiveSuccesses int64                              `tfschema:"consecutive_successes"`
+	PollIntervalSeconds  int64                              `tfschema:"poll_interval_seconds"`
+	Triggers             map[string]string                  `tfschema:"triggers"`
+}
+
+type WaitForPropagationDnsRecordModel struct {
+	Fqdn           string   `tfschema:"fqdn"`
+	Type           string   `tfschema:"type"`
+	ExpectedValues []string `tfschema:"expected_values"`
+	NameServer     string   `tfschema:"name_server"`
+}
+
+const (
+	waitForPropagationPending = "Pending"
+	waitForPropagationReady   = "Ready"
+)
+
+func (r WaitForPropagationResource) ResourceType() string {
+	return "azurerm_wait_for_propagation"
+}
+
+func (r WaitForPropagationResource) ModelObject() interface{} {
+	return &WaitForPropagationModel{}
+}
+
+func (r WaitForPropagationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.IsUUID
+}
+
+func (r WaitForPropagationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_record": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"fqdn": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"A",
+							"AAAA",
+							"CNAME",
+							"TXT",
+						}, false),
+					},
+
+					"expected_values": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"name_server": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"consecutive_successes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      5,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+
+		"poll_interval_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 300),
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r WaitForPropagationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WaitForPropagationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model WaitForPropagationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			check := model.DnsRecord[0]
+			description := fmt.Sprintf("the %s Record %q", check.Type, check.Fqdn)
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+
+			log.Printf("[DEBUG] Waiting for %s to propagate..", description)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:                   []string{waitForPropagationPending},
+				Target:                    []string{waitForPropagationReady},
+				Refresh:                   r.dnsRecordRefreshFunc(ctx, check),
+				PollInterval:              time.Duration(model.PollIntervalSeconds) * time.Second,
+				ContinuousTargetOccurence: int(model.ConsecutiveSuccesses),
+				Timeout:                   time.Until(deadline),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to propagate: %+v", description, err)
+			}
+			log.Printf("[DEBUG] %s has propagated", description)
+
+			id, err := uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating an ID: %+v", err)
+			}
+
+			metadata.ResourceData.SetId(id)
+			return nil
+		},
+	}
+}
+
+func (r WaitForPropagationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// intentionally a no-op: this resource only represents the wait which happened during creation,
+			// as such there's nothing to refresh
+			return nil
+		},
+	}
+}
+
+func (r WaitForPropagationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// intentionally a no-op: there's nothing to delete in Azure
+			return nil
+		},
+	}
+}
+
+func (r WaitForPropagationResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("%s only exists within the Terraform State and can't be imported", r.ResourceType())
+	}
+}
+
+func (r WaitForPropagationResource) dnsRecordRefreshFunc(ctx context.Context, check WaitForPropagationDnsRecordModel) pluginsdk.StateRefreshFunc {
+	resolver := net.DefaultResolver
+	if check.NameServer != "" {
+		nameServer := check.NameServer
+		if _, _, err := net.SplitHostPort(nameServer); err != nil {
+			nameServer = net.JoinHostPort(nameServer, "53")
+		}
+
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				dialer := net.Dialer{}
+				return dialer.DialContext(ctx, network, nameServer)
+			},
+		}
+	}
+
+	return func() (interface{}, string, error) {
+		values, err := lookupDnsRecord(ctx, resolver, check.Type, check.Fqdn)
+		if err != nil {
+			// the record not existing (yet) is expected whilst waiting for it to propagate
+			log.Printf("[DEBUG] Looking up the %s Record %q: %+v", check.Type, check.Fqdn, err)
+			return "", waitForPropagationPending, nil
+		}
+
+		if len(values) == 0 || !dnsRecordHasExpectedValues(values, check.ExpectedValues) {
+			return values, waitForPropagationPending, nil
+		}
+
+		return values, waitForPropagationReady, nil
+	}
+}
+
+func lookupDnsRecord(ctx context.Context, resolver *net.Resolver, recordType string, fqdn string) ([]string, error) {
+	values := make([]string, 0)
+	switch recordType {
+	case "A", "AAAA":
+		addresses, err := resolver.LookupIPAddr(ctx, fqdn)
+		if err != nil {
+			return nil, err
+		}
+		for _, address := range addresses {
+			isIPv4 := address.IP.To4() != nil
+			if isIPv4 == (recordType == "A") {
+				values = append(values, address.IP.String())
+			}
+		}
+
+	case "CNAME":
+		cname, err := resolver.LookupCNAME(ctx, fqdn)
+		if err != nil {
+			return nil, err
+		}
+		// a CNAME lookup for a name which isn't a CNAME returns the name itself
+		if !strings.EqualFold(strings.TrimSuffix(cname, "."), strings.TrimSuffix(fqdn, ".")) {
+			values = append(values, cname)
+		}
+
+	case "TXT":
+		records, err := resolver.LookupTXT(ctx, fqdn)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, records...)
+
+	default:
+		return nil, fmt.Errorf("unsupported record type %q", recordType)
+	}
+
+	return values, nil
+}
+
+// dnsRecordHasExpectedValues returns whether all of the expected values are present within the values returned
+// from the DNS lookup - when no values are expected any value is sufficient
+func dnsRecordHasExpectedValues(values []string, expected []string) bool {
+	for _, expectedValue := range expected {
+		found := false
+		for _, value := range values {
+			if strings.EqualFold(strings.TrimSuffix(value, "."), strings.TrimSuffix(expectedValue, ".")) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type WaitForPropagationResource struct{}
+
+func TestAccWaitForPropagation_dnsRecord(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_wait_for_propagation", "test")
+	r := WaitForPropagationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dnsRecord(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (WaitForPropagationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	// this resource only exists within the Terraform State, so has nothing to look up in Azure
+	return pointer.To(state.ID != ""), nil
+}
+
+func (WaitForPropagationResource) dnsRecord(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_a_record" "test" {
+  name                = "myarecord%d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+  records             = ["1.2.3.4"]
+}
+
+resource "azurerm_wait_for_propagation" "test" {
+  consecutive_successes = 1
+
+  dns_record {
+    fqdn            = trimsuffix(azurerm_dns_a_record.test.fqdn, ".")
+    type            = "A"
+    expected_values = ["1.2.3.4"]
+
+    # the zone isn't delegated, so query one of the Azure DNS name servers for the zone directly
+    name_server = trimsuffix(tolist(azurerm_dns_zone.test.name_servers)[0], ".")
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/sharedprivatelinkresources"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -32,6 +34,7 @@ type SharedPrivateLinkServiceModel struct {
 	SubResourceName  string `tfschema:"subresource_name"`
 	TargetResourceId string `tfschema:"target_resource_id"`
 	RequestMessage   string `tfschema:"request_message"`
+	ResourceRegion   string `tfschema:"resource_region"`
 	Status           string `tfschema:"status"`
 }
 
@@ -70,6 +73,8 @@ func (r SharedPrivateLinkServiceResource) Arguments() map[string]*pluginsdk.Sche
 			Optional:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+
+		"resource_region": commonschema.LocationOptional(),
 	}
 }
 
@@ -131,6 +136,10 @@ func (r SharedPrivateLinkServiceResource) Create() sdk.ResourceFunc {
 				parameters.Properties.RequestMessage = utils.String(model.RequestMessage)
 			}
 
+			if model.ResourceRegion != "" {
+				parameters.Properties.ResourceRegion = utils.String(location.Normalize(model.ResourceRegion))
+			}
+
 			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters, sharedprivatelinkresources.CreateOrUpdateOperationOptions{}); err != nil {
 				return fmt.Errorf("creating/ updating %s: %+v", id, err)
 			}
@@ -180,6 +189,10 @@ func (r SharedPrivateLinkServiceResource) Read() sdk.ResourceFunc {
 						state.RequestMessage = *props.RequestMessage
 					}
 
+					if props.ResourceRegion != nil {
+						state.ResourceRegion = location.Normalize(*props.ResourceRegion)
+					}
+
 					if props.Status != nil {
 						state.Status = string(*props.Status)
 					}
@@ -210,9 +223,15 @@ func (r SharedPrivateLinkServiceResource) Update() sdk.ResourceFunc {
 			if metadata.ResourceData.HasChange("request_message") {
 				props := sharedprivatelinkresources.SharedPrivateLinkResource{
 					Properties: &sharedprivatelinkresources.SharedPrivateLinkResourceProperties{
-						RequestMessage: utils.String(state.RequestMessage),
+						GroupId:               utils.String(state.SubResourceName),
+						PrivateLinkResourceId: utils.String(state.TargetResourceId),
+						RequestMessage:        utils.String(state.RequestMessage),
 					},
 				}
+
+				if state.ResourceRegion != "" {
+					props.Properties.ResourceRegion = utils.String(location.Normalize(state.ResourceRegion))
+				}
 				if err := client.CreateOrUpdateThenPoll(ctx, *id, props, sharedprivatelinkresources.CreateOrUpdateOperationOptions{}); err != nil {
 					return fmt.Errorf("updating %s: %+v", *id, err)
 				}
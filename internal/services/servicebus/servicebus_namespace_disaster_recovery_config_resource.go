@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/disasterrecoveryconfigs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2022-10-01-preview/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -50,10 +53,10 @@ func resourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			// changing this to the current `partner_namespace_id` triggers a failover, any other change forces a new resource
 			"primary_namespace_id": {
 				Type:     pluginsdk.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"partner_namespace_id": {
@@ -62,6 +65,17 @@ func resourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceIDOrEmpty, // nolint: staticcheck
 			},
 
+			"safe_failover_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"alias_authorization_rule_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -92,6 +106,57 @@ func resourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if !diff.HasChange("primary_namespace_id") || diff.Id() == "" {
+					return nil
+				}
+
+				// a failover is only possible to the namespace which is currently paired as the secondary
+				oldPartner, _ := diff.GetChange("partner_namespace_id")
+				_, newPrimary := diff.GetChange("primary_namespace_id")
+				if oldPartner.(string) == "" || !strings.EqualFold(oldPartner.(string), newPrimary.(string)) {
+					diff.ForceNew("primary_namespace_id")
+				}
+
+				return nil
+			}),
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if !diff.NewValueKnown("primary_namespace_id") || !diff.NewValueKnown("partner_namespace_id") {
+					return nil
+				}
+
+				// when failing over the former partner namespace becomes the primary, so can't remain the partner
+				partnerNamespaceId := diff.Get("partner_namespace_id").(string)
+				if partnerNamespaceId != "" && strings.EqualFold(partnerNamespaceId, diff.Get("primary_namespace_id").(string)) {
+					return fmt.Errorf("`partner_namespace_id` must be a different Service Bus Namespace to `primary_namespace_id` - when failing over, `partner_namespace_id` must be set to an empty string or to the ID of a new Service Bus Namespace")
+				}
+
+				return nil
+			}),
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				// Geo-Disaster Recovery isn't supported for partitioned Premium namespaces - when the namespace isn't
+				// known yet this is instead checked when the namespaces are paired
+				client := v.(*clients.Client).ServiceBus.NamespacesClient
+				for _, key := range []string{"primary_namespace_id", "partner_namespace_id"} {
+					if diff.Id() != "" && !diff.HasChange(key) {
+						continue
+					}
+					if !diff.NewValueKnown(key) {
+						continue
+					}
+
+					if namespaceId := diff.Get(key).(string); namespaceId != "" {
+						if err := checkServiceBusNamespaceIsNotPartitioned(ctx, client, namespaceId); err != nil {
+							return err
+						}
+					}
+				}
+
+				return nil
+			}),
+		),
 	}
 }
 
@@ -121,6 +186,16 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigCreate(d *pluginsdk.Resour
 		}
 	}
 
+	// Geo-Disaster Recovery isn't supported for partitioned Premium namespaces
+	for _, v := range []string{namespaceId.ID(), partnerNamespaceId} {
+		if v == "" {
+			continue
+		}
+		if err := checkServiceBusNamespaceIsNotPartitioned(ctx, meta.(*clients.Client).ServiceBus.NamespacesClient, v); err != nil {
+			return err
+		}
+	}
+
 	parameters := disasterrecoveryconfigs.ArmDisasterRecovery{
 		Properties: &disasterrecoveryconfigs.ArmDisasterRecoveryProperties{
 			PartnerNamespace: utils.String(partnerNamespaceId),
@@ -152,12 +227,53 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigUpdate(d *pluginsdk.Resour
 	locks.ByName(id.NamespaceName, serviceBusNamespaceResourceName)
 	defer locks.UnlockByName(id.NamespaceName, serviceBusNamespaceResourceName)
 
-	if d.HasChange("partner_namespace_id") {
-		if _, err := client.BreakPairing(ctx, *id); err != nil {
-			return fmt.Errorf("breaking the pairing for %s: %+v", *id, err)
+	failedOver := false
+	if d.HasChange("primary_namespace_id") {
+		// the failover has to be invoked against the alias on the secondary namespace, which then becomes the primary
+		secondaryNamespaceId, err := disasterrecoveryconfigs.ParseNamespaceID(d.Get("primary_namespace_id").(string))
+		if err != nil {
+			return err
 		}
-		if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id); err != nil {
-			return fmt.Errorf("waiting for the pairing to break for %s: %+v", *id, err)
+		secondaryId := disasterrecoveryconfigs.NewDisasterRecoveryConfigID(secondaryNamespaceId.SubscriptionId, secondaryNamespaceId.ResourceGroupName, secondaryNamespaceId.NamespaceName, id.DisasterRecoveryConfigName)
+
+		locks.ByName(secondaryId.NamespaceName, serviceBusNamespaceResourceName)
+		defer locks.UnlockByName(secondaryId.NamespaceName, serviceBusNamespaceResourceName)
+
+		input := disasterrecoveryconfigs.FailoverProperties{
+			Properties: &disasterrecoveryconfigs.FailoverPropertiesProperties{
+				IsSafeFailover: utils.Bool(d.Get("safe_failover_enabled").(bool)),
+			},
+		}
+		if _, err := client.FailOver(ctx, secondaryId, input); err != nil {
+			return fmt.Errorf("failing over %s to %s: %+v", *id, secondaryNamespaceId, err)
+		}
+		if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, secondaryId); err != nil {
+			return fmt.Errorf("waiting for the failover of %s to complete: %+v", secondaryId, err)
+		}
+
+		// the alias is now hosted on the former secondary namespace and is no longer paired
+		id = &secondaryId
+		d.SetId(id.ID())
+		failedOver = true
+	} else if d.HasChange("partner_namespace_id") {
+		if old, _ := d.GetChange("partner_namespace_id"); old.(string) != "" {
+			if _, err := client.BreakPairing(ctx, *id); err != nil {
+				return fmt.Errorf("breaking the pairing for %s: %+v", *id, err)
+			}
+			if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id); err != nil {
+				return fmt.Errorf("waiting for the pairing to break for %s: %+v", *id, err)
+			}
+		}
+	}
+
+	if failedOver && d.Get("partner_namespace_id").(string) == "" {
+		return resourceServiceBusNamespaceDisasterRecoveryConfigRead(d, meta)
+	}
+
+	// Geo-Disaster Recovery isn't supported for partitioned Premium namespaces
+	if partnerNamespaceId := d.Get("partner_namespace_id").(string); partnerNamespaceId != "" && d.HasChange("partner_namespace_id") {
+		if err := checkServiceBusNamespaceIsNotPartitioned(ctx, meta.(*clients.Client).ServiceBus.NamespacesClient, partnerNamespaceId); err != nil {
+			return err
 		}
 	}
 
@@ -204,6 +320,12 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigRead(d *pluginsdk.Resource
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("partner_namespace_id", props.PartnerNamespace)
+
+			role := ""
+			if props.Role != nil {
+				role = string(*props.Role)
+			}
+			d.Set("role", role)
 		}
 	}
 
@@ -326,6 +448,30 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigDelete(d *pluginsdk.Resour
 	return nil
 }
 
+func checkServiceBusNamespaceIsNotPartitioned(ctx context.Context, client *namespaces.NamespacesClient, input string) error {
+	namespaceId, err := namespaces.ParseNamespaceIDInsensitively(input)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *namespaceId)
+	if err != nil {
+		// a namespace which doesn't exist is surfaced by the API when pairing the namespaces
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *namespaceId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if partitions := pointer.From(model.Properties.PremiumMessagingPartitions); partitions > 1 {
+			return fmt.Errorf("%s has %d `premium_messaging_partitions` - Geo-Disaster Recovery is not supported for partitioned namespaces", *namespaceId, partitions)
+		}
+	}
+
+	return nil
+}
+
 func resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx context.Context, client *disasterrecoveryconfigs.DisasterRecoveryConfigsClient, id disasterrecoveryconfigs.DisasterRecoveryConfigId) error {
 	deadline, ok := ctx.Deadline()
 	if !ok {
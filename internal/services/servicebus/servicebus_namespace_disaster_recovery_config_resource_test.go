@@ -6,6 +6,7 @@ package servicebus_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/disasterrecoveryconfigs"
@@ -32,6 +33,40 @@ func TestAccAzureRMServiceBusNamespacePairing_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMServiceBusNamespacePairing_failover(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_config", "pairing_test")
+	r := ServiceBusNamespaceDisasterRecoveryConfigResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep("safe_failover_enabled"),
+		{
+			Config: r.failover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("partner_namespace_id").HasValue(""),
+			),
+		},
+		data.ImportStep("safe_failover_enabled"),
+	})
+}
+
+func TestAccAzureRMServiceBusNamespacePairing_partitionedNamespace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_config", "pairing_test")
+	r := ServiceBusNamespaceDisasterRecoveryConfigResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.partitionedNamespace(data),
+			ExpectError: regexp.MustCompile("Geo-Disaster Recovery is not supported for partitioned namespaces"),
+		},
+	})
+}
+
 func (t ServiceBusNamespaceDisasterRecoveryConfigResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(state.ID)
 	if err != nil {
@@ -46,7 +81,53 @@ func (t ServiceBusNamespaceDisasterRecoveryConfigResource) Exists(ctx context.Co
 	return utils.Bool(resp.Model != nil), nil
 }
 
-func (ServiceBusNamespaceDisasterRecoveryConfigResource) basic(data acceptance.TestData) string {
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
+  name                 = "acctest-alias-%[2]d"
+  primary_namespace_id = azurerm_servicebus_namespace.primary_namespace_test.id
+  partner_namespace_id = azurerm_servicebus_namespace.secondary_namespace_test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) failover(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
+  name                  = "acctest-alias-%[2]d"
+  primary_namespace_id  = azurerm_servicebus_namespace.secondary_namespace_test.id
+  partner_namespace_id  = ""
+  safe_failover_enabled = true
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) partitionedNamespace(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_servicebus_namespace" "partitioned" {
+  name                         = "acctest3-%[2]d"
+  location                     = azurerm_resource_group.primary.location
+  resource_group_name          = azurerm_resource_group.primary.name
+  sku                          = "Premium"
+  capacity                     = 2
+  premium_messaging_partitions = 2
+}
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
+  name                 = "acctest-alias-%[2]d"
+  primary_namespace_id = azurerm_servicebus_namespace.partitioned.id
+  partner_namespace_id = azurerm_servicebus_namespace.secondary_namespace_test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ServiceBusNamespaceDisasterRecoveryConfigResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -79,11 +160,5 @@ resource "azurerm_servicebus_namespace" "secondary_namespace_test" {
   capacity                     = "1"
   premium_messaging_partitions = 1
 }
-
-resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
-  name                 = "acctest-alias-%[1]d"
-  primary_namespace_id = azurerm_servicebus_namespace.primary_namespace_test.id
-  partner_namespace_id = azurerm_servicebus_namespace.secondary_namespace_test.id
-}
 `, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
 }
@@ -266,6 +266,7 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 				return nil
 			}),
 			pluginsdk.CustomizeDiffShim(servicebusTLSVersionDiff),
+			pluginsdk.CustomizeDiffShim(servicebusPremiumMessagingPartitionsDiff),
 		),
 	}
 
@@ -633,6 +634,22 @@ func servicebusTLSVersionDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _
 	return
 }
 
+func servicebusPremiumMessagingPartitionsDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !strings.EqualFold(d.Get("sku").(string), string(namespaces.SkuNamePremium)) {
+		return nil
+	}
+
+	// the messaging units of a partitioned namespace are spread evenly across the partitions, so the
+	// capacity has to be a multiple of the partition count - this also applies when scaling an existing namespace
+	capacity := d.Get("capacity").(int)
+	partitions := d.Get("premium_messaging_partitions").(int)
+	if capacity > 0 && partitions > 1 && capacity%partitions != 0 {
+		return fmt.Errorf("`capacity` must be a multiple of `premium_messaging_partitions` (%d) but got %d", partitions, capacity)
+	}
+
+	return nil
+}
+
 func createNetworkRuleSetForNamespace(ctx context.Context, client *namespaces.NamespacesClient, id namespaces.NamespaceId, input []interface{}) error {
 	if len(input) < 1 || input[0] == nil {
 		return nil
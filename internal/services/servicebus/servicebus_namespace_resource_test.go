@@ -151,6 +151,39 @@ func TestAccAzureRMServiceBusNamespace_premiumMessagingPartition(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMServiceBusNamespace_premiumMessagingPartitionCapacity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace", "test")
+	r := ServiceBusNamespaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.premiumPartitioned(data, 2, 4),
+			ExpectError: regexp.MustCompile("`capacity` must be a multiple of `premium_messaging_partitions`"),
+		},
+	})
+}
+
+func TestAccAzureRMServiceBusNamespace_premiumPartitioned(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace", "test")
+	r := ServiceBusNamespaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.premiumPartitioned(data, 2, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("premium_messaging_partitions").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.premiumPartitioned(data, 4, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureRMServiceBusNamespace_zoneRedundant(t *testing.T) {
 	if features.FourPointOhBeta() {
 		t.Skipf("Skipped as 'zone_redundant' property is deprecated in 4.0")
@@ -494,6 +527,28 @@ resource "azurerm_servicebus_namespace" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (ServiceBusNamespaceResource) premiumPartitioned(data acceptance.TestData, capacity, partitions int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                         = "acctestservicebusnamespace-%d"
+  location                     = azurerm_resource_group.test.location
+  resource_group_name          = azurerm_resource_group.test.name
+  sku                          = "Premium"
+  capacity                     = %d
+  premium_messaging_partitions = %d
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, capacity, partitions)
+}
+
 // TODO: Remove in v4.0
 func (ServiceBusNamespaceResource) zoneRedundant(data acceptance.TestData) string {
 	return fmt.Sprintf(`
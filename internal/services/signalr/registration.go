@@ -25,6 +25,8 @@ func (r Registration) Resources() []sdk.Resource {
 		CustomDomainWebPubsubResource{},
 		CustomCertWebPubsubResource{},
 		CustomCertSignalrServiceResource{},
+		SignalRServiceReplicaResource{},
+		WebPubSubReplicaResource{},
 	}
 }
 
@@ -11,7 +11,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2023-02-01/webpubsub"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
@@ -200,6 +201,18 @@ func resourceWebPubSubHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("expanding event listener for web pubsub %s: %+v", id, err)
 	}
 
+	// event listeners authenticate against the Event Hubs using the Managed Identity of the Web PubSub - this can't be
+	// checked in a CustomizeDiff since the identity may be assigned to the Web PubSub during the same apply
+	if eventListener != nil && len(*eventListener) > 0 {
+		webPubSub, err := client.Get(ctx, *webPubSubId)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *webPubSubId, err)
+		}
+		if webPubSub.Model == nil || webPubSub.Model.Identity == nil || webPubSub.Model.Identity.Type == identity.TypeNone {
+			return fmt.Errorf("`event_listener` requires a Managed Identity to be assigned to %s", *webPubSubId)
+		}
+	}
+
 	parameters.Properties.EventListeners = eventListener
 
 	if err := client.HubsCreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalr
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WebPubSubReplicaModel struct {
+	Name        string            `tfschema:"name"`
+	WebPubSubId string            `tfschema:"web_pubsub_id"`
+	Location    string            `tfschema:"location"`
+	Sku         string            `tfschema:"sku"`
+	Capacity    int64             `tfschema:"capacity"`
+	Tags        map[string]string `tfschema:"tags"`
+}
+
+type WebPubSubReplicaResource struct{}
+
+var _ sdk.ResourceWithUpdate = WebPubSubReplicaResource{}
+
+func (r WebPubSubReplicaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$`),
+				"The name of the replica must be between 3 and 63 characters long, it can contain only letters, numbers and hyphens, it must start with a letter and end with a letter or number."),
+		},
+
+		"web_pubsub_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: webpubsub.ValidateWebPubSubID,
+		},
+
+		"location": commonschema.Location(),
+
+		"sku": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Premium_P1",
+				"Premium_P2",
+			}, false),
+		},
+
+		"capacity": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  1,
+			ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100, 200,
+				300, 400, 500, 600, 700, 800, 900, 1000}),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r WebPubSubReplicaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WebPubSubReplicaResource) ModelObject() interface{} {
+	return &WebPubSubReplicaModel{}
+}
+
+func (r WebPubSubReplicaResource) ResourceType() string {
+	return "azurerm_web_pubsub_replica"
+}
+
+func (r WebPubSubReplicaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return webpubsub.ValidateReplicaID
+}
+
+func (r WebPubSubReplicaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			var model WebPubSubReplicaModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			webPubSubId, err := webpubsub.ParseWebPubSubID(model.WebPubSubId)
+			if err != nil {
+				return err
+			}
+
+			id := webpubsub.NewReplicaID(webPubSubId.SubscriptionId, webPubSubId.ResourceGroupName, webPubSubId.WebPubSubName, model.Name)
+
+			locks.ByID(webPubSubId.ID())
+			defer locks.UnlockByID(webPubSubId.ID())
+
+			existing, err := client.ReplicasGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := webpubsub.Replica{
+				Location: location.Normalize(model.Location),
+				Sku: &webpubsub.ResourceSku{
+					Name:     model.Sku,
+					Capacity: pointer.To(model.Capacity),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.ReplicasCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WebPubSubReplicaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			id, err := webpubsub.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := WebPubSubReplicaModel{
+				Name:        id.ReplicaName,
+				WebPubSubId: webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.WebPubSubName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if sku := model.Sku; sku != nil {
+					state.Sku = sku.Name
+					state.Capacity = pointer.From(sku.Capacity)
+				}
+				state.Tags = pointer.From(model.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebPubSubReplicaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			id, err := webpubsub.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WebPubSubReplicaModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChanges("sku", "capacity") {
+				payload.Sku = &webpubsub.ResourceSku{
+					Name:     model.Sku,
+					Capacity: pointer.To(model.Capacity),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.ReplicasUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebPubSubReplicaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			id, err := webpubsub.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			webPubSubId := webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.WebPubSubName)
+
+			locks.ByID(webPubSubId.ID())
+			defer locks.UnlockByID(webPubSubId.ID())
+
+			if _, err := client.ReplicasDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -68,6 +68,7 @@ func resourceSpringCloudBuildPackBinding() *pluginsdk.Resource {
 					string(appplatform.BindingTypeDynatrace),
 					string(appplatform.BindingTypeNewRelic),
 					string(appplatform.BindingTypeElasticAPM),
+					string(appplatform.BindingTypeCACertificates),
 				}, false),
 			},
 
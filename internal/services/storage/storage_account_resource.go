@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/nameavailability"
 	keyVaultClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -1270,6 +1271,7 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				}
 				return false
 			}),
+			nameavailability.CustomizeDiff("Storage Account", storageAccountNameAvailability),
 		),
 	}
 
@@ -3503,3 +3505,22 @@ func flattenEdgeZone(input *edgezones.Model) string {
 	}
 	return output
 }
+
+func storageAccountNameAvailability(ctx context.Context, client *clients.Client, _ *pluginsdk.ResourceDiff, name string) (*nameavailability.Result, error) {
+	input := storageaccounts.StorageAccountCheckNameAvailabilityParameters{
+		Name: name,
+		Type: storageaccounts.TypeMicrosoftPointStorageStorageAccounts,
+	}
+	resp, err := client.Storage.ResourceManager.StorageAccounts.CheckNameAvailability(ctx, commonids.NewSubscriptionID(client.Account.SubscriptionId), input)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Model == nil || resp.Model.NameAvailable == nil {
+		return nil, fmt.Errorf("`model` was nil")
+	}
+
+	return &nameavailability.Result{
+		Available: *resp.Model.NameAvailable,
+		Message:   pointer.From(resp.Model.Message),
+	}, nil
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/amlfilesystems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-03-01/amlfilesystems"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagecache/validate"
@@ -31,6 +31,7 @@ type ManagedLustreFileSystemModel struct {
 	EncryptionKey       []EncryptionKey              `tfschema:"encryption_key"`
 	MaintenanceWindow   []MaintenanceWindow          `tfschema:"maintenance_window"`
 	MgsAddress          string                       `tfschema:"mgs_address"`
+	RootSquash          []RootSquash                 `tfschema:"root_squash"`
 	SkuName             string                       `tfschema:"sku_name"`
 	StorageCapacityInTb int64                        `tfschema:"storage_capacity_in_tb"`
 	SubnetId            string                       `tfschema:"subnet_id"`
@@ -54,6 +55,13 @@ type MaintenanceWindow struct {
 	TimeOfDayInUTC string                                  `tfschema:"time_of_day_in_utc"`
 }
 
+type RootSquash struct {
+	Mode         amlfilesystems.AmlFilesystemSquashMode `tfschema:"mode"`
+	NoSquashNids string                                 `tfschema:"no_squash_nids"`
+	SquashGid    int64                                  `tfschema:"squash_gid"`
+	SquashUid    int64                                  `tfschema:"squash_uid"`
+}
+
 type SkuProperties struct {
 	Name           string
 	MinimumStorage int
@@ -228,6 +236,39 @@ func (r ManagedLustreFileSystemResource) Arguments() map[string]*pluginsdk.Schem
 			},
 		},
 
+		"root_squash": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"mode": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(amlfilesystems.PossibleValuesForAmlFilesystemSquashMode(), false),
+					},
+
+					"no_squash_nids": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"squash_gid": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"squash_uid": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+
 		"tags": commonschema.Tags(),
 	}
 }
@@ -304,6 +345,7 @@ func (r ManagedLustreFileSystemResource) Create() sdk.ResourceFunc {
 					Hsm:                expandManagedLustreFileSystemHsmSetting(model.HsmSetting),
 					EncryptionSettings: expandManagedLustreFileSystemEncryptionKey(model.EncryptionKey),
 					MaintenanceWindow:  expandManagedLustreFileSystemMaintenanceWindowForCreate(model.MaintenanceWindow),
+					RootSquashSettings: expandManagedLustreFileSystemRootSquash(model.RootSquash),
 					FilesystemSubnet:   model.SubnetId,
 					StorageCapacityTiB: float64(model.StorageCapacityInTb),
 				},
@@ -352,6 +394,16 @@ func (r ManagedLustreFileSystemResource) Update() sdk.ResourceFunc {
 				properties.Properties.EncryptionSettings = expandManagedLustreFileSystemEncryptionKey(model.EncryptionKey)
 			}
 
+			if metadata.ResourceData.HasChange("root_squash") {
+				properties.Properties.RootSquashSettings = expandManagedLustreFileSystemRootSquash(model.RootSquash)
+				if properties.Properties.RootSquashSettings == nil {
+					// removing the root squash settings requires the mode to be explicitly reset
+					properties.Properties.RootSquashSettings = &amlfilesystems.AmlFilesystemRootSquashSettings{
+						Mode: pointer.To(amlfilesystems.AmlFilesystemSquashModeNone),
+					}
+				}
+			}
+
 			if metadata.ResourceData.HasChange("tags") {
 				properties.Tags = pointer.To(model.Tags)
 			}
@@ -408,6 +460,7 @@ func (r ManagedLustreFileSystemResource) Read() sdk.ResourceFunc {
 					state.HsmSetting = flattenManagedLustreFileSystemHsmSetting(properties.Hsm)
 					state.Zones = pointer.From(model.Zones)
 					state.EncryptionKey = flattenManagedLustreFileSystemEncryptionKey(properties.EncryptionSettings)
+					state.RootSquash = flattenManagedLustreFileSystemRootSquash(properties.RootSquashSettings)
 
 					if v := model.Sku; v != nil {
 						state.SkuName = pointer.From(v.Name)
@@ -543,3 +596,37 @@ func flattenManagedLustreFileSystemHsmSetting(input *amlfilesystems.AmlFilesyste
 
 	return append(result, hsmSetting)
 }
+
+func expandManagedLustreFileSystemRootSquash(input []RootSquash) *amlfilesystems.AmlFilesystemRootSquashSettings {
+	if len(input) == 0 {
+		return nil
+	}
+
+	rootSquash := &input[0]
+
+	result := &amlfilesystems.AmlFilesystemRootSquashSettings{
+		Mode:             pointer.To(rootSquash.Mode),
+		NoSquashNidLists: pointer.To(rootSquash.NoSquashNids),
+		SquashGID:        pointer.To(rootSquash.SquashGid),
+		SquashUID:        pointer.To(rootSquash.SquashUid),
+	}
+
+	return result
+}
+
+func flattenManagedLustreFileSystemRootSquash(input *amlfilesystems.AmlFilesystemRootSquashSettings) []RootSquash {
+	result := make([]RootSquash, 0)
+	// the API returns a mode of `None` once the root squash settings have been removed
+	if input == nil || (pointer.From(input.Mode) == amlfilesystems.AmlFilesystemSquashModeNone && pointer.From(input.NoSquashNidLists) == "") {
+		return result
+	}
+
+	rootSquash := RootSquash{
+		Mode:         pointer.From(input.Mode),
+		NoSquashNids: pointer.From(input.NoSquashNidLists),
+		SquashGid:    pointer.From(input.SquashGID),
+		SquashUid:    pointer.From(input.SquashUID),
+	}
+
+	return append(result, rootSquash)
+}
@@ -9,7 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/amlfilesystems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-03-01/amlfilesystems"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -304,6 +304,13 @@ resource "azurerm_managed_lustre_file_system" "test" {
     import_prefix        = "/"
   }
 
+  root_squash {
+    mode           = "RootOnly"
+    no_squash_nids = "10.0.2.4@tcp;10.0.2.[6-8]@tcp"
+    squash_gid     = 1000
+    squash_uid     = 1000
+  }
+
   tags = {
     Env = "Test"
   }
@@ -388,6 +395,13 @@ resource "azurerm_managed_lustre_file_system" "test" {
     import_prefix        = "/"
   }
 
+  root_squash {
+    mode           = "All"
+    no_squash_nids = "10.0.2.4@tcp"
+    squash_gid     = 2000
+    squash_uid     = 2000
+  }
+
   tags = {
     Env = "Test2"
   }
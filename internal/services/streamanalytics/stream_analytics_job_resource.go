@@ -6,6 +6,7 @@ package streamanalytics
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -141,7 +143,7 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 			"streaming_units": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				ValidateFunc: validation.IntBetween(1, 120),
+				ValidateFunc: validate.StreamingUnits,
 			},
 
 			"content_storage_policy": {
@@ -356,6 +358,25 @@ func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			return fmt.Errorf("updating %s: %+v", id, err)
 		}
 
+		// the transformation of a running job can't be updated, however the job can be scaled whilst it's running
+		if d.HasChange("streaming_units") && !d.HasChange("transformation_query") && jobType != string(streamingjobs.JobTypeEdge) {
+			existing, err := client.Get(ctx, id, streamingjobs.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := existing.Model; model != nil && model.Properties != nil && strings.EqualFold(pointer.From(model.Properties.JobState), "Running") {
+				scaleProps := streamingjobs.ScaleStreamingJobParameters{
+					StreamingUnits: pointer.To(int64(d.Get("streaming_units").(int))),
+				}
+				if err := client.ScaleThenPoll(ctx, id, scaleProps); err != nil {
+					return fmt.Errorf("scaling %s: %+v", id, err)
+				}
+
+				return resourceStreamAnalyticsJobRead(d, meta)
+			}
+		}
+
 		if d.HasChanges("streaming_units", "transformation_query") {
 			transformationUpdate := transformations.Transformation{
 				Name: utils.String("main"),
@@ -73,6 +73,29 @@ func TestAccStreamAnalyticsJobSchedule_lastOutputEventTime(t *testing.T) {
 	})
 }
 
+func TestAccStreamAnalyticsJobSchedule_scaleRunningJob(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_job_schedule", "test")
+	r := StreamAnalyticsJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.scaled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_stream_analytics_job.test").Key("streaming_units").HasValue("12"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StreamAnalyticsJobScheduleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.StreamingJobScheduleID(state.ID)
 	if err != nil {
@@ -149,7 +172,28 @@ resource "azurerm_stream_analytics_job_schedule" "test" {
 `, r.template(data))
 }
 
+func (r StreamAnalyticsJobScheduleResource) scaled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_job_schedule" "test" {
+  stream_analytics_job_id = azurerm_stream_analytics_job.test.id
+  start_mode              = "JobStartTime"
+
+  depends_on = [
+    azurerm_stream_analytics_job.test,
+    azurerm_stream_analytics_stream_input_blob.test,
+    azurerm_stream_analytics_output_blob.test,
+  ]
+}
+`, r.templateWithStreamingUnits(data, 12))
+}
+
 func (r StreamAnalyticsJobScheduleResource) template(data acceptance.TestData) string {
+	return r.templateWithStreamingUnits(data, 6)
+}
+
+func (r StreamAnalyticsJobScheduleResource) templateWithStreamingUnits(data acceptance.TestData, streamingUnits int) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -192,7 +236,7 @@ resource "azurerm_stream_analytics_job" "test" {
   events_out_of_order_max_delay_in_seconds = 20
   events_out_of_order_policy               = "Drop"
   output_error_policy                      = "Stop"
-  streaming_units                          = 6
+  streaming_units                          = %[4]d
 
   transformation_query = <<QUERY
     SELECT *
@@ -234,5 +278,5 @@ resource "azurerm_stream_analytics_output_blob" "test" {
     type = "Avro"
   }
 }
-`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, streamingUnits)
 }
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+)
+
+func StreamingUnits(input interface{}, key string) (warnings []string, errors []error) {
+	value, ok := input.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be int", key))
+		return
+	}
+
+	// V1 streaming units: 1, 3, 6 and multiples of 6 up to 120
+	if value == 1 || value == 3 || (value > 0 && value <= 120 && value%6 == 0) {
+		return
+	}
+
+	// V2 streaming units (1/3, 2/3, 1 and multiples of 1 up to 66) are expressed as 3, 7, 10 and multiples of 10 up to 660
+	if value == 7 || (value > 0 && value <= 660 && value%10 == 0) {
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%q must be one of `1`, `3`, `6` or a multiple of `6` up to `120` (V1 Streaming Units), or one of `3`, `7`, `10` or a multiple of `10` up to `660` (V2 Streaming Units), got %d", key, value))
+	return warnings, errors
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestStreamingUnits(t *testing.T) {
+	cases := map[int]bool{
+		0:   false,
+		1:   true,
+		2:   false,
+		3:   true,
+		6:   true,
+		7:   true,
+		9:   false,
+		10:  true,
+		12:  true,
+		120: true,
+		126: false,
+		130: true,
+		660: true,
+		670: false,
+		-6:  false,
+	}
+	for i, shouldBeValid := range cases {
+		_, errors := StreamingUnits(i, "streaming_units")
+
+		isValid := len(errors) == 0
+		if shouldBeValid != isValid {
+			t.Fatalf("Expected %d to be %t but got %t", i, shouldBeValid, isValid)
+		}
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
+	managementGroupParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -111,6 +113,13 @@ func resourceSubscription() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 
+			"management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Description:  "The ID of the Management Group the Subscription should be placed in. Changing this moves the Subscription to the new Management Group.",
+				Optional:     true,
+				ValidateFunc: managementGroupValidate.ManagementGroupID,
+			},
+
 			"tenant_id": {
 				Type:        pluginsdk.TypeString,
 				Description: "The Tenant ID to which the subscription belongs",
@@ -213,6 +222,12 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		// If we're not assuming control of an existing Subscription, we need to know where to create it.
 		req.Properties.DisplayName = utils.String(d.Get("subscription_name").(string))
 		req.Properties.BillingScope = utils.String(d.Get("billing_scope_id").(string))
+
+		if v := d.Get("management_group_id").(string); v != "" {
+			req.Properties.AdditionalProperties = &subscriptionAlias.PutAliasRequestAdditionalProperties{
+				ManagementGroupId: utils.String(v),
+			}
+		}
 	}
 
 	if err := aliasClient.AliasCreateThenPoll(ctx, id, req); err != nil {
@@ -235,6 +250,13 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("failed waiting for Subscription %q (Alias %q) to enter %q state: %+v", *alias.Model.Properties.SubscriptionId, id.AliasName, "Active", err)
 	}
 
+	// an existing Subscription is moved into the Management Group once the Alias has been created
+	if v := d.Get("management_group_id").(string); v != "" && d.Get("subscription_id").(string) != "" {
+		if err := moveSubscriptionToManagementGroup(ctx, meta, subscriptionResourceId, v); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("tags") {
 		tagsClient := meta.(*clients.Client).Resource.TagsClient
 		t := tags.Expand(d.Get("tags").(map[string]interface{}))
@@ -285,6 +307,19 @@ func resourceSubscriptionUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.HasChange("management_group_id") {
+		// removing `management_group_id` leaves the Subscription in its current Management Group, since
+		// Subscriptions always belong to a Management Group there's nothing to remove it from
+		if v := d.Get("management_group_id").(string); v != "" {
+			locks.ByID(subscriptionId.ID())
+			defer locks.UnlockByID(subscriptionId.ID())
+
+			if err := moveSubscriptionToManagementGroup(ctx, meta, subscriptionId, v); err != nil {
+				return err
+			}
+		}
+	}
+
 	if d.HasChange("tags") {
 		tagsClient := meta.(*clients.Client).Resource.TagsClient
 		t := tags.Expand(d.Get("tags").(map[string]interface{}))
@@ -328,6 +363,7 @@ func resourceSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error
 	subscriptionId := ""
 	subscriptionName := ""
 	tenantId := ""
+	managementGroupId := ""
 	var t *map[string]string
 	if props := alias.Model.Properties; props != nil && props.SubscriptionId != nil {
 		subscriptionId = *props.SubscriptionId
@@ -345,6 +381,14 @@ func resourceSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error
 			tenantId = pointer.From(model.TenantId)
 			t = model.Tags
 		}
+
+		// the parent Management Group is only looked up when it's managed, since this requires read access to the Tenant Root Management Group
+		if d.Get("management_group_id").(string) != "" && tenantId != "" {
+			managementGroupId, err = parentManagementGroupForSubscription(ctx, meta, tenantId, subscriptionId)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	// (@jackofallops) A subscription's billing scope is not exposed in any way in the API/SDK so we cannot read it back here
@@ -352,6 +396,7 @@ func resourceSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("subscription_id", subscriptionId)
 	d.Set("subscription_name", subscriptionName)
 	d.Set("tenant_id", tenantId)
+	d.Set("management_group_id", managementGroupId)
 	if err := tags.FlattenAndSet(d, t); err != nil {
 		return err
 	}
@@ -446,6 +491,42 @@ func resourceSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) err
 	return nil
 }
 
+func moveSubscriptionToManagementGroup(ctx context.Context, meta interface{}, subscriptionId commonids.SubscriptionId, managementGroupId string) error {
+	client := meta.(*clients.Client).ManagementGroups.SubscriptionClient
+
+	id, err := managementGroupParse.ManagementGroupID(managementGroupId)
+	if err != nil {
+		return err
+	}
+
+	// associating a Subscription with a Management Group moves it out of its current Management Group
+	if _, err := client.Create(ctx, id.Name, subscriptionId.SubscriptionId, "no-cache"); err != nil {
+		return fmt.Errorf("moving %s to Management Group %q: %+v", subscriptionId, id.Name, err)
+	}
+
+	return nil
+}
+
+func parentManagementGroupForSubscription(ctx context.Context, meta interface{}, tenantId, subscriptionId string) (string, error) {
+	client := meta.(*clients.Client).ManagementGroups.SubscriptionClient
+
+	// the Tenant Root Management Group shares its name with the Tenant ID and contains every Subscription in the Tenant
+	resp, err := client.GetSubscription(ctx, tenantId, subscriptionId, "no-cache")
+	if err != nil {
+		return "", fmt.Errorf("retrieving Management Group for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	if props := resp.SubscriptionUnderManagementGroupProperties; props != nil && props.Parent != nil && props.Parent.ID != nil {
+		id, err := managementGroupParse.ManagementGroupID(*props.Parent.ID)
+		if err != nil {
+			return "", err
+		}
+		return id.ID(), nil
+	}
+
+	return "", nil
+}
+
 func waitForSubscriptionStateToSettle(ctx context.Context, client *subscriptions.SubscriptionsClient, subscriptionId commonids.SubscriptionId, targetState string, timeout time.Duration) error {
 	stateConf := &pluginsdk.StateChangeConf{
 		Refresh: func() (result interface{}, state string, err error) {
@@ -97,6 +97,30 @@ func TestAccSubscriptionResource_devTest(t *testing.T) {
 	})
 }
 
+func TestAccSubscriptionResource_managementGroup(t *testing.T) {
+	if os.Getenv("ARM_BILLING_ACCOUNT") == "" {
+		t.Skip("skipping tests - no billing account data provided")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_subscription", "test")
+	r := SubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managementGroup(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r)),
+		},
+		data.ImportStep("billing_scope_id"),
+		{
+			Config: r.managementGroup(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r)),
+		},
+		data.ImportStep("billing_scope_id"),
+	})
+}
+
 func (SubscriptionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := subscriptions.ParseAliasID(state.ID)
 	if err != nil {
@@ -195,3 +219,35 @@ resource "azurerm_subscription" "import" {
 }
 `, r.basicEnrollmentAccount(data))
 }
+
+func (SubscriptionResource) managementGroup(data acceptance.TestData, managementGroup string) string {
+	billingAccount := os.Getenv("ARM_BILLING_ACCOUNT")
+	billingProfile := os.Getenv("ARM_BILLING_PROFILE")
+	invoiceSection := os.Getenv("ARM_INVOICE_SECTION")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_billing_mca_account_scope" "test" {
+  billing_account_name = "%[1]s"
+  billing_profile_name = "%[2]s"
+  invoice_section_name = "%[3]s"
+}
+
+resource "azurerm_management_group" "first" {
+  display_name = "acctestmg-first-%[4]d"
+}
+
+resource "azurerm_management_group" "second" {
+  display_name = "acctestmg-second-%[4]d"
+}
+
+resource "azurerm_subscription" "test" {
+  alias               = "testAcc-%[4]d"
+  subscription_name   = "testAccSubscription %[4]d"
+  billing_scope_id    = data.azurerm_billing_mca_account_scope.test.id
+  management_group_id = azurerm_management_group.%[5]s.id
+}
+`, billingAccount, billingProfile, invoiceSection, data.RandomInteger, managementGroup)
+}
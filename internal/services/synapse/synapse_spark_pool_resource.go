@@ -4,11 +4,21 @@
 package synapse
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -19,8 +29,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	artifacts "github.com/tombuildsstuff/kermit/sdk/synapse/2021-06-01-preview/synapse"
 )
 
+// the data plane API accepts at most 4MiB of content per append operation
+const sparkPoolWorkspacePackageChunkSize = 4 * 1024 * 1024
+
 func resourceSynapseSparkPool() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceSynapseSparkPoolCreate,
@@ -40,6 +54,8 @@ func resourceSynapseSparkPool() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceSynapseSparkPoolCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -199,18 +215,59 @@ func resourceSynapseSparkPool() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"content": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ExactlyOneOf: []string{"library_requirement.0.content", "library_requirement.0.source"},
 						},
 
 						"filename": {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 						},
+
+						"source": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"library_requirement.0.content", "library_requirement.0.source"},
+						},
+					},
+				},
+			},
+
+			"workspace_package": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.SparkPoolWorkspacePackageSource,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
 
+			"source_content_hashes": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"spark_version": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -345,9 +402,12 @@ func resourceSynapseSparkPoolRead(d *pluginsdk.ResourceData, meta interface{}) e
 		if err := d.Set("auto_scale", flattenArmSparkPoolAutoScaleProperties(props.AutoScale)); err != nil {
 			return fmt.Errorf("setting `auto_scale`: %+v", err)
 		}
-		if err := d.Set("library_requirement", flattenArmSparkPoolLibraryRequirements(props.LibraryRequirements)); err != nil {
+		if err := d.Set("library_requirement", flattenArmSparkPoolLibraryRequirements(props.LibraryRequirements, d.Get("library_requirement").([]interface{}))); err != nil {
 			return fmt.Errorf("setting `library_requirement`: %+v", err)
 		}
+		if err := d.Set("workspace_package", flattenSparkPoolWorkspacePackages(props.CustomLibraries, d.Get("workspace_package").([]interface{}))); err != nil {
+			return fmt.Errorf("setting `workspace_package`: %+v", err)
+		}
 		d.Set("cache_size", props.CacheSize)
 		d.Set("compute_isolation_enabled", props.IsComputeIsolationEnabled)
 
@@ -393,6 +453,36 @@ func resourceSynapseSparkPoolUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("reading Synapse workspace %q (Workspace %q / Resource Group %q): %+v", id.WorkspaceName, id.WorkspaceName, id.ResourceGroup, err)
 	}
 
+	libraryRequirements, err := expandArmSparkPoolLibraryRequirements(d.Get("library_requirement").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	var libraryClient *artifacts.LibraryClient
+	packages := d.Get("workspace_package").([]interface{})
+	if len(packages) > 0 || d.HasChange("workspace_package") {
+		environment := meta.(*clients.Client).Account.Environment
+		synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+		if !ok {
+			return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+		}
+
+		libraryClient, err = meta.(*clients.Client).Synapse.LibraryClient(id.WorkspaceName, *synapseDomainSuffix)
+		if err != nil {
+			return err
+		}
+	}
+
+	var customLibraries *[]synapse.LibraryInfo
+	if libraryClient != nil {
+		oldHashes, newHashes := d.GetChange("source_content_hashes")
+		libraries, err := uploadSparkPoolWorkspacePackages(ctx, libraryClient, packages, oldHashes.(map[string]interface{}), newHashes.(map[string]interface{}), d.IsNewResource())
+		if err != nil {
+			return fmt.Errorf("uploading workspace packages for %s: %+v", *id, err)
+		}
+		customLibraries = &libraries
+	}
+
 	autoScale := expandArmSparkPoolAutoScaleProperties(d.Get("auto_scale").([]interface{}))
 	bigDataPoolInfo := synapse.BigDataPoolResourceInfo{
 		Location: workspace.Location,
@@ -407,7 +497,8 @@ func resourceSynapseSparkPoolUpdate(d *pluginsdk.ResourceData, meta interface{})
 				MaxExecutors: utils.Int32(int32(d.Get("max_executors").(int))),
 			},
 			DefaultSparkLogFolder:       utils.String(d.Get("spark_log_folder").(string)),
-			LibraryRequirements:         expandArmSparkPoolLibraryRequirements(d.Get("library_requirement").([]interface{})),
+			CustomLibraries:             customLibraries,
+			LibraryRequirements:         libraryRequirements,
 			NodeSize:                    synapse.NodeSize(d.Get("node_size").(string)),
 			NodeSizeFamily:              synapse.NodeSizeFamily(d.Get("node_size_family").(string)),
 			SessionLevelPackagesEnabled: utils.Bool(d.Get("session_level_packages_enabled").(bool)),
@@ -431,6 +522,14 @@ func resourceSynapseSparkPoolUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
 	}
 
+	// packages can only be removed from the workspace once they're no longer referenced by the Spark Pool
+	if libraryClient != nil && d.HasChange("workspace_package") {
+		oldPackages, newPackages := d.GetChange("workspace_package")
+		if err := deleteSparkPoolWorkspacePackages(ctx, libraryClient, oldPackages.([]interface{}), newPackages.([]interface{})); err != nil {
+			return fmt.Errorf("removing workspace packages for %s: %+v", *id, err)
+		}
+	}
+
 	return resourceSynapseSparkPoolRead(d, meta)
 }
 
@@ -453,6 +552,206 @@ func resourceSynapseSparkPoolDelete(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
 	}
 
+	if packages := d.Get("workspace_package").([]interface{}); len(packages) > 0 {
+		environment := meta.(*clients.Client).Account.Environment
+		synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+		if !ok {
+			return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+		}
+
+		libraryClient, err := meta.(*clients.Client).Synapse.LibraryClient(id.WorkspaceName, *synapseDomainSuffix)
+		if err != nil {
+			return err
+		}
+
+		if err := deleteSparkPoolWorkspacePackages(ctx, libraryClient, packages, []interface{}{}); err != nil {
+			return fmt.Errorf("removing workspace packages for %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceSynapseSparkPoolCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the contents of local files aren't tracked by Terraform, so we hash them to ensure they're only re-uploaded when they change
+	if !d.NewValueKnown("library_requirement") || !d.NewValueKnown("workspace_package") {
+		return d.SetNewComputed("source_content_hashes")
+	}
+
+	hashes, err := sparkPoolSourceContentHashes(d.Get("library_requirement").([]interface{}), d.Get("workspace_package").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	old, _ := d.GetChange("source_content_hashes")
+	if !reflect.DeepEqual(old.(map[string]interface{}), hashes) {
+		return d.SetNew("source_content_hashes", hashes)
+	}
+
+	return nil
+}
+
+func sparkPoolSourceContentHashes(libraryRequirements []interface{}, packages []interface{}) (map[string]interface{}, error) {
+	sources := make([]string, 0)
+	if len(libraryRequirements) > 0 && libraryRequirements[0] != nil {
+		if source := libraryRequirements[0].(map[string]interface{})["source"].(string); source != "" {
+			sources = append(sources, source)
+		}
+	}
+	for _, raw := range packages {
+		if raw == nil {
+			continue
+		}
+		sources = append(sources, raw.(map[string]interface{})["source"].(string))
+	}
+
+	hashes := make(map[string]interface{})
+	for _, source := range sources {
+		content, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("reading %q: %+v", source, err)
+		}
+		hash := sha256.Sum256(content)
+		hashes[source] = hex.EncodeToString(hash[:])
+	}
+
+	return hashes, nil
+}
+
+func uploadSparkPoolWorkspacePackages(ctx context.Context, client *artifacts.LibraryClient, packages []interface{}, oldHashes map[string]interface{}, newHashes map[string]interface{}, isNewResource bool) ([]synapse.LibraryInfo, error) {
+	libraries := make([]synapse.LibraryInfo, 0)
+	for _, raw := range packages {
+		if raw == nil {
+			continue
+		}
+		source := raw.(map[string]interface{})["source"].(string)
+		name := filepath.Base(source)
+
+		existing, err := client.Get(ctx, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return nil, fmt.Errorf("retrieving workspace package %q: %+v", name, err)
+		}
+		exists := !utils.ResponseWasNotFound(existing.Response)
+
+		if !exists || isNewResource || oldHashes[source] != newHashes[source] {
+			if exists {
+				if err := deleteSparkPoolWorkspacePackage(ctx, client, name); err != nil {
+					return nil, err
+				}
+			}
+
+			if err := uploadSparkPoolWorkspacePackage(ctx, client, name, source); err != nil {
+				return nil, err
+			}
+
+			existing, err = client.Get(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving workspace package %q: %+v", name, err)
+			}
+		}
+
+		libraries = append(libraries, expandSparkPoolLibraryInfo(name, existing.Properties))
+	}
+
+	return libraries, nil
+}
+
+func uploadSparkPoolWorkspacePackage(ctx context.Context, client *artifacts.LibraryClient, name string, source string) error {
+	content, err := os.ReadFile(source)
+	if err != nil {
+		return fmt.Errorf("reading %q: %+v", source, err)
+	}
+
+	createFuture, err := client.Create(ctx, name)
+	if err != nil {
+		return fmt.Errorf("creating workspace package %q: %+v", name, err)
+	}
+	if err := createFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of workspace package %q: %+v", name, err)
+	}
+
+	for position := 0; position < len(content); position += sparkPoolWorkspacePackageChunkSize {
+		end := position + sparkPoolWorkspacePackageChunkSize
+		if end > len(content) {
+			end = len(content)
+		}
+
+		// the SDK serializes the content as a JSON string, so the binary chunk is sent as the raw request body instead
+		offset := int64(position)
+		req, err := client.AppendPreparer(ctx, name, "", &offset)
+		if err != nil {
+			return fmt.Errorf("preparing append to workspace package %q: %+v", name, err)
+		}
+		chunk := content[position:end]
+		req.Body = io.NopCloser(bytes.NewReader(chunk))
+		req.ContentLength = int64(len(chunk))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(chunk)), nil
+		}
+
+		resp, err := client.AppendSender(req)
+		if err != nil {
+			return fmt.Errorf("appending to workspace package %q: %+v", name, err)
+		}
+		if _, err := client.AppendResponder(resp); err != nil {
+			return fmt.Errorf("appending to workspace package %q: %+v", name, err)
+		}
+	}
+
+	flushFuture, err := client.Flush(ctx, name)
+	if err != nil {
+		return fmt.Errorf("flushing workspace package %q: %+v", name, err)
+	}
+	if err := flushFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for flush of workspace package %q: %+v", name, err)
+	}
+
+	return nil
+}
+
+func deleteSparkPoolWorkspacePackages(ctx context.Context, client *artifacts.LibraryClient, oldPackages []interface{}, newPackages []interface{}) error {
+	retained := make(map[string]bool)
+	for _, raw := range newPackages {
+		if raw == nil {
+			continue
+		}
+		retained[filepath.Base(raw.(map[string]interface{})["source"].(string))] = true
+	}
+
+	for _, raw := range oldPackages {
+		if raw == nil {
+			continue
+		}
+		name := filepath.Base(raw.(map[string]interface{})["source"].(string))
+		if retained[name] {
+			continue
+		}
+
+		if err := deleteSparkPoolWorkspacePackage(ctx, client, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteSparkPoolWorkspacePackage(ctx context.Context, client *artifacts.LibraryClient, name string) error {
+	existing, err := client.Get(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving workspace package %q: %+v", name, err)
+	}
+
+	future, err := client.Delete(ctx, name)
+	if err != nil {
+		return fmt.Errorf("deleting workspace package %q: %+v", name, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of workspace package %q: %+v", name, err)
+	}
+
 	return nil
 }
 
@@ -483,15 +782,48 @@ func expandArmSparkPoolAutoScaleProperties(input []interface{}) *synapse.AutoSca
 	}
 }
 
-func expandArmSparkPoolLibraryRequirements(input []interface{}) *synapse.LibraryRequirements {
+func expandArmSparkPoolLibraryRequirements(input []interface{}) (*synapse.LibraryRequirements, error) {
 	if len(input) == 0 || input[0] == nil {
-		return nil
+		return nil, nil
 	}
 	v := input[0].(map[string]interface{})
+
+	content := v["content"].(string)
+	if source := v["source"].(string); source != "" {
+		contents, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("reading `library_requirement.0.source` %q: %+v", source, err)
+		}
+		content = string(contents)
+	}
+
 	return &synapse.LibraryRequirements{
-		Content:  utils.String(v["content"].(string)),
+		Content:  utils.String(content),
 		Filename: utils.String(v["filename"].(string)),
+	}, nil
+}
+
+func expandSparkPoolLibraryInfo(name string, input *artifacts.LibraryResourceProperties) synapse.LibraryInfo {
+	library := synapse.LibraryInfo{
+		Name: utils.String(name),
+		Type: utils.String(strings.TrimPrefix(filepath.Ext(name), ".")),
 	}
+	if input == nil {
+		return library
+	}
+
+	library.ContainerName = input.ContainerName
+	library.Path = input.Path
+	if input.Type != nil {
+		library.Type = input.Type
+	}
+	if input.UploadedTimestamp != nil {
+		if uploaded, err := time.Parse(time.RFC3339, *input.UploadedTimestamp); err == nil {
+			library.UploadedTimestamp = &date.Time{Time: uploaded}
+		}
+	}
+
+	return library
 }
 
 func expandSparkPoolSparkConfig(input []interface{}) *synapse.SparkConfigProperties {
@@ -560,7 +892,7 @@ func flattenArmSparkPoolAutoScaleProperties(input *synapse.AutoScaleProperties)
 	}
 }
 
-func flattenArmSparkPoolLibraryRequirements(input *synapse.LibraryRequirements) []interface{} {
+func flattenArmSparkPoolLibraryRequirements(input *synapse.LibraryRequirements, existing []interface{}) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
@@ -573,14 +905,58 @@ func flattenArmSparkPoolLibraryRequirements(input *synapse.LibraryRequirements)
 	if input.Filename != nil {
 		filename = *input.Filename
 	}
+	// the source file isn't returned by the API, so we pull it from the existing state
+	var source string
+	if len(existing) > 0 && existing[0] != nil {
+		source = existing[0].(map[string]interface{})["source"].(string)
+	}
 	return []interface{}{
 		map[string]interface{}{
 			"content":  content,
 			"filename": filename,
+			"source":   source,
 		},
 	}
 }
 
+func flattenSparkPoolWorkspacePackages(input *[]synapse.LibraryInfo, existing []interface{}) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	libraries := make(map[string]synapse.LibraryInfo)
+	for _, v := range *input {
+		if v.Name != nil {
+			libraries[*v.Name] = v
+		}
+	}
+
+	// only packages uploaded from a local source are managed by this resource
+	for _, raw := range existing {
+		if raw == nil {
+			continue
+		}
+		source := raw.(map[string]interface{})["source"].(string)
+		library, ok := libraries[filepath.Base(source)]
+		if !ok {
+			continue
+		}
+
+		var libraryType string
+		if library.Type != nil {
+			libraryType = *library.Type
+		}
+		output = append(output, map[string]interface{}{
+			"name":   *library.Name,
+			"source": source,
+			"type":   libraryType,
+		})
+	}
+
+	return output
+}
+
 func flattenSparkPoolSparkConfig(input *synapse.SparkConfigProperties) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
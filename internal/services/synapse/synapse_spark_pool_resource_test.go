@@ -4,8 +4,11 @@
 package synapse_test
 
 import (
+	"archive/zip"
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -187,6 +190,44 @@ func TestAccSynapseSpark3Pool_update(t *testing.T) {
 	})
 }
 
+func TestAccSynapseSparkPool_librarySources(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_spark_pool", "test")
+	r := SynapseSparkPoolResource{}
+
+	requirements := filepath.Join(t.TempDir(), "requirements.txt")
+	if err := os.WriteFile(requirements, []byte("appnope==0.1.0\n"), 0o600); err != nil {
+		t.Fatalf("writing requirements file: %+v", err)
+	}
+	workspacePackage := filepath.Join(t.TempDir(), fmt.Sprintf("acctest%s.jar", data.RandomString))
+	if err := writeTestJar(workspacePackage); err != nil {
+		t.Fatalf("writing workspace package: %+v", err)
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.librarySources(data, requirements, workspacePackage),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("library_requirement.0.content").HasValue("appnope==0.1.0\n"),
+				check.That(data.ResourceName).Key("workspace_package.0.name").HasValue(filepath.Base(workspacePackage)),
+			),
+		},
+		data.ImportStep("spark_events_folder", "spark_log_folder", "library_requirement.0.source", "workspace_package", "source_content_hashes"),
+		{
+			PreConfig: func() {
+				if err := os.WriteFile(requirements, []byte("appnope==0.1.0\nbeautifulsoup4==4.6.3\n"), 0o600); err != nil {
+					t.Fatalf("updating requirements file: %+v", err)
+				}
+			},
+			Config: r.librarySources(data, requirements, workspacePackage),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("library_requirement.0.content").HasValue("appnope==0.1.0\nbeautifulsoup4==4.6.3\n"),
+			),
+		},
+	})
+}
+
 func (r SynapseSparkPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SparkPoolID(state.ID)
 	if err != nil {
@@ -301,6 +342,31 @@ EOF
 `, template, data.RandomString, sparkVersion)
 }
 
+func (r SynapseSparkPoolResource) librarySources(data acceptance.TestData, requirements string, workspacePackage string) string {
+	template := r.template(data, data.Locations.Primary)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_spark_pool" "test" {
+  name                 = "acctestSSP%s"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  node_size_family     = "MemoryOptimized"
+  node_size            = "Small"
+  node_count           = 3
+  spark_version        = "3.4"
+
+  library_requirement {
+    source   = "%s"
+    filename = "requirements.txt"
+  }
+
+  workspace_package {
+    source = "%s"
+  }
+}
+`, template, data.RandomString, requirements, workspacePackage)
+}
+
 func (r SynapseSparkPoolResource) isolation(data acceptance.TestData) string {
 	template := r.template(data, "East US")
 	return fmt.Sprintf(`
@@ -356,3 +422,22 @@ resource "azurerm_synapse_workspace" "test" {
 }
 `, data.RandomInteger, location, data.RandomString, data.RandomInteger, data.RandomInteger)
 }
+
+func writeTestJar(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	manifest, err := writer.Create("META-INF/MANIFEST.MF")
+	if err != nil {
+		return err
+	}
+	if _, err := manifest.Write([]byte("Manifest-Version: 1.0\n")); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+func SparkPoolWorkspacePackageSource(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// The workspace package is named after the file so:
+	// 1. the file must be a wheel (.whl), jar (.jar) or source distribution (.tar.gz).
+	// 2. the file name can contain only letters, numbers, hyphens, underscores and periods.
+
+	name := filepath.Base(v)
+	if !strings.HasSuffix(name, ".whl") && !strings.HasSuffix(name, ".jar") && !strings.HasSuffix(name, ".tar.gz") {
+		errors = append(errors, fmt.Errorf("%s must be the path to a `.whl`, `.jar` or `.tar.gz` file", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z\d][a-zA-Z\d_.-]*$`).MatchString(name) {
+		errors = append(errors, fmt.Errorf("the file name of %s can contain only letters, numbers, hyphens, underscores and periods", k))
+		return
+	}
+
+	return warnings, errors
+}
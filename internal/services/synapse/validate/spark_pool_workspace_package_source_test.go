@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"testing"
+)
+
+func TestSparkPoolWorkspacePackageSource(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// wheel
+			input:    "dist/example_package-1.0.0-py3-none-any.whl",
+			expected: true,
+		},
+		{
+			// jar
+			input:    "/tmp/example-1.0.jar",
+			expected: true,
+		},
+		{
+			// source distribution
+			input:    "example-1.0.0.tar.gz",
+			expected: true,
+		},
+		{
+			// unsupported extension
+			input:    "requirements.txt",
+			expected: false,
+		},
+		{
+			// can't contain spaces
+			input:    "example package.whl",
+			expected: false,
+		},
+		{
+			// can't start with a period
+			input:    ".example.jar",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := SparkPoolWorkspacePackageSource(v.input, "source")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -9,15 +9,19 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/authorizations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/clusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/datastores"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/hcxenterprisesites"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/placementpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/privateclouds"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	AuthorizationClient *authorizations.AuthorizationsClient
-	ClusterClient       *clusters.ClustersClient
-	PrivateCloudClient  *privateclouds.PrivateCloudsClient
-	DataStoreClient     *datastores.DataStoresClient
+	AuthorizationClient     *authorizations.AuthorizationsClient
+	ClusterClient           *clusters.ClustersClient
+	PrivateCloudClient      *privateclouds.PrivateCloudsClient
+	DataStoreClient         *datastores.DataStoresClient
+	HcxEnterpriseSiteClient *hcxenterprisesites.HcxEnterpriseSitesClient
+	PlacementPolicyClient   *placementpolicies.PlacementPoliciesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -45,10 +49,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(dataStoresClient.Client, o.Authorizers.ResourceManager)
 
+	hcxEnterpriseSiteClient, err := hcxenterprisesites.NewHcxEnterpriseSitesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building HCX Enterprise Site Client: %+v", err)
+	}
+	o.Configure(hcxEnterpriseSiteClient.Client, o.Authorizers.ResourceManager)
+
+	placementPolicyClient, err := placementpolicies.NewPlacementPoliciesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Placement Policy Client: %+v", err)
+	}
+	o.Configure(placementPolicyClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
-		AuthorizationClient: authorizationClient,
-		ClusterClient:       clusterClient,
-		PrivateCloudClient:  privateCloudClient,
-		DataStoreClient:     dataStoresClient,
+		AuthorizationClient:     authorizationClient,
+		ClusterClient:           clusterClient,
+		PrivateCloudClient:      privateCloudClient,
+		DataStoreClient:         dataStoresClient,
+		HcxEnterpriseSiteClient: hcxEnterpriseSiteClient,
+		PlacementPolicyClient:   placementPolicyClient,
 	}, nil
 }
@@ -50,6 +50,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		NetappFileVolumeAttachmentResource{},
+		HcxEnterpriseSiteResource{},
+		VmHostPlacementPolicyResource{},
+		VmVmPlacementPolicyResource{},
 	}
 }
 
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/hcxenterprisesites"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type HcxEnterpriseSiteModel struct {
+	Name           string `tfschema:"name"`
+	PrivateCloudId string `tfschema:"private_cloud_id"`
+	ActivationKey  string `tfschema:"activation_key"`
+	Status         string `tfschema:"status"`
+}
+
+type HcxEnterpriseSiteResource struct{}
+
+var _ sdk.Resource = HcxEnterpriseSiteResource{}
+
+func (r HcxEnterpriseSiteResource) ResourceType() string {
+	return "azurerm_vmware_hcx_enterprise_site"
+}
+
+func (r HcxEnterpriseSiteResource) ModelObject() interface{} {
+	return &HcxEnterpriseSiteModel{}
+}
+
+func (r HcxEnterpriseSiteResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return hcxenterprisesites.ValidateHcxEnterpriseSiteID
+}
+
+func (r HcxEnterpriseSiteResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"private_cloud_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateCloudID,
+		},
+	}
+}
+
+func (r HcxEnterpriseSiteResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"activation_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r HcxEnterpriseSiteResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.HcxEnterpriseSiteClient
+
+			var model HcxEnterpriseSiteModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			privateCloudId, err := hcxenterprisesites.ParsePrivateCloudID(model.PrivateCloudId)
+			if err != nil {
+				return err
+			}
+
+			id := hcxenterprisesites.NewHcxEnterpriseSiteID(privateCloudId.SubscriptionId, privateCloudId.ResourceGroupName, privateCloudId.PrivateCloudName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, hcxenterprisesites.HcxEnterpriseSite{}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r HcxEnterpriseSiteResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.HcxEnterpriseSiteClient
+
+			id, err := hcxenterprisesites.ParseHcxEnterpriseSiteID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := HcxEnterpriseSiteModel{
+				Name:           id.HcxEnterpriseSiteName,
+				PrivateCloudId: hcxenterprisesites.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ActivationKey = pointer.From(props.ActivationKey)
+					state.Status = string(pointer.From(props.Status))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r HcxEnterpriseSiteResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.HcxEnterpriseSiteClient
+
+			id, err := hcxenterprisesites.ParseHcxEnterpriseSiteID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -4,6 +4,7 @@
 package vmware
 
 import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/privateclouds"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -52,3 +53,113 @@ func flattenPrivateCloudCircuit(input *privateclouds.Circuit) []interface{} {
 		},
 	}
 }
+
+func expandPrivateCloudAvailability(input []interface{}) *privateclouds.AvailabilityProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	result := &privateclouds.AvailabilityProperties{
+		Strategy: pointer.To(privateclouds.AvailabilityStrategy(v["strategy"].(string))),
+	}
+
+	if zone := v["zone"].(int); zone != 0 {
+		result.Zone = pointer.To(int64(zone))
+	}
+
+	if secondaryZone := v["secondary_zone"].(int); secondaryZone != 0 {
+		result.SecondaryZone = pointer.To(int64(secondaryZone))
+	}
+
+	return result
+}
+
+func flattenPrivateCloudAvailability(input *privateclouds.AvailabilityProperties) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	var strategy string
+	if input.Strategy != nil {
+		strategy = string(*input.Strategy)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"strategy":       strategy,
+			"zone":           int(pointer.From(input.Zone)),
+			"secondary_zone": int(pointer.From(input.SecondaryZone)),
+		},
+	}
+}
+
+func expandPrivateCloudIdentitySources(input []interface{}) *[]privateclouds.IdentitySource {
+	result := make([]privateclouds.IdentitySource, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		ssl := privateclouds.SslEnumDisabled
+		if v["ssl_enabled"].(bool) {
+			ssl = privateclouds.SslEnumEnabled
+		}
+
+		identitySource := privateclouds.IdentitySource{
+			Name:          pointer.To(v["name"].(string)),
+			Alias:         pointer.To(v["alias"].(string)),
+			Domain:        pointer.To(v["domain"].(string)),
+			BaseGroupDN:   pointer.To(v["base_group_dn"].(string)),
+			BaseUserDN:    pointer.To(v["base_user_dn"].(string)),
+			PrimaryServer: pointer.To(v["primary_server_url"].(string)),
+			Ssl:           pointer.To(ssl),
+			Username:      pointer.To(v["username"].(string)),
+			Password:      pointer.To(v["password"].(string)),
+		}
+
+		if secondaryServer := v["secondary_server_url"].(string); secondaryServer != "" {
+			identitySource.SecondaryServer = pointer.To(secondaryServer)
+		}
+
+		result = append(result, identitySource)
+	}
+
+	return &result
+}
+
+func flattenPrivateCloudIdentitySources(input *[]privateclouds.IdentitySource, existing []interface{}) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	// the password isn't returned by the API, so this is looked up from the existing configuration by name
+	passwords := make(map[string]string)
+	for _, item := range existing {
+		if v, ok := item.(map[string]interface{}); ok {
+			passwords[v["name"].(string)] = v["password"].(string)
+		}
+	}
+
+	for _, item := range *input {
+		name := pointer.From(item.Name)
+
+		results = append(results, map[string]interface{}{
+			"name":                 name,
+			"alias":                pointer.From(item.Alias),
+			"domain":               pointer.From(item.Domain),
+			"base_group_dn":        pointer.From(item.BaseGroupDN),
+			"base_user_dn":         pointer.From(item.BaseUserDN),
+			"primary_server_url":   pointer.From(item.PrimaryServer),
+			"secondary_server_url": pointer.From(item.SecondaryServer),
+			"ssl_enabled":          pointer.From(item.Ssl) == privateclouds.SslEnumEnabled,
+			"username":             pointer.From(item.Username),
+			"password":             passwords[name],
+		})
+	}
+
+	return results
+}
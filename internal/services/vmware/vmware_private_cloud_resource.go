@@ -127,6 +127,108 @@ func resourceVmwarePrivateCloud() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"availability": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"strategy": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(privateclouds.PossibleValuesForAvailabilityStrategy(), false),
+						},
+
+						"zone": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"secondary_zone": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"identity_source": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"alias": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"domain": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"base_group_dn": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"base_user_dn": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"primary_server_url": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithScheme([]string{"ldap", "ldaps"}),
+						},
+
+						"secondary_server_url": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithScheme([]string{"ldap", "ldaps"}),
+						},
+
+						"ssl_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"username": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"circuit": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -235,10 +337,18 @@ func resourceVmwarePrivateCloudCreate(d *pluginsdk.ResourceData, meta interface{
 			Internet:        &internet,
 			NsxtPassword:    utils.String(d.Get("nsxt_password").(string)),
 			VcenterPassword: utils.String(d.Get("vcenter_password").(string)),
+			Availability:    expandPrivateCloudAvailability(d.Get("availability").([]interface{})),
+			IdentitySources: expandPrivateCloudIdentitySources(d.Get("identity_source").([]interface{})),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if availability := privateCloud.Properties.Availability; availability != nil && pointer.From(availability.Strategy) == privateclouds.AvailabilityStrategyDualZone {
+		if availability.Zone == nil || availability.SecondaryZone == nil {
+			return fmt.Errorf("`availability.0.zone` and `availability.0.secondary_zone` must be specified when `availability.0.strategy` is `%s`", privateclouds.AvailabilityStrategyDualZone)
+		}
+	}
+
 	if _, err := client.CreateOrUpdate(ctx, id, privateCloud); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -297,6 +407,12 @@ func resourceVmwarePrivateCloudRead(d *pluginsdk.ResourceData, meta interface{})
 		if err := d.Set("circuit", flattenPrivateCloudCircuit(props.Circuit)); err != nil {
 			return fmt.Errorf("setting `circuit`: %+v", err)
 		}
+		if err := d.Set("availability", flattenPrivateCloudAvailability(props.Availability)); err != nil {
+			return fmt.Errorf("setting `availability`: %+v", err)
+		}
+		if err := d.Set("identity_source", flattenPrivateCloudIdentitySources(props.IdentitySources, d.Get("identity_source").([]interface{}))); err != nil {
+			return fmt.Errorf("setting `identity_source`: %+v", err)
+		}
 
 		internetConnectionEnabled := false
 		if props.Internet != nil {
@@ -355,6 +471,10 @@ func resourceVmwarePrivateCloudUpdate(d *pluginsdk.ResourceData, meta interface{
 		privateCloudUpdate.Properties.Internet = &internet
 	}
 
+	if d.HasChange("identity_source") {
+		privateCloudUpdate.Properties.IdentitySources = expandPrivateCloudIdentitySources(d.Get("identity_source").([]interface{}))
+	}
+
 	if d.HasChange("tags") {
 		privateCloudUpdate.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
@@ -100,6 +100,50 @@ func TestAccVmwarePrivateCloud_update(t *testing.T) {
 	})
 }
 
+func TestAccVmwarePrivateCloud_stretched(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_private_cloud", "test")
+	r := VmwarePrivateCloudResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.stretched(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwarePrivateCloud_identitySource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_private_cloud", "test")
+	r := VmwarePrivateCloudResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.identitySource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("identity_source.0.password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (VmwarePrivateCloudResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := privateclouds.ParsePrivateCloudID(state.ID)
 	if err != nil {
@@ -261,3 +305,57 @@ resource "azurerm_vmware_private_cloud" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r VmwarePrivateCloudResource) stretched(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_private_cloud" "test" {
+  name                = "acctest-PC-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "av36"
+
+  management_cluster {
+    size = 6
+  }
+  network_subnet_cidr = "192.168.48.0/22"
+
+  availability {
+    strategy       = "DualZone"
+    zone           = 1
+    secondary_zone = 2
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VmwarePrivateCloudResource) identitySource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_private_cloud" "test" {
+  name                = "acctest-PC-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "av36"
+
+  management_cluster {
+    size = 3
+  }
+  network_subnet_cidr = "192.168.48.0/22"
+
+  identity_source {
+    name               = "example"
+    alias              = "EXAMPLE"
+    domain             = "example.com"
+    base_group_dn      = "dc=example,dc=com"
+    base_user_dn       = "dc=example,dc=com"
+    primary_server_url = "ldaps://dc1.example.com:636"
+    ssl_enabled        = true
+    username           = "cloudadmin@example.com"
+    password           = "QazWsx13$Edc"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
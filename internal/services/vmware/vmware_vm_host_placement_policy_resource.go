@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/clusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/placementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VmHostPlacementPolicyModel struct {
+	Name                   string   `tfschema:"name"`
+	VmwareClusterId        string   `tfschema:"vmware_cluster_id"`
+	AffinityType           string   `tfschema:"affinity_type"`
+	AffinityStrength       string   `tfschema:"affinity_strength"`
+	AzureHybridBenefitType string   `tfschema:"azure_hybrid_benefit_type"`
+	DisplayName            string   `tfschema:"display_name"`
+	Enabled                bool     `tfschema:"enabled"`
+	HostMembers            []string `tfschema:"host_members"`
+	VirtualMachineIds      []string `tfschema:"virtual_machine_ids"`
+}
+
+type VmHostPlacementPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = VmHostPlacementPolicyResource{}
+
+func (r VmHostPlacementPolicyResource) ResourceType() string {
+	return "azurerm_vmware_vm_host_placement_policy"
+}
+
+func (r VmHostPlacementPolicyResource) ModelObject() interface{} {
+	return &VmHostPlacementPolicyModel{}
+}
+
+func (r VmHostPlacementPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return placementpolicies.ValidatePlacementPolicyID
+}
+
+func (r VmHostPlacementPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"vmware_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ClusterID,
+		},
+
+		"affinity_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(placementpolicies.PossibleValuesForAffinityType(), false),
+		},
+
+		"host_members": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"virtual_machine_ids": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: placementpolicies.ValidateVirtualMachineID,
+			},
+		},
+
+		"affinity_strength": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(placementpolicies.PossibleValuesForAffinityStrength(), false),
+		},
+
+		"azure_hybrid_benefit_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(placementpolicies.AzureHybridBenefitTypeNone),
+			ValidateFunc: validation.StringInSlice(placementpolicies.PossibleValuesForAzureHybridBenefitType(), false),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r VmHostPlacementPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VmHostPlacementPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.PlacementPolicyClient
+
+			var model VmHostPlacementPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := clusters.ParseClusterID(model.VmwareClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := placementpolicies.NewPlacementPolicyID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.PrivateCloudName, clusterId.ClusterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := placementpolicies.VMHostPlacementPolicyProperties{
+				AffinityType:           placementpolicies.AffinityType(model.AffinityType),
+				AzureHybridBenefitType: pointer.To(placementpolicies.AzureHybridBenefitType(model.AzureHybridBenefitType)),
+				HostMembers:            model.HostMembers,
+				VMMembers:              model.VirtualMachineIds,
+				State:                  expandPlacementPolicyState(model.Enabled),
+			}
+
+			if model.AffinityStrength != "" {
+				properties.AffinityStrength = pointer.To(placementpolicies.AffinityStrength(model.AffinityStrength))
+			}
+
+			if model.DisplayName != "" {
+				properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, placementpolicies.PlacementPolicy{Properties: properties}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VmHostPlacementPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.PlacementPolicyClient
+
+			id, err := placementpolicies.ParsePlacementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VmHostPlacementPolicyModel{
+				Name:            id.PlacementPolicyName,
+				VmwareClusterId: clusters.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName, id.ClusterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props, ok := model.Properties.(placementpolicies.VMHostPlacementPolicyProperties)
+				if !ok {
+					return fmt.Errorf("retrieving %s: expected a VM-Host Placement Policy but got %+v", *id, model.Properties)
+				}
+
+				state.AffinityType = string(props.AffinityType)
+				state.AffinityStrength = string(pointer.From(props.AffinityStrength))
+				state.AzureHybridBenefitType = string(placementpolicies.AzureHybridBenefitTypeNone)
+				if props.AzureHybridBenefitType != nil {
+					state.AzureHybridBenefitType = string(*props.AzureHybridBenefitType)
+				}
+				state.DisplayName = pointer.From(props.DisplayName)
+				state.Enabled = pointer.From(props.State) == placementpolicies.PlacementPolicyStateEnabled
+				state.HostMembers = props.HostMembers
+				state.VirtualMachineIds = props.VMMembers
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VmHostPlacementPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.PlacementPolicyClient
+
+			id, err := placementpolicies.ParsePlacementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VmHostPlacementPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := &placementpolicies.PlacementPolicyUpdateProperties{}
+
+			if metadata.ResourceData.HasChange("affinity_strength") {
+				properties.AffinityStrength = pointer.To(placementpolicies.AffinityStrength(model.AffinityStrength))
+			}
+
+			if metadata.ResourceData.HasChange("azure_hybrid_benefit_type") {
+				properties.AzureHybridBenefitType = pointer.To(placementpolicies.AzureHybridBenefitType(model.AzureHybridBenefitType))
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				properties.State = expandPlacementPolicyState(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("host_members") {
+				properties.HostMembers = pointer.To(model.HostMembers)
+			}
+
+			if metadata.ResourceData.HasChange("virtual_machine_ids") {
+				properties.VMMembers = pointer.To(model.VirtualMachineIds)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, placementpolicies.PlacementPolicyUpdate{Properties: properties}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VmHostPlacementPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.PlacementPolicyClient
+
+			id, err := placementpolicies.ParsePlacementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPlacementPolicyState(enabled bool) *placementpolicies.PlacementPolicyState {
+	if enabled {
+		return pointer.To(placementpolicies.PlacementPolicyStateEnabled)
+	}
+
+	return pointer.To(placementpolicies.PlacementPolicyStateDisabled)
+}
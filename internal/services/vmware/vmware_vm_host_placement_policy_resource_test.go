@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/placementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VmwareVmHostPlacementPolicyResource struct{}
+
+func TestAccVmwareVmHostPlacementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_vm_host_placement_policy", "test")
+	r := VmwareVmHostPlacementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwareVmHostPlacementPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_vm_host_placement_policy", "test")
+	r := VmwareVmHostPlacementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVmwareVmHostPlacementPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_vm_host_placement_policy", "test")
+	r := VmwareVmHostPlacementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VmwareVmHostPlacementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := placementpolicies.ParsePlacementPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.PlacementPolicyClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r VmwareVmHostPlacementPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_vm_host_placement_policy" "test" {
+  name                = "acctest-VmHostPolicy-%d"
+  vmware_cluster_id   = "${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1"
+  affinity_type       = "Affinity"
+  host_members        = [azurerm_vmware_private_cloud.test.management_cluster.0.hosts[0]]
+  virtual_machine_ids = ["${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1/virtualMachines/vm-101"]
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger)
+}
+
+func (r VmwareVmHostPlacementPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_vm_host_placement_policy" "import" {
+  name                = azurerm_vmware_vm_host_placement_policy.test.name
+  vmware_cluster_id   = azurerm_vmware_vm_host_placement_policy.test.vmware_cluster_id
+  affinity_type       = azurerm_vmware_vm_host_placement_policy.test.affinity_type
+  host_members        = azurerm_vmware_vm_host_placement_policy.test.host_members
+  virtual_machine_ids = azurerm_vmware_vm_host_placement_policy.test.virtual_machine_ids
+}
+`, r.basic(data))
+}
+
+func (r VmwareVmHostPlacementPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_vm_host_placement_policy" "test" {
+  name                      = "acctest-VmHostPolicy-%d"
+  vmware_cluster_id         = "${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1"
+  affinity_type             = "Affinity"
+  affinity_strength         = "Must"
+  azure_hybrid_benefit_type = "SqlHost"
+  enabled                   = false
+  host_members              = slice(azurerm_vmware_private_cloud.test.management_cluster.0.hosts, 0, 2)
+  virtual_machine_ids = [
+    "${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1/virtualMachines/vm-101",
+    "${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1/virtualMachines/vm-102",
+  ]
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger)
+}
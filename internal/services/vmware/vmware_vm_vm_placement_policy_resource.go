@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/clusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/placementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VmVmPlacementPolicyModel struct {
+	Name              string   `tfschema:"name"`
+	VmwareClusterId   string   `tfschema:"vmware_cluster_id"`
+	AffinityType      string   `tfschema:"affinity_type"`
+	DisplayName       string   `tfschema:"display_name"`
+	Enabled           bool     `tfschema:"enabled"`
+	VirtualMachineIds []string `tfschema:"virtual_machine_ids"`
+}
+
+type VmVmPlacementPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = VmVmPlacementPolicyResource{}
+
+func (r VmVmPlacementPolicyResource) ResourceType() string {
+	return "azurerm_vmware_vm_vm_placement_policy"
+}
+
+func (r VmVmPlacementPolicyResource) ModelObject() interface{} {
+	return &VmVmPlacementPolicyModel{}
+}
+
+func (r VmVmPlacementPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return placementpolicies.ValidatePlacementPolicyID
+}
+
+func (r VmVmPlacementPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"vmware_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ClusterID,
+		},
+
+		"affinity_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(placementpolicies.PossibleValuesForAffinityType(), false),
+		},
+
+		"virtual_machine_ids": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 2,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: placementpolicies.ValidateVirtualMachineID,
+			},
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r VmVmPlacementPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VmVmPlacementPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.PlacementPolicyClient
+
+			var model VmVmPlacementPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := clusters.ParseClusterID(model.VmwareClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := placementpolicies.NewPlacementPolicyID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.PrivateCloudName, clusterId.ClusterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := placementpolicies.VMVMPlacementPolicyProperties{
+				AffinityType: placementpolicies.AffinityType(model.AffinityType),
+				VMMembers:    model.VirtualMachineIds,
+				State:        expandPlacementPolicyState(model.Enabled),
+			}
+
+			if model.DisplayName != "" {
+				properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, placementpolicies.PlacementPolicy{Properties: properties}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VmVmPlacementPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.PlacementPolicyClient
+
+			id, err := placementpolicies.ParsePlacementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VmVmPlacementPolicyModel{
+				Name:            id.PlacementPolicyName,
+				VmwareClusterId: clusters.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName, id.ClusterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props, ok := model.Properties.(placementpolicies.VMVMPlacementPolicyProperties)
+				if !ok {
+					return fmt.Errorf("retrieving %s: expected a VM-VM Placement Policy but got %+v", *id, model.Properties)
+				}
+
+				state.AffinityType = string(props.AffinityType)
+				state.DisplayName = pointer.From(props.DisplayName)
+				state.Enabled = pointer.From(props.State) == placementpolicies.PlacementPolicyStateEnabled
+				state.VirtualMachineIds = props.VMMembers
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VmVmPlacementPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.PlacementPolicyClient
+
+			id, err := placementpolicies.ParsePlacementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VmVmPlacementPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := &placementpolicies.PlacementPolicyUpdateProperties{}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				properties.State = expandPlacementPolicyState(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("virtual_machine_ids") {
+				properties.VMMembers = pointer.To(model.VirtualMachineIds)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, placementpolicies.PlacementPolicyUpdate{Properties: properties}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VmVmPlacementPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.PlacementPolicyClient
+
+			id, err := placementpolicies.ParsePlacementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/placementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VmwareVmVmPlacementPolicyResource struct{}
+
+func TestAccVmwareVmVmPlacementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_vm_vm_placement_policy", "test")
+	r := VmwareVmVmPlacementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwareVmVmPlacementPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_vm_vm_placement_policy", "test")
+	r := VmwareVmVmPlacementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVmwareVmVmPlacementPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_vm_vm_placement_policy", "test")
+	r := VmwareVmVmPlacementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VmwareVmVmPlacementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := placementpolicies.ParsePlacementPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.PlacementPolicyClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r VmwareVmVmPlacementPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_vm_vm_placement_policy" "test" {
+  name              = "acctest-VmVmPolicy-%d"
+  vmware_cluster_id = "${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1"
+  affinity_type     = "AntiAffinity"
+  virtual_machine_ids = [
+    "${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1/virtualMachines/vm-101",
+    "${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1/virtualMachines/vm-102",
+  ]
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger)
+}
+
+func (r VmwareVmVmPlacementPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_vm_vm_placement_policy" "import" {
+  name                = azurerm_vmware_vm_vm_placement_policy.test.name
+  vmware_cluster_id   = azurerm_vmware_vm_vm_placement_policy.test.vmware_cluster_id
+  affinity_type       = azurerm_vmware_vm_vm_placement_policy.test.affinity_type
+  virtual_machine_ids = azurerm_vmware_vm_vm_placement_policy.test.virtual_machine_ids
+}
+`, r.basic(data))
+}
+
+func (r VmwareVmVmPlacementPolicyResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_vm_vm_placement_policy" "test" {
+  name              = "acctest-VmVmPolicy-%d"
+  vmware_cluster_id = "${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1"
+  affinity_type     = "AntiAffinity"
+  enabled           = false
+  virtual_machine_ids = [
+    "${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1/virtualMachines/vm-101",
+    "${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1/virtualMachines/vm-102",
+    "${azurerm_vmware_private_cloud.test.id}/clusters/Cluster-1/virtualMachines/vm-103",
+  ]
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger)
+}
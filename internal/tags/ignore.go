@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import "strings"
+
+// IgnoreConfig defines the tags which are managed outside of Terraform (for example by Azure Policy) and which
+// should therefore be ignored when reading the tags for a resource
+type IgnoreConfig struct {
+	// Keys is a list of tag keys which should be ignored
+	Keys []string
+
+	// KeyPrefixes is a list of prefixes, where any tag key starting with one of these prefixes should be ignored
+	KeyPrefixes []string
+}
+
+// Enabled returns whether any tags should be ignored
+func (c IgnoreConfig) Enabled() bool {
+	return len(c.Keys) > 0 || len(c.KeyPrefixes) > 0
+}
+
+// IsIgnored returns whether the tag with the specified key should be ignored - since tag keys are case-insensitive in
+// Azure, this comparison is case-insensitive
+func (c IgnoreConfig) IsIgnored(key string) bool {
+	for _, k := range c.Keys {
+		if k != "" && strings.EqualFold(k, key) {
+			return true
+		}
+	}
+
+	for _, prefix := range c.KeyPrefixes {
+		if prefix != "" && strings.HasPrefix(strings.ToLower(key), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RemoveIgnored returns a copy of the tags without any ignored tags, alongside whether any tags were removed
+func (c IgnoreConfig) RemoveIgnored(input map[string]interface{}) (map[string]interface{}, bool) {
+	output := make(map[string]interface{}, len(input))
+	removed := false
+	for k, v := range input {
+		if c.IsIgnored(k) {
+			removed = true
+			continue
+		}
+		output[k] = v
+	}
+
+	return output, removed
+}
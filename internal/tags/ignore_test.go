@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIgnoreConfigIsIgnored(t *testing.T) {
+	config := IgnoreConfig{
+		Keys:        []string{"CreatedBy", ""},
+		KeyPrefixes: []string{"hidden-", ""},
+	}
+
+	testData := []struct {
+		key      string
+		expected bool
+	}{
+		{key: "CreatedBy", expected: true},
+		{key: "createdby", expected: true},
+		{key: "CreatedByUser", expected: false},
+		{key: "hidden-link", expected: true},
+		{key: "Hidden-Link", expected: true},
+		{key: "hidden", expected: false},
+		{key: "environment", expected: false},
+		{key: "", expected: false},
+	}
+
+	for _, v := range testData {
+		if actual := config.IsIgnored(v.key); actual != v.expected {
+			t.Fatalf("expected IsIgnored(%q) to be %t but got %t", v.key, v.expected, actual)
+		}
+	}
+}
+
+func TestIgnoreConfigRemoveIgnored(t *testing.T) {
+	config := IgnoreConfig{
+		Keys:        []string{"CreatedBy"},
+		KeyPrefixes: []string{"hidden-"},
+	}
+
+	input := map[string]interface{}{
+		"environment": "production",
+		"createdBy":   "policy",
+		"hidden-link": "/subscriptions/...",
+	}
+
+	actual, removed := config.RemoveIgnored(input)
+	expected := map[string]interface{}{
+		"environment": "production",
+	}
+	if !removed {
+		t.Fatalf("expected tags to be removed")
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	if _, removed := config.RemoveIgnored(expected); removed {
+		t.Fatalf("expected no tags to be removed")
+	}
+
+	if (IgnoreConfig{}).Enabled() {
+		t.Fatalf("expected an empty IgnoreConfig to be disabled")
+	}
+	if !config.Enabled() {
+		t.Fatalf("expected the IgnoreConfig to be enabled")
+	}
+}
@@ -6,7 +6,10 @@ package pluginsdk
 import (
 	"context"
 	"fmt"
+	"log"
 	"sort"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
 type StateUpgraderFunc = func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error)
@@ -59,3 +62,37 @@ func StateUpgrades(upgrades map[int]StateUpgrade) []StateUpgrader {
 	}
 	return out
 }
+
+// ResourceIdCasingUpgradeFunc returns a StateUpgraderFunc which parses the `id` field in the raw state insensitively
+// as the Resource ID type `T`, and then replaces it with the correctly-cased ID - for example:
+//
+//	pluginsdk.ResourceIdCasingUpgradeFunc[components.ComponentId]()
+//
+// Since the ID is parsed using the Segments defined on `T`, this can be used for any Resource ID type, rather than
+// each resource needing to parse (and re-format) its own ID.
+func ResourceIdCasingUpgradeFunc[T any, PT interface {
+	*T
+	resourceids.ResourceId
+}]() StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		oldId, ok := rawState["id"].(string)
+		if !ok || oldId == "" {
+			return rawState, nil
+		}
+
+		id := PT(new(T))
+		parsed, err := resourceids.NewParserFromResourceIdType(id).Parse(oldId, true)
+		if err != nil {
+			return rawState, fmt.Errorf("parsing %q: %+v", oldId, err)
+		}
+		if err := id.FromParseResult(*parsed); err != nil {
+			return rawState, fmt.Errorf("parsing %q: %+v", oldId, err)
+		}
+
+		newId := id.ID()
+		log.Printf("[DEBUG] Updating ID from %q to %q", oldId, newId)
+		rawState["id"] = newId
+
+		return rawState, nil
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+func TestResourceIdCasingUpgradeFunc(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+		error    bool
+	}{
+		{
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group",
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group",
+		},
+		{
+			input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/resourcegroups/example-resource-group",
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group",
+		},
+		{
+			// the casing of the user-specified values should be kept
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/Example-Resource-Group",
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Example-Resource-Group",
+		},
+		{
+			input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			error: true,
+		},
+	}
+
+	upgrade := ResourceIdCasingUpgradeFunc[commonids.ResourceGroupId]()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, err := upgrade(context.TODO(), map[string]interface{}{"id": v.input}, nil)
+		if err != nil {
+			if v.error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual["id"] != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual["id"])
+		}
+	}
+}
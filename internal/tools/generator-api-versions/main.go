@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// apiVersionRegex matches the API Version segment within the import path of an SDK package, for example:
+// `github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/publicipaddresses`
+var apiVersionRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-[a-z]+)?$`)
+
+type apiVersion struct {
+	service string
+	version string
+}
+
+func main() {
+	servicesPath := flag.String("services", "", "The relative path to the `internal/services` directory")
+	outputPath := flag.String("output", "", "The relative path to the file which should be generated")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if *servicesPath == "" || *outputPath == "" {
+		fmt.Println("both `-services` and `-output` must be specified")
+		os.Exit(1)
+	}
+
+	if err := run(*servicesPath, *outputPath); err != nil {
+		panic(err)
+	}
+}
+
+func run(servicesPath, outputPath string) error {
+	registrations, err := filepath.Glob(filepath.Join(servicesPath, "*", "registration.go"))
+	if err != nil {
+		return fmt.Errorf("finding the Service Registrations within %q: %+v", servicesPath, err)
+	}
+
+	resources := make(map[string][]apiVersion)
+	for _, registration := range registrations {
+		found, err := parseService(filepath.Dir(registration))
+		if err != nil {
+			return err
+		}
+		for resourceType, versions := range found {
+			resources[resourceType] = versions
+		}
+	}
+
+	output, err := render(resources)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(outputPath, output, 0o644)
+}
+
+// service is the information parsed from the (non-test) files within a Service Package
+type service struct {
+	// apiVersions is the API Versions imported by each file
+	apiVersions map[string][]apiVersion
+
+	// functionFiles is the file which declares each top-level function
+	functionFiles map[string]string
+
+	// typeFiles is the files which declare methods on each type
+	typeFiles map[string][]string
+
+	// fieldTypes is the (package-local) types of the fields within each struct, which allows for Resources which
+	// are implemented using a shared base type (e.g. `base costManagementExportBaseResource`)
+	fieldTypes map[string][]string
+
+	// typedResourceTypes is the value returned from the `ResourceType` method on each type
+	typedResourceTypes map[string]string
+
+	// untypedResources is the function returning each (untyped) Resource, from `SupportedResources`
+	untypedResources map[string]string
+
+	// typedResources is the type of each (typed) Resource, from `Resources`
+	typedResources []string
+}
+
+func parseService(directory string) (map[string][]apiVersion, error) {
+	fileSet := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(directory, "*.go"))
+	if err != nil {
+		return nil, fmt.Errorf("finding the files within %q: %+v", directory, err)
+	}
+
+	svc := service{
+		apiVersions:        make(map[string][]apiVersion),
+		functionFiles:      make(map[string]string),
+		typeFiles:          make(map[string][]string),
+		fieldTypes:         make(map[string][]string),
+		typedResourceTypes: make(map[string]string),
+		untypedResources:   make(map[string]string),
+		typedResources:     make([]string, 0),
+	}
+	for _, fileName := range files {
+		if strings.HasSuffix(fileName, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, fileName, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", fileName, err)
+		}
+		svc.parseFile(fileName, file)
+	}
+
+	resources := make(map[string][]apiVersion)
+	for resourceType, function := range svc.untypedResources {
+		if file, ok := svc.functionFiles[function]; ok {
+			resources[resourceType] = svc.apiVersions[file]
+		}
+	}
+	for _, typeName := range svc.typedResources {
+		resourceType, ok := svc.typedResourceTypes[typeName]
+		if !ok {
+			continue
+		}
+
+		resources[resourceType] = svc.typeApiVersions(typeName, make(map[string]struct{}))
+	}
+
+	for resourceType, versions := range resources {
+		resources[resourceType] = normalize(versions)
+	}
+
+	return resources, nil
+}
+
+func (s *service) parseFile(fileName string, file *ast.File) {
+	for _, imp := range file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		if v := parseApiVersion(path); v != nil {
+			s.apiVersions[fileName] = append(s.apiVersions[fileName], *v)
+		}
+	}
+
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+			s.parseTypes(gen)
+			continue
+		}
+
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		if fn.Recv == nil {
+			s.functionFiles[fn.Name.Name] = fileName
+			continue
+		}
+
+		typeName := receiverTypeName(fn.Recv)
+		if typeName == "" {
+			continue
+		}
+		if !contains(s.typeFiles[typeName], fileName) {
+			s.typeFiles[typeName] = append(s.typeFiles[typeName], fileName)
+		}
+
+		switch fn.Name.Name {
+		case "ResourceType":
+			if v := returnedString(fn); v != "" {
+				s.typedResourceTypes[typeName] = v
+			}
+
+		case "SupportedResources":
+			// both `map[string]*pluginsdk.Resource{"azurerm_example": resourceExample()}` and
+			// `resources["azurerm_example"] = resourceExample()` are used to register Resources
+			ast.Inspect(fn.Body, func(node ast.Node) bool {
+				switch n := node.(type) {
+				case *ast.KeyValueExpr:
+					s.addUntypedResource(n.Key, n.Value)
+				case *ast.AssignStmt:
+					if len(n.Lhs) == 1 && len(n.Rhs) == 1 {
+						if index, ok := n.Lhs[0].(*ast.IndexExpr); ok {
+							s.addUntypedResource(index.Index, n.Rhs[0])
+						}
+					}
+				}
+				return true
+			})
+
+		case "Resources":
+			ast.Inspect(fn.Body, func(node ast.Node) bool {
+				if lit, ok := node.(*ast.CompositeLit); ok {
+					if ident, ok := lit.Type.(*ast.Ident); ok {
+						s.typedResources = append(s.typedResources, ident.Name)
+					}
+				}
+				return true
+			})
+		}
+	}
+}
+
+func (s *service) parseTypes(decl *ast.GenDecl) {
+	for _, spec := range decl.Specs {
+		typeSpec, ok := spec.(*ast.TypeSpec)
+		if !ok {
+			continue
+		}
+		structType, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			continue
+		}
+
+		for _, field := range structType.Fields.List {
+			expr := field.Type
+			if star, ok := expr.(*ast.StarExpr); ok {
+				expr = star.X
+			}
+			if ident, ok := expr.(*ast.Ident); ok {
+				s.fieldTypes[typeSpec.Name.Name] = append(s.fieldTypes[typeSpec.Name.Name], ident.Name)
+			}
+		}
+	}
+}
+
+// typeApiVersions returns the API Versions imported by the files declaring methods on `typeName`, or any of its fields
+func (s *service) typeApiVersions(typeName string, seen map[string]struct{}) []apiVersion {
+	if _, ok := seen[typeName]; ok {
+		return nil
+	}
+	seen[typeName] = struct{}{}
+
+	versions := make([]apiVersion, 0)
+	for _, file := range s.typeFiles[typeName] {
+		versions = append(versions, s.apiVersions[file]...)
+	}
+	for _, fieldType := range s.fieldTypes[typeName] {
+		versions = append(versions, s.typeApiVersions(fieldType, seen)...)
+	}
+	return versions
+}
+
+func (s *service) addUntypedResource(key ast.Expr, value ast.Expr) {
+	lit, ok := key.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return
+	}
+	call, ok := value.(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	function, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return
+	}
+
+	resourceType, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return
+	}
+	s.untypedResources[resourceType] = function.Name
+}
+
+// parseApiVersion returns the Service and API Version for the SDK package at `path`, or nil if `path` isn't versioned
+func parseApiVersion(path string) *apiVersion {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if i == 0 || !apiVersionRegex.MatchString(segment) {
+			continue
+		}
+
+		// the `azure-sdk-for-go` packages are in the format `services/{service}/mgmt/{version}/{package}`
+		serviceName := segments[i-1]
+		if serviceName == "mgmt" && i > 1 {
+			serviceName = segments[i-2]
+		}
+		return &apiVersion{
+			service: serviceName,
+			version: segment,
+		}
+	}
+
+	return nil
+}
+
+func receiverTypeName(recv *ast.FieldList) string {
+	if len(recv.List) == 0 {
+		return ""
+	}
+
+	expr := recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+func returnedString(fn *ast.FuncDecl) string {
+	if fn.Body == nil || len(fn.Body.List) != 1 {
+		return ""
+	}
+	ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return ""
+	}
+	lit, ok := ret.Results[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return ""
+	}
+	v, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return ""
+	}
+	return v
+}
+
+func normalize(input []apiVersion) []apiVersion {
+	output := make([]apiVersion, 0)
+	seen := make(map[apiVersion]struct{})
+	for _, v := range input {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		output = append(output, v)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].service != output[j].service {
+			return output[i].service < output[j].service
+		}
+		return output[i].version < output[j].version
+	})
+	return output
+}
+
+func contains(input []string, value string) bool {
+	for _, v := range input {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func render(resources map[string][]apiVersion) ([]byte, error) {
+	resourceTypes := make([]string, 0, len(resources))
+	for k := range resources {
+		resourceTypes = append(resourceTypes, k)
+	}
+	sort.Strings(resourceTypes)
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+// resourceApiVersions is the API Versions used by each Resource, based on the SDK packages imported by the files
+// implementing that Resource
+var resourceApiVersions = map[string][]apiVersion{
+`)
+	for _, resourceType := range resourceTypes {
+		fmt.Fprintf(&buf, "%q: {\n", resourceType)
+		for _, v := range resources[resourceType] {
+			fmt.Fprintf(&buf, "{service: %q, version: %q},\n", v.service, v.version)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	output, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting the generated code: %+v", err)
+	}
+	return output, nil
+}
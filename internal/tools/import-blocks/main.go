@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	resourcegraph "github.com/hashicorp/go-azure-sdk/resource-manager/resourcegraph/2024-04-01/resources"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+)
+
+var logger hclog.Logger
+
+type options struct {
+	docsDirectory string
+	filter        string
+	output        string
+	subscriptions []string
+	table         string
+}
+
+func main() {
+	logger = hclog.New(hclog.DefaultOptions)
+	if os.Getenv("DEBUG") != "" {
+		logger.SetLevel(hclog.Debug)
+	}
+
+	f := flag.NewFlagSet("import-blocks", flag.ExitOnError)
+	docsDirectory := f.String("docs", "website/docs/r", "-docs=website/docs/r")
+	filter := f.String("filter", "", "-filter=\"where resourceGroup == 'example-resources'\"")
+	output := f.String("output", "", "-output=imports.tf")
+	subscriptions := f.String("subscriptions", "", "-subscriptions=00000000-0000-0000-0000-000000000000")
+	table := f.String("table", "Resources", "-table=ResourceContainers")
+	timeout := f.Duration("timeout", 30*time.Minute, "-timeout=30m")
+	if err := f.Parse(os.Args[1:]); err != nil {
+		log.Fatalf("parsing arguments: %+v", err)
+	}
+
+	opts := options{
+		docsDirectory: *docsDirectory,
+		filter:        strings.TrimSpace(*filter),
+		output:        *output,
+		table:         *table,
+	}
+	for _, v := range strings.Split(*subscriptions, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			opts.subscriptions = append(opts.subscriptions, v)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	if err := run(ctx, opts); err != nil {
+		log.Fatalf("error: %+v", err)
+	}
+}
+
+func run(ctx context.Context, opts options) error {
+	templates, err := loadTemplates(opts.docsDirectory)
+	if err != nil {
+		return err
+	}
+	logger.Debug(fmt.Sprintf("Loaded %d Resource ID formats from %q", len(templates), opts.docsDirectory))
+
+	// the same `ARM_*` environment variables as the acceptance tests are used to authenticate
+	client, err := testclient.Build()
+	if err != nil {
+		return err
+	}
+	if len(opts.subscriptions) == 0 {
+		opts.subscriptions = []string{client.Account.SubscriptionId}
+	}
+
+	query := opts.table
+	if opts.filter != "" {
+		query += " | " + strings.TrimPrefix(opts.filter, "| ")
+	}
+	query += " | project id, type | order by id asc"
+
+	ids, err := queryResourceIds(ctx, client.Resource.ResourceGraphClient, query, opts.subscriptions)
+	if err != nil {
+		return err
+	}
+	logger.Info(fmt.Sprintf("Found %d resources matching %q", len(ids), query))
+
+	var out io.Writer = os.Stdout
+	if opts.output != "" {
+		file, err := os.Create(opts.output)
+		if err != nil {
+			return fmt.Errorf("creating %q: %+v", opts.output, err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	names := make(map[string]struct{})
+	for _, v := range ids {
+		matches := findMatches(templates, v.id)
+		if len(matches) == 0 {
+			logger.Warn(fmt.Sprintf("No Terraform Resource was found which can import %q (%s)", v.id, v.resourceType))
+			continue
+		}
+
+		address := uniqueAddress(names, matches[0].resourceType, v.id)
+		if _, err := fmt.Fprint(out, importBlock(address, matches)); err != nil {
+			return fmt.Errorf("writing the import block for %q: %+v", v.id, err)
+		}
+	}
+
+	return nil
+}
+
+type resourceGraphResult struct {
+	id           string
+	resourceType string
+}
+
+func queryResourceIds(ctx context.Context, client *resourcegraph.ResourcesClient, query string, subscriptions []string) ([]resourceGraphResult, error) {
+	results := make([]resourceGraphResult, 0)
+
+	request := resourcegraph.QueryRequest{
+		Query:         query,
+		Subscriptions: pointer.To(subscriptions),
+		Options: &resourcegraph.QueryRequestOptions{
+			ResultFormat: pointer.To(resourcegraph.ResultFormatObjectArray),
+			Top:          pointer.To(int64(1000)),
+		},
+	}
+	for {
+		resp, err := client.Resources(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("querying Resource Graph: %+v", err)
+		}
+		if resp.Model == nil {
+			return nil, fmt.Errorf("querying Resource Graph: `model` was nil")
+		}
+
+		rows, ok := resp.Model.Data.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("querying Resource Graph: expected `data` to be a list but got %T", resp.Model.Data)
+		}
+		for _, row := range rows {
+			values, ok := row.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			id, _ := values["id"].(string)
+			resourceType, _ := values["type"].(string)
+			if id == "" {
+				continue
+			}
+			results = append(results, resourceGraphResult{
+				id:           id,
+				resourceType: resourceType,
+			})
+		}
+
+		if pointer.From(resp.Model.SkipToken) == "" {
+			break
+		}
+		request.Options.SkipToken = resp.Model.SkipToken
+	}
+
+	return results, nil
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// uniqueAddress returns a Terraform Resource address based on the name of the resource, which is unique within `names`
+func uniqueAddress(names map[string]struct{}, resourceType, id string) string {
+	name := id[strings.LastIndex(id, "/")+1:]
+	name = strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+
+	address := fmt.Sprintf("%s.%s", resourceType, name)
+	for i := 2; ; i++ {
+		if _, exists := names[address]; !exists {
+			break
+		}
+		address = fmt.Sprintf("%s.%s_%d", resourceType, name, i)
+	}
+	names[address] = struct{}{}
+
+	return address
+}
+
+func importBlock(address string, matches []match) string {
+	out := ""
+	if len(matches) > 1 {
+		alternatives := make([]string, 0)
+		for _, v := range matches[1:] {
+			alternatives = append(alternatives, v.resourceType)
+		}
+		out += fmt.Sprintf("# NOTE: this resource can also be imported as: %s\n", strings.Join(alternatives, ", "))
+	}
+
+	out += fmt.Sprintf(`import {
+  to = %s
+  id = %q
+}
+
+`, address, matches[0].id)
+	return out
+}
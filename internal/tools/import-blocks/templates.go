@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var importExampleRegex = regexp.MustCompile(`^terraform import (azurerm_[a-z0-9_]+)\.[A-Za-z0-9_\-]+ ["']?([^"'\s]+)["']?\s*$`)
+
+type segment struct {
+	value string
+
+	// static is true when this segment is a fixed part of the Resource ID (e.g. `resourceGroups` or `Microsoft.Compute`)
+	// and false when this segment is a user-specified value (e.g. the name of the Resource Group)
+	static bool
+}
+
+// idTemplate is the structure of a Resource ID, taken from the example given in the Import section of the documentation
+// for a Terraform Resource - which contains the canonical casing for the static segments.
+type idTemplate struct {
+	resourceType string
+	segments     []segment
+}
+
+func parseSegments(input string) []segment {
+	split := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	segments := make([]segment, 0, len(split))
+	for i := 0; i < len(split); i++ {
+		// `providers` is followed by the Resource Provider namespace, both of which are static
+		if strings.EqualFold(split[i], "providers") && i+1 < len(split) {
+			segments = append(segments, segment{value: split[i], static: true}, segment{value: split[i+1], static: true})
+			i++
+			continue
+		}
+
+		segments = append(segments, segment{value: split[i], static: true})
+		if i+1 < len(split) {
+			segments = append(segments, segment{value: split[i+1], static: false})
+			i++
+		}
+	}
+	return segments
+}
+
+// recase returns `id` using the casing of the static segments within this template, or false if `id` doesn't match
+func (t idTemplate) recase(id string) (string, bool) {
+	input := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	if len(input) != len(t.segments) {
+		return "", false
+	}
+
+	output := make([]string, 0, len(input))
+	for i, v := range t.segments {
+		if !v.static {
+			if input[i] == "" {
+				return "", false
+			}
+			output = append(output, input[i])
+			continue
+		}
+
+		if !strings.EqualFold(input[i], v.value) {
+			return "", false
+		}
+		output = append(output, v.value)
+	}
+
+	return "/" + strings.Join(output, "/"), true
+}
+
+// loadTemplates parses the Import section of each Resource's documentation within `directory`
+func loadTemplates(directory string) ([]idTemplate, error) {
+	files, err := filepath.Glob(filepath.Join(directory, "*.html.markdown"))
+	if err != nil {
+		return nil, fmt.Errorf("finding documentation within %q: %+v", directory, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no documentation was found within %q", directory)
+	}
+
+	templates := make([]idTemplate, 0)
+	for _, file := range files {
+		parsed, err := loadTemplatesFromFile(file)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, parsed...)
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].resourceType < templates[j].resourceType
+	})
+	return templates, nil
+}
+
+func loadTemplatesFromFile(file string) ([]idTemplate, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("opening %q: %+v", file, err)
+	}
+	defer f.Close()
+
+	templates := make([]idTemplate, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		matches := importExampleRegex.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if len(matches) != 3 {
+			continue
+		}
+
+		// only Resource Manager IDs are supported, data plane IDs (e.g. Key Vault Secrets) and composite IDs
+		// (e.g. associations between two resources) can't be found via Resource Graph
+		id := matches[2]
+		if !strings.HasPrefix(id, "/") || strings.ContainsAny(id, "|;{}?") {
+			continue
+		}
+
+		templates = append(templates, idTemplate{
+			resourceType: matches[1],
+			segments:     parseSegments(id),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", file, err)
+	}
+
+	return templates, nil
+}
+
+type match struct {
+	resourceType string
+	id           string
+}
+
+// findMatches returns each Terraform Resource which can import `id`, along with the correctly-cased ID.
+//
+// Resources which manage part of another resource (e.g. `azurerm_storage_account_network_rules`) share the ID of the
+// resource they're a part of, as such the matches are ordered by the shortest Resource type first, which is the resource
+// managing the whole of the Azure resource in the majority of cases.
+func findMatches(templates []idTemplate, id string) []match {
+	matches := make([]match, 0)
+	seen := make(map[string]struct{})
+	for _, template := range templates {
+		if _, ok := seen[template.resourceType]; ok {
+			continue
+		}
+
+		recased, ok := template.recase(id)
+		if !ok {
+			continue
+		}
+
+		seen[template.resourceType] = struct{}{}
+		matches = append(matches, match{
+			resourceType: template.resourceType,
+			id:           recased,
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if len(matches[i].resourceType) != len(matches[j].resourceType) {
+			return len(matches[i].resourceType) < len(matches[j].resourceType)
+		}
+		return matches[i].resourceType < matches[j].resourceType
+	})
+	return matches
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindMatches(t *testing.T) {
+	templates := []idTemplate{
+		{
+			resourceType: "azurerm_resource_group",
+			segments:     parseSegments("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"),
+		},
+		{
+			resourceType: "azurerm_linux_virtual_machine",
+			segments:     parseSegments("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/machine1"),
+		},
+		{
+			resourceType: "azurerm_windows_virtual_machine",
+			segments:     parseSegments("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/machine1"),
+		},
+		{
+			resourceType: "azurerm_monitor_diagnostic_setting",
+			segments:     parseSegments("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/machine1/providers/Microsoft.Insights/diagnosticSettings/setting1"),
+		},
+	}
+
+	testData := []struct {
+		input    string
+		expected []match
+	}{
+		{
+			input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/My-Resources",
+			expected: []match{
+				{
+					resourceType: "azurerm_resource_group",
+					id:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/My-Resources",
+				},
+			},
+		},
+		{
+			input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/My-Resources/providers/microsoft.compute/virtualmachines/VM1",
+			expected: []match{
+				{
+					resourceType: "azurerm_linux_virtual_machine",
+					id:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/My-Resources/providers/Microsoft.Compute/virtualMachines/VM1",
+				},
+				{
+					resourceType: "azurerm_windows_virtual_machine",
+					id:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/My-Resources/providers/Microsoft.Compute/virtualMachines/VM1",
+				},
+			},
+		},
+		{
+			input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/My-Resources/providers/microsoft.compute/virtualmachines/VM1/providers/microsoft.insights/diagnosticsettings/diag",
+			expected: []match{
+				{
+					resourceType: "azurerm_monitor_diagnostic_setting",
+					id:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/My-Resources/providers/Microsoft.Compute/virtualMachines/VM1/providers/Microsoft.Insights/diagnosticSettings/diag",
+				},
+			},
+		},
+		{
+			// a different resource type within the same Resource Provider
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/My-Resources/providers/Microsoft.Compute/disks/disk1",
+			expected: []match{},
+		},
+		{
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/My-Resources/providers/Microsoft.Compute/virtualMachines/",
+			expected: []match{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual := findMatches(templates, v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestLoadTemplates(t *testing.T) {
+	templates, err := loadTemplates("../../../website/docs/r")
+	if err != nil {
+		t.Fatalf("loading templates: %+v", err)
+	}
+
+	matches := findMatches(templates, "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example")
+	if len(matches) != 1 || matches[0].resourceType != "azurerm_resource_group" {
+		t.Fatalf("expected a single match for `azurerm_resource_group` but got %+v", matches)
+	}
+
+	matches = findMatches(templates, "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/microsoft.storage/storageaccounts/account1")
+	if len(matches) == 0 || matches[0].resourceType != "azurerm_storage_account" {
+		t.Fatalf("expected the first match to be `azurerm_storage_account` but got %+v", matches)
+	}
+}
+
+func TestUniqueAddress(t *testing.T) {
+	names := make(map[string]struct{})
+	testData := []struct {
+		id       string
+		expected string
+	}{
+		{
+			id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/My-Resources",
+			expected: "azurerm_resource_group.my_resources",
+		},
+		{
+			id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/my.resources",
+			expected: "azurerm_resource_group.my_resources_2",
+		},
+		{
+			id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/1resources",
+			expected: "azurerm_resource_group.r_1resources",
+		},
+	}
+
+	for _, v := range testData {
+		actual := uniqueAddress(names, "azurerm_resource_group", v.id)
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+var logger hclog.Logger
+
+// forceDeletionTypes are the resource types which the Resource Manager API can force-delete when removing a Resource Group,
+// which avoids the Resource Group deletion waiting on (or failing because of) the graceful shutdown of these resources
+const forceDeletionTypes = "Microsoft.Compute/virtualMachines,Microsoft.Compute/virtualMachineScaleSets"
+
+type options struct {
+	prefixes    []string
+	tagKey      string
+	tagValue    string
+	dryRun      bool
+	parallelism int
+	passes      int
+}
+
+func main() {
+	logger = hclog.New(hclog.DefaultOptions)
+	if os.Getenv("DEBUG") != "" {
+		logger.SetLevel(hclog.Debug)
+	}
+
+	f := flag.NewFlagSet("sweeper", flag.ExitOnError)
+	prefixes := f.String("prefixes", "acctest", "-prefixes=acctest,acctestRG")
+	tag := f.String("tag", "", "-tag=environment=acctest")
+	dryRun := f.Bool("dry-run", false, "-dry-run")
+	parallelism := f.Int("parallelism", 10, "-parallelism=10")
+	passes := f.Int("passes", 3, "-passes=3")
+	timeout := f.Duration("timeout", 3*time.Hour, "-timeout=3h")
+	if err := f.Parse(os.Args[1:]); err != nil {
+		log.Fatalf("parsing arguments: %+v", err)
+	}
+
+	opts := options{
+		dryRun:      *dryRun,
+		parallelism: *parallelism,
+		passes:      *passes,
+	}
+	for _, v := range strings.Split(*prefixes, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			opts.prefixes = append(opts.prefixes, strings.ToLower(v))
+		}
+	}
+	if len(opts.prefixes) == 0 {
+		log.Fatalf("at least one value must be specified for `-prefixes`")
+	}
+	if *tag != "" {
+		key, value, ok := strings.Cut(*tag, "=")
+		if !ok || key == "" {
+			log.Fatalf("expected `-tag` to be in the format `key=value` but got %q", *tag)
+		}
+		opts.tagKey = key
+		opts.tagValue = value
+	}
+	if opts.parallelism < 1 {
+		log.Fatalf("`-parallelism` must be at least 1")
+	}
+	if opts.passes < 1 {
+		log.Fatalf("`-passes` must be at least 1")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	if err := run(ctx, opts); err != nil {
+		log.Fatalf("error: %+v", err)
+	}
+}
+
+func run(ctx context.Context, opts options) error {
+	// the sweeper authenticates using the same `ARM_*` environment variables as the acceptance tests
+	client, err := testclient.Build()
+	if err != nil {
+		return err
+	}
+
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+	groups, err := findResourceGroups(ctx, client, subscriptionId, opts)
+	if err != nil {
+		return err
+	}
+
+	if len(groups) == 0 {
+		logger.Info(fmt.Sprintf("No Resource Groups matching the prefixes %q were found in %s", strings.Join(opts.prefixes, ","), subscriptionId))
+		return nil
+	}
+
+	for _, id := range groups {
+		logger.Info(fmt.Sprintf("Found %s", id))
+	}
+	if opts.dryRun {
+		logger.Info(fmt.Sprintf("Dry run - %d Resource Groups would be deleted", len(groups)))
+		return nil
+	}
+
+	// Resources in one Resource Group can depend on resources in another (e.g. Virtual Network Peerings, Private Endpoints
+	// or Role Assignments) - so rather than working out the dependency graph up-front, the groups which fail to delete are
+	// retried in a subsequent pass, once the groups they depend on (or which depend on them) have been removed.
+	remaining := groups
+	for pass := 1; pass <= opts.passes && len(remaining) > 0; pass++ {
+		logger.Info(fmt.Sprintf("Pass %d/%d: deleting %d Resource Groups..", pass, opts.passes, len(remaining)))
+		remaining = deleteResourceGroups(ctx, client, remaining, opts.parallelism)
+	}
+
+	if len(remaining) > 0 {
+		names := make([]string, 0, len(remaining))
+		for _, id := range remaining {
+			names = append(names, id.ResourceGroupName)
+		}
+		return fmt.Errorf("%d Resource Groups could not be deleted after %d passes: %s", len(remaining), opts.passes, strings.Join(names, ", "))
+	}
+
+	logger.Info(fmt.Sprintf("Deleted %d Resource Groups", len(groups)))
+	return nil
+}
+
+func findResourceGroups(ctx context.Context, client *clients.Client, subscriptionId commonids.SubscriptionId, opts options) ([]commonids.ResourceGroupId, error) {
+	listOpts := resourcegroups.DefaultListOperationOptions()
+	if opts.tagKey != "" {
+		listOpts.Filter = pointer.To(fmt.Sprintf("tagName eq '%s' and tagValue eq '%s'", opts.tagKey, opts.tagValue))
+	}
+
+	resp, err := client.Resource.ResourceGroupsClient.ListComplete(ctx, subscriptionId, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource Groups within %s: %+v", subscriptionId, err)
+	}
+
+	groups := make([]commonids.ResourceGroupId, 0)
+	for _, item := range resp.Items {
+		name := pointer.From(item.Name)
+		if !matchesPrefix(name, opts.prefixes) {
+			continue
+		}
+
+		// groups which are managed by another resource (e.g. the Node Resource Group of a Kubernetes Cluster) are
+		// removed alongside the resource which manages them, and can't be deleted directly
+		if pointer.From(item.ManagedBy) != "" {
+			logger.Debug(fmt.Sprintf("Skipping Resource Group %q since it's managed by %q", name, *item.ManagedBy))
+			continue
+		}
+
+		if item.Properties != nil && strings.EqualFold(pointer.From(item.Properties.ProvisioningState), "Deleting") {
+			logger.Debug(fmt.Sprintf("Skipping Resource Group %q since it's already being deleted", name))
+			continue
+		}
+
+		groups = append(groups, commonids.NewResourceGroupID(subscriptionId.SubscriptionId, name))
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].ResourceGroupName < groups[j].ResourceGroupName
+	})
+
+	return groups, nil
+}
+
+func matchesPrefix(name string, prefixes []string) bool {
+	name = strings.ToLower(name)
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteResourceGroups deletes the specified Resource Groups, returning the ones which couldn't be deleted
+func deleteResourceGroups(ctx context.Context, client *clients.Client, groups []commonids.ResourceGroupId, parallelism int) []commonids.ResourceGroupId {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		failed = make([]commonids.ResourceGroupId, 0)
+		sem    = make(chan struct{}, parallelism)
+	)
+
+	for _, id := range groups {
+		wg.Add(1)
+		go func(id commonids.ResourceGroupId) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := deleteResourceGroup(ctx, client, id); err != nil {
+				logger.Warn(fmt.Sprintf("deleting %s: %+v", id, err))
+				mu.Lock()
+				failed = append(failed, id)
+				mu.Unlock()
+				return
+			}
+
+			logger.Info(fmt.Sprintf("Deleted %s", id))
+		}(id)
+	}
+	wg.Wait()
+
+	sort.Slice(failed, func(i, j int) bool {
+		return failed[i].ResourceGroupName < failed[j].ResourceGroupName
+	})
+	return failed
+}
+
+func deleteResourceGroup(ctx context.Context, client *clients.Client, id commonids.ResourceGroupId) error {
+	// Management Locks (on either the Resource Group or any resource within it) block the deletion, so must be removed first
+	locks, err := client.Resource.LocksClient.ListAtResourceGroupLevelComplete(ctx, id, managementlocks.DefaultListAtResourceGroupLevelOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing Management Locks: %+v", err)
+	}
+	for _, lock := range locks.Items {
+		lockId, err := managementlocks.ParseScopedLockIDInsensitively(pointer.From(lock.Id))
+		if err != nil {
+			return err
+		}
+
+		logger.Debug(fmt.Sprintf("Removing %s", lockId))
+		if _, err := client.Resource.LocksClient.DeleteByScope(ctx, *lockId); err != nil {
+			return fmt.Errorf("removing %s: %+v", lockId, err)
+		}
+	}
+
+	deleteOpts := resourcegroups.DeleteOperationOptions{
+		ForceDeletionTypes: pointer.To(forceDeletionTypes),
+	}
+	if err := client.Resource.ResourceGroupsClient.DeleteThenPoll(ctx, id, deleteOpts); err != nil {
+		return err
+	}
+
+	return nil
+}
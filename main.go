@@ -48,14 +48,14 @@ func main() {
 			//nolint:staticcheck
 			err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/azurerm",
 				&plugin.ServeOpts{
-					ProviderFunc: provider.AzureProvider,
+					GRPCProviderFunc: provider.AzureGRPCProvider,
 				})
 			if err != nil {
 				log.Println(err.Error())
 			}
 		} else {
 			plugin.Serve(&plugin.ServeOpts{
-				ProviderFunc: provider.AzureProvider,
+				GRPCProviderFunc: provider.AzureGRPCProvider,
 			})
 		}
 	}